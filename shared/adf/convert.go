@@ -9,6 +9,7 @@ import (
 	"github.com/yuin/goldmark/extension"
 	extast "github.com/yuin/goldmark/extension/ast"
 	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
 )
 
 // mdParser is a goldmark parser configured for ADF conversion.
@@ -16,12 +17,27 @@ var mdParser = goldmark.New(
 	goldmark.WithExtensions(
 		extension.Table,
 		extension.Strikethrough,
+		extension.TaskList,
+		dialect,
 	),
 )
 
 // ToDocument converts markdown text to an ADF Document struct.
 // Returns nil for empty input. If parsing yields no content,
 // falls back to a single paragraph with the raw text.
+//
+// Besides GitHub-flavored Markdown, ToDocument reads a dialect for ADF nodes
+// that Markdown has no syntax for:
+//
+//	@[Name](accountId)         mention
+//	- [ ] task / - [x] done    taskList and taskItem
+//	> [!INFO] ...              panel (also NOTE, TIP, SUCCESS, WARNING, ERROR)
+//	{status:In Progress|green} status (neutral, purple, blue, red, yellow, green)
+//	:smile:                    emoji
+//	<date:2026-10-17>          date
+//
+// Each can be written literally by escaping its first character, as in
+// \:smile:.
 func ToDocument(markdown string) *Document {
 	if markdown == "" {
 		return nil
@@ -87,6 +103,7 @@ func ToJSON(markdown []byte) (string, error) {
 // converter holds state during AST-to-ADF conversion.
 type converter struct {
 	source []byte
+	ids    int // localIds handed out so far
 }
 
 // convertChildren converts all children of an AST node to ADF nodes.
@@ -148,6 +165,10 @@ func (c *converter) convertHeading(n *ast.Heading) *Node {
 }
 
 func (c *converter) convertList(n *ast.List) *Node {
+	if isTaskList(n) {
+		return c.convertTaskList(n)
+	}
+
 	listType := "bulletList"
 	var attrs map[string]interface{}
 	if n.IsOrdered() {
@@ -194,6 +215,85 @@ func (c *converter) convertListItem(n *ast.ListItem) *Node {
 	}
 }
 
+// isTaskList reports whether every item of a list starts with a checkbox.
+func isTaskList(n *ast.List) bool {
+	if !n.HasChildren() {
+		return false
+	}
+	for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+		if item.FirstChild() == nil {
+			return false
+		}
+		if _, ok := item.FirstChild().FirstChild().(*extast.TaskCheckBox); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// convertTaskList converts a list of checkbox items. ADF task items hold
+// only inline content, so any further blocks in an item are joined to it
+// with line breaks. Nested task lists follow the item they belong to.
+func (c *converter) convertTaskList(n *ast.List) *Node {
+	list := &Node{
+		Type:  "taskList",
+		Attrs: map[string]interface{}{"localId": c.localID()},
+	}
+	for item := n.FirstChild(); item != nil; item = item.NextSibling() {
+		state := "TODO"
+		if box, ok := item.FirstChild().FirstChild().(*extast.TaskCheckBox); ok {
+			if box.IsChecked {
+				state = "DONE"
+			}
+			box.Parent().RemoveChild(box.Parent(), box)
+		}
+		task := &Node{
+			Type:  "taskItem",
+			Attrs: map[string]interface{}{"localId": c.localID(), "state": state},
+		}
+		list.Content = append(list.Content, task)
+
+		for child := item.FirstChild(); child != nil; child = child.NextSibling() {
+			if nested, ok := child.(*ast.List); ok && isTaskList(nested) {
+				list.Content = append(list.Content, c.convertTaskList(nested))
+				continue
+			}
+			inline := inlineContent(c.convertNode(child))
+			if len(inline) == 0 {
+				continue
+			}
+			if len(task.Content) > 0 {
+				task.Content = append(task.Content, &Node{Type: "hardBreak"})
+			}
+			task.Content = append(task.Content, inline...)
+		}
+	}
+	return list
+}
+
+// inlineContent returns the inline content of a block, with the content of
+// separate paragraphs joined by line breaks.
+func inlineContent(n *Node) []*Node {
+	if n == nil {
+		return nil
+	}
+	if len(n.Content) == 0 || isInline(n.Content[0]) {
+		return n.Content
+	}
+	var content []*Node
+	for _, child := range n.Content {
+		inline := inlineContent(child)
+		if len(inline) == 0 {
+			continue
+		}
+		if len(content) > 0 {
+			content = append(content, &Node{Type: "hardBreak"})
+		}
+		content = append(content, inline...)
+	}
+	return content
+}
+
 func (c *converter) convertTextBlockToParagraph(n *ast.TextBlock) *Node {
 	content := c.convertInlineChildren(n)
 	if len(content) == 0 {
@@ -215,13 +315,7 @@ func (c *converter) convertFencedCodeBlock(n *ast.FencedCodeBlock) *Node {
 
 	codeStr := strings.TrimSuffix(code.String(), "\n")
 
-	node := &Node{
-		Type: "codeBlock",
-		Content: []*Node{
-			{Type: "text", Text: codeStr},
-		},
-	}
-
+	node := codeBlockNode(codeStr)
 	if lang := string(n.Language(c.source)); lang != "" {
 		node.Attrs = map[string]interface{}{"language": lang}
 	}
@@ -239,15 +333,27 @@ func (c *converter) convertCodeBlock(n *ast.CodeBlock) *Node {
 
 	codeStr := strings.TrimSuffix(code.String(), "\n")
 
-	return &Node{
-		Type: "codeBlock",
-		Content: []*Node{
-			{Type: "text", Text: codeStr},
-		},
+	return codeBlockNode(codeStr)
+}
+
+// codeBlockNode returns a code block holding code. An empty block has no
+// content, as ADF does not allow empty text.
+func codeBlockNode(code string) *Node {
+	node := &Node{Type: "codeBlock"}
+	if code != "" {
+		node.Content = []*Node{{Type: "text", Text: code}}
 	}
+	return node
 }
 
 func (c *converter) convertBlockquote(n *ast.Blockquote) *Node {
+	if panelType, marker := c.panelType(n); panelType != "" {
+		return &Node{
+			Type:    "panel",
+			Attrs:   map[string]interface{}{"panelType": panelType},
+			Content: stripPanelMarker(c.convertChildren(n), marker),
+		}
+	}
 	return &Node{
 		Type:    "blockquote",
 		Content: c.convertChildren(n),
@@ -307,18 +413,11 @@ func (c *converter) convertTableCell(n *extast.TableCell, isHeader bool) *Node {
 		cellType = "tableHeader"
 	}
 
-	content := c.convertInlineChildren(n)
-	var para *Node
-	if len(content) > 0 {
-		para = &Node{Type: "paragraph", Content: content}
-	} else {
-		para = &Node{Type: "paragraph", Content: []*Node{{Type: "text", Text: ""}}}
-	}
-
+	// Empty cells hold an empty paragraph, as ADF does not allow empty text
 	return &Node{
 		Type:    cellType,
 		Attrs:   map[string]interface{}{"colspan": 1, "rowspan": 1},
-		Content: []*Node{para},
+		Content: []*Node{{Type: "paragraph", Content: c.convertInlineChildren(n)}},
 	}
 }
 
@@ -329,14 +428,46 @@ func (c *converter) convertInlineChildren(n ast.Node) []*Node {
 		textNodes := c.convertInlineNode(child, nil)
 		nodes = append(nodes, textNodes...)
 	}
-	return nodes
+	return mergeTextNodes(nodes)
+}
+
+// mergeTextNodes joins adjacent text nodes with the same marks, which the
+// parser splits at characters that might have started emphasis.
+func mergeTextNodes(nodes []*Node) []*Node {
+	var merged []*Node
+	for _, n := range nodes {
+		if len(merged) > 0 {
+			last := merged[len(merged)-1]
+			if n.Type == "text" && last.Type == "text" && sameMarks(last.Marks, n.Marks) {
+				merged[len(merged)-1] = &Node{Type: "text", Text: last.Text + n.Text, Marks: last.Marks}
+				continue
+			}
+		}
+		merged = append(merged, n)
+	}
+	return merged
+}
+
+func sameMarks(a, b []*Mark) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Type != b[i].Type || stringAttr(a[i].Attrs, "href") != stringAttr(b[i].Attrs, "href") {
+			return false
+		}
+	}
+	return true
 }
 
 // convertInlineNode converts an inline AST node to ADF text node(s).
 func (c *converter) convertInlineNode(n ast.Node, marks []*Mark) []*Node {
 	switch node := n.(type) {
 	case *ast.Text:
-		txt := string(node.Segment.Value(c.source))
+		txt := string(util.UnescapePunctuations(node.Segment.Value(c.source)))
+		if node.SoftLineBreak() && !node.HardLineBreak() {
+			txt += " "
+		}
 		if txt == "" {
 			return nil
 		}
@@ -417,6 +548,17 @@ func (c *converter) convertInlineNode(n ast.Node, marks []*Mark) []*Node {
 	case *ast.RawHTML:
 		return nil
 
+	case *extast.TaskCheckBox:
+		// A checkbox in a list that is not a task list stays as text
+		box := "[ ] "
+		if node.IsChecked {
+			box = "[x] "
+		}
+		return []*Node{{Type: "text", Text: box, Marks: marks}}
+
+	case *mentionNode, *statusNode, *emojiNode, *dateNode:
+		return []*Node{c.convertDialectNode(node)}
+
 	case *ast.Image:
 		var altBuilder strings.Builder
 		for child := node.FirstChild(); child != nil; child = child.NextSibling() {
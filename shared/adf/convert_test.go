@@ -667,3 +667,107 @@ func TestToPlainText_UnknownNodeWithChildren(t *testing.T) {
 	text := doc.ToPlainText()
 	assert.Contains(t, text, "inner")
 }
+
+func TestToDocument_Mention(t *testing.T) {
+	doc := ToDocument("Ping @[Jane Doe](557058:f58131cb) or mail jane@[host](x)")
+	require.Len(t, doc.Content, 1)
+	content := doc.Content[0].Content
+	require.Len(t, content, 4)
+	assert.Equal(t, "mention", content[1].Type)
+	assert.Equal(t, "557058:f58131cb", content[1].Attrs["id"])
+	assert.Equal(t, "@Jane Doe", content[1].Attrs["text"])
+	// An @ inside a word is not a mention
+	assert.Equal(t, " or mail jane@", content[2].Text)
+	assert.Equal(t, "link", content[3].Marks[0].Type)
+}
+
+func TestToDocument_TaskList(t *testing.T) {
+	doc := ToDocument("- [ ] Write tests\n- [x] Ship it\n  - [ ] Tell everyone")
+	require.Len(t, doc.Content, 1)
+	list := doc.Content[0]
+	assert.Equal(t, "taskList", list.Type)
+	assert.NotEmpty(t, list.Attrs["localId"])
+	require.Len(t, list.Content, 3)
+
+	assert.Equal(t, "taskItem", list.Content[0].Type)
+	assert.Equal(t, "TODO", list.Content[0].Attrs["state"])
+	assert.Equal(t, "Write tests", list.Content[0].Content[0].Text)
+	assert.Equal(t, "DONE", list.Content[1].Attrs["state"])
+	assert.Equal(t, "taskList", list.Content[2].Type)
+	assert.Equal(t, "Tell everyone", list.Content[2].Content[0].Content[0].Text)
+}
+
+func TestToDocument_MixedTaskListStaysBulletList(t *testing.T) {
+	doc := ToDocument("- [ ] task\n- plain")
+	require.Len(t, doc.Content, 1)
+	assert.Equal(t, "bulletList", doc.Content[0].Type)
+	assert.Equal(t, "[ ] task", doc.Content[0].Content[0].Content[0].Content[0].Text)
+}
+
+func TestToDocument_Panel(t *testing.T) {
+	tests := []struct {
+		markdown  string
+		panelType string
+	}{
+		{"> [!INFO]\n> Heads up", "info"},
+		{"> [!warning] Heads up", "warning"},
+		{"> [!TIP]\n> Heads up", "tip"},
+		{"> [!CAUTION]\n> Heads up", "error"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.markdown, func(t *testing.T) {
+			doc := ToDocument(tt.markdown)
+			require.Len(t, doc.Content, 1)
+			panel := doc.Content[0]
+			assert.Equal(t, "panel", panel.Type)
+			assert.Equal(t, tt.panelType, panel.Attrs["panelType"])
+			require.Len(t, panel.Content, 1)
+			assert.Equal(t, "Heads up", panel.Content[0].Content[0].Text)
+		})
+	}
+
+	// Unknown markers and escaped markers stay quotes
+	for _, markdown := range []string{"> [!FOO]\n> text", `> \[!INFO\] text`} {
+		assert.Equal(t, "blockquote", ToDocument(markdown).Content[0].Type, markdown)
+	}
+}
+
+func TestToDocument_InlineNodes(t *testing.T) {
+	doc := ToDocument("{status:In Progress|Green} :tada: <date:2026-10-17> {status:Done}")
+	require.Len(t, doc.Content, 1)
+	content := doc.Content[0].Content
+	require.Len(t, content, 7)
+
+	assert.Equal(t, "status", content[0].Type)
+	assert.Equal(t, "In Progress", content[0].Attrs["text"])
+	assert.Equal(t, "green", content[0].Attrs["color"])
+	assert.Equal(t, "emoji", content[2].Type)
+	assert.Equal(t, ":tada:", content[2].Attrs["shortName"])
+	assert.Equal(t, "date", content[4].Type)
+	assert.Equal(t, "1792195200000", content[4].Attrs["timestamp"])
+	assert.Equal(t, "neutral", content[6].Attrs["color"])
+}
+
+func TestToDocument_DialectLookalikes(t *testing.T) {
+	tests := []string{
+		"at 10:30:00",
+		"{status:Odd|pink}",
+		"<date:2026-13-45>",
+		"`:smile:`",
+		`\:smile:`,
+	}
+	for _, markdown := range tests {
+		t.Run(markdown, func(t *testing.T) {
+			doc := ToDocument(markdown)
+			for _, n := range doc.Content[0].Content {
+				assert.Equal(t, "text", n.Type)
+			}
+		})
+	}
+}
+
+func TestToDocument_SoftLineBreak(t *testing.T) {
+	doc := ToDocument("one\ntwo")
+	require.Len(t, doc.Content[0].Content, 1)
+	assert.Equal(t, "one two", doc.Content[0].Content[0].Text)
+}
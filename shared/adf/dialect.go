@@ -0,0 +1,285 @@
+package adf
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// dialect is a goldmark extension that parses the inline parts of the
+// Markdown dialect for ADF nodes without a Markdown form:
+//
+//	@[Name](accountId)         mention
+//	{status:In Progress|green} status
+//	:smile:                    emoji
+//	<date:2026-10-17>          date
+//
+// Task lists use the GFM syntax and panels the GitHub alert syntax; both are
+// handled during conversion.
+var dialect = &dialectExtension{}
+
+type dialectExtension struct{}
+
+func (e *dialectExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(&mentionParser{}, 150),
+		util.Prioritized(&statusParser{}, 150),
+		util.Prioritized(&emojiParser{}, 150),
+		// Before the autolink parser, which would read a date as a URL
+		util.Prioritized(&dateParser{}, 250),
+	))
+}
+
+var (
+	kindMention = ast.NewNodeKind("Mention")
+	kindStatus  = ast.NewNodeKind("Status")
+	kindEmoji   = ast.NewNodeKind("Emoji")
+	kindDate    = ast.NewNodeKind("Date")
+)
+
+// mentionNode is an @[Name](accountId) mention.
+type mentionNode struct {
+	ast.BaseInline
+	ID   string
+	Name string
+}
+
+func (n *mentionNode) Kind() ast.NodeKind { return kindMention }
+
+func (n *mentionNode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"ID": n.ID, "Name": n.Name}, nil)
+}
+
+// statusNode is a {status:Text|color} lozenge.
+type statusNode struct {
+	ast.BaseInline
+	Label string
+	Color string
+}
+
+func (n *statusNode) Kind() ast.NodeKind { return kindStatus }
+
+func (n *statusNode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Label": n.Label, "Color": n.Color}, nil)
+}
+
+// emojiNode is an :emoji: short name.
+type emojiNode struct {
+	ast.BaseInline
+	ShortName string
+}
+
+func (n *emojiNode) Kind() ast.NodeKind { return kindEmoji }
+
+func (n *emojiNode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"ShortName": n.ShortName}, nil)
+}
+
+// dateNode is a <date:YYYY-MM-DD> date.
+type dateNode struct {
+	ast.BaseInline
+	Date time.Time
+}
+
+func (n *dateNode) Kind() ast.NodeKind { return kindDate }
+
+func (n *dateNode) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Date": n.Date.Format("2006-01-02")}, nil)
+}
+
+var (
+	mentionPattern = regexp.MustCompile(`^@\[((?:\\.|[^\\\]\n])+)\]\(([\w:-]+)\)`)
+	statusPattern  = regexp.MustCompile(`^\{status:((?:\\.|[^\\|}\n])+)(?:\|(\w+))?\}`)
+	emojiPattern   = regexp.MustCompile(`^:([a-z0-9_+-]+):`)
+	datePattern    = regexp.MustCompile(`^<date:(\d{4}-\d{2}-\d{2})>`)
+)
+
+// statusColors are the colors of ADF status lozenges.
+var statusColors = map[string]bool{
+	"neutral": true, "purple": true, "blue": true, "red": true, "yellow": true, "green": true,
+}
+
+type mentionParser struct{}
+
+func (p *mentionParser) Trigger() []byte { return []byte{'@'} }
+
+func (p *mentionParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	// An @ inside a word, as in an email address, is not a mention
+	if isWordRune(block.PrecendingCharacter()) {
+		return nil
+	}
+	line, _ := block.PeekLine()
+	m := mentionPattern.FindSubmatch(line)
+	if m == nil {
+		return nil
+	}
+	block.Advance(len(m[0]))
+	return &mentionNode{ID: string(m[2]), Name: string(util.UnescapePunctuations(m[1]))}
+}
+
+type statusParser struct{}
+
+func (p *statusParser) Trigger() []byte { return []byte{'{'} }
+
+func (p *statusParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+	m := statusPattern.FindSubmatch(line)
+	if m == nil {
+		return nil
+	}
+	color := strings.ToLower(string(m[2]))
+	if color == "" {
+		color = "neutral"
+	}
+	if !statusColors[color] {
+		return nil
+	}
+	block.Advance(len(m[0]))
+	return &statusNode{Label: strings.TrimSpace(string(util.UnescapePunctuations(m[1]))), Color: color}
+}
+
+type emojiParser struct{}
+
+func (p *emojiParser) Trigger() []byte { return []byte{':'} }
+
+func (p *emojiParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	// Colons in times such as 10:30:00 are not emoji
+	if isWordRune(block.PrecendingCharacter()) {
+		return nil
+	}
+	line, _ := block.PeekLine()
+	m := emojiPattern.FindSubmatch(line)
+	if m == nil {
+		return nil
+	}
+	if next, _ := utf8.DecodeRune(line[len(m[0]):]); isWordRune(next) {
+		return nil
+	}
+	block.Advance(len(m[0]))
+	return &emojiNode{ShortName: string(m[1])}
+}
+
+type dateParser struct{}
+
+func (p *dateParser) Trigger() []byte { return []byte{'<'} }
+
+func (p *dateParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+	m := datePattern.FindSubmatch(line)
+	if m == nil {
+		return nil
+	}
+	date, err := time.Parse("2006-01-02", string(m[1]))
+	if err != nil {
+		return nil
+	}
+	block.Advance(len(m[0]))
+	return &dateNode{Date: date}
+}
+
+// convertDialectNode converts a node parsed by the dialect extension. ADF
+// allows no formatting marks on these nodes, so marks are dropped.
+func (c *converter) convertDialectNode(n ast.Node) *Node {
+	switch node := n.(type) {
+	case *mentionNode:
+		return &Node{Type: "mention", Attrs: map[string]interface{}{
+			"id":   node.ID,
+			"text": "@" + node.Name,
+		}}
+	case *statusNode:
+		return &Node{Type: "status", Attrs: map[string]interface{}{
+			"text":    node.Label,
+			"color":   node.Color,
+			"localId": c.localID(),
+		}}
+	case *emojiNode:
+		return &Node{Type: "emoji", Attrs: map[string]interface{}{
+			"shortName": ":" + node.ShortName + ":",
+		}}
+	case *dateNode:
+		return &Node{Type: "date", Attrs: map[string]interface{}{
+			"timestamp": strconv.FormatInt(node.Date.UnixMilli(), 10),
+		}}
+	}
+	return nil
+}
+
+// panelPattern matches the GitHub alert marker that turns a blockquote into
+// a panel, as in "> [!WARNING]".
+var panelPattern = regexp.MustCompile(`^\[!([A-Za-z]+)\]`)
+
+// panelTypes maps alert markers to ADF panel types. GitHub's own alert
+// types are mapped to the closest panel.
+var panelTypes = map[string]string{
+	"info":      "info",
+	"note":      "note",
+	"tip":       "tip",
+	"success":   "success",
+	"warning":   "warning",
+	"error":     "error",
+	"important": "info",
+	"caution":   "error",
+}
+
+// panelType returns the panel type of a blockquote that starts with an
+// alert marker, and the length of the marker.
+func (c *converter) panelType(n *ast.Blockquote) (string, int) {
+	para, ok := n.FirstChild().(*ast.Paragraph)
+	if !ok || para.Lines().Len() == 0 {
+		return "", 0
+	}
+	segment := para.Lines().At(0)
+	line := segment.Value(c.source)
+	m := panelPattern.FindSubmatch(line)
+	if m == nil {
+		return "", 0
+	}
+	panelType, ok := panelTypes[strings.ToLower(string(m[1]))]
+	if !ok {
+		return "", 0
+	}
+	return panelType, len(m[0])
+}
+
+// stripPanelMarker removes the alert marker, and the space or line break
+// after it, from the start of a panel's first paragraph.
+func stripPanelMarker(content []*Node, marker int) []*Node {
+	if len(content) == 0 || content[0].Type != "paragraph" {
+		return content
+	}
+	para := content[0]
+	if len(para.Content) == 0 || para.Content[0].Type != "text" || len(para.Content[0].Text) < marker {
+		return content
+	}
+
+	first := *para.Content[0]
+	first.Text = strings.TrimLeftFunc(first.Text[marker:], unicode.IsSpace)
+	inline := para.Content[1:]
+	if first.Text != "" {
+		inline = append([]*Node{&first}, inline...)
+	} else if len(inline) > 0 && inline[0].Type == "hardBreak" {
+		inline = inline[1:]
+	}
+
+	if len(inline) == 0 {
+		return content[1:]
+	}
+	return append([]*Node{{Type: "paragraph", Content: inline}}, content[1:]...)
+}
+
+// localID returns a new localId attribute, which ADF requires on tasks and
+// status lozenges to tell them apart. IDs are numbered in document order,
+// so converting the same Markdown gives the same document.
+func (c *converter) localID() string {
+	c.ids++
+	return strconv.Itoa(c.ids)
+}
@@ -0,0 +1,538 @@
+package adf
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ToMarkdown renders the document as GitHub-flavored Markdown.
+//
+// Every node and mark produced by ToDocument is rendered so that converting
+// the result back with ToDocument gives the same document. Mentions, tasks,
+// panels, status lozenges, emoji and dates are written in the dialect that
+// ToDocument reads. Media is written as an image, ![alt](media:id), but is
+// not converted back, as the files are not downloaded.
+//
+// Underline, colors and other marks without a Markdown form are dropped,
+// keeping their text.
+func (d *Document) ToMarkdown() string {
+	if d == nil {
+		return ""
+	}
+	return renderBlocks(d.Content)
+}
+
+// renderBlocks renders block nodes separated by blank lines.
+func renderBlocks(nodes []*Node) string {
+	var blocks []string
+	for _, n := range nodes {
+		if s := renderBlock(n); s != "" {
+			blocks = append(blocks, s)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func renderBlock(n *Node) string {
+	switch n.Type {
+	case "paragraph":
+		return escapeLineStarts(renderInline(n.Content))
+	case "heading":
+		level := intAttr(n.Attrs, "level", 1)
+		level = max(1, min(level, 6))
+		return strings.Repeat("#", level) + " " + renderInline(n.Content)
+	case "bulletList", "orderedList", "taskList", "decisionList":
+		return renderList(n)
+	case "codeBlock":
+		return renderCodeBlock(n)
+	case "blockquote":
+		return quote(renderBlocks(n.Content))
+	case "panel":
+		panelType := strings.ToUpper(stringAttr(n.Attrs, "panelType"))
+		if panelType == "" {
+			panelType = "INFO"
+		}
+		return quote("[!" + panelType + "]\n" + renderBlocks(n.Content))
+	case "rule":
+		return "---"
+	case "table":
+		return renderTable(n)
+	case "mediaSingle", "mediaGroup":
+		var media []string
+		for _, child := range n.Content {
+			media = append(media, renderMedia(child))
+		}
+		return strings.Join(media, "\n")
+	case "media":
+		return renderMedia(n)
+	case "expand", "nestedExpand":
+		body := renderBlocks(n.Content)
+		if title := stringAttr(n.Attrs, "title"); title != "" {
+			return strings.TrimSuffix("**"+escapeText(title)+"**\n\n"+body, "\n\n")
+		}
+		return body
+	case "blockCard", "embedCard":
+		if url := stringAttr(n.Attrs, "url"); url != "" {
+			return "<" + url + ">"
+		}
+		return ""
+	case "text", "hardBreak", "mention", "emoji", "status", "date", "inlineCard", "mediaInline", "placeholder":
+		// Inline content directly in a block container
+		return escapeLineStarts(renderInline([]*Node{n}))
+	}
+
+	// Unknown containers such as layouts: render what they contain
+	if len(n.Content) > 0 {
+		if isInline(n.Content[0]) {
+			return escapeLineStarts(renderInline(n.Content))
+		}
+		return renderBlocks(n.Content)
+	}
+	return escapeText(n.Text)
+}
+
+func renderList(n *Node) string {
+	ordered := n.Type == "orderedList"
+	number := intAttr(n.Attrs, "order", 1)
+
+	var items []string
+	for _, item := range n.Content {
+		marker := "- "
+		var body string
+		switch {
+		case item.Type == "taskList":
+			// Nested task lists follow the item they belong to
+			nested := renderList(item)
+			if len(items) > 0 {
+				items[len(items)-1] += "\n  " + indent(nested, 2)
+			} else {
+				items = append(items, nested)
+			}
+			continue
+		case item.Type == "taskItem":
+			marker = "- [ ] "
+			if stringAttr(item.Attrs, "state") == "DONE" {
+				marker = "- [x] "
+			}
+			body = renderInline(item.Content)
+		case ordered:
+			marker = strconv.Itoa(number) + ". "
+			number++
+			body = renderListItem(item)
+		default:
+			body = renderListItem(item)
+		}
+		items = append(items, marker+indent(body, len(marker)))
+	}
+	return strings.Join(items, "\n")
+}
+
+// renderListItem renders the blocks of a list item. Nested lists directly
+// follow the preceding block so that the list stays tight.
+func renderListItem(item *Node) string {
+	var b strings.Builder
+	for i, child := range item.Content {
+		s := renderBlock(child)
+		if s == "" {
+			continue
+		}
+		if i > 0 && b.Len() > 0 {
+			if isList(child) {
+				b.WriteString("\n")
+			} else {
+				b.WriteString("\n\n")
+			}
+		}
+		b.WriteString(s)
+	}
+	return b.String()
+}
+
+func isList(n *Node) bool {
+	switch n.Type {
+	case "bulletList", "orderedList", "taskList", "decisionList":
+		return true
+	}
+	return false
+}
+
+func renderCodeBlock(n *Node) string {
+	var code strings.Builder
+	for _, child := range n.Content {
+		code.WriteString(child.Text)
+	}
+	text := code.String()
+
+	fence := "```"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	return fence + stringAttr(n.Attrs, "language") + "\n" + text + "\n" + fence
+}
+
+func renderTable(n *Node) string {
+	var rows [][]string
+	columns := 0
+	for _, row := range n.Content {
+		var cells []string
+		for _, cell := range row.Content {
+			text := renderBlocks(cell.Content)
+			text = strings.ReplaceAll(text, "\\\n", " ")
+			text = strings.ReplaceAll(text, "\n", " ")
+			text = strings.ReplaceAll(text, "|", "\\|")
+			cells = append(cells, text)
+		}
+		columns = max(columns, len(cells))
+		rows = append(rows, cells)
+	}
+	if len(rows) == 0 || columns == 0 {
+		return ""
+	}
+
+	// Markdown tables always have a header row, so the first row is used
+	// whether or not it holds tableHeader cells
+	var b strings.Builder
+	for i, cells := range rows {
+		for len(cells) < columns {
+			cells = append(cells, "")
+		}
+		b.WriteString("| " + strings.Join(cells, " | ") + " |")
+		if i == 0 {
+			b.WriteString("\n|" + strings.Repeat(" --- |", columns))
+		}
+		if i < len(rows)-1 {
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+func renderMedia(n *Node) string {
+	alt := stringAttr(n.Attrs, "alt")
+	if alt == "" {
+		alt = "attachment"
+	}
+	ref := "media:" + stringAttr(n.Attrs, "id")
+	if url := stringAttr(n.Attrs, "url"); url != "" {
+		ref = url
+	}
+	return "![" + escapeText(alt) + "](" + ref + ")"
+}
+
+// quote prefixes every line with "> ".
+func quote(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line == "" {
+			lines[i] = ">"
+		} else {
+			lines[i] = "> " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// indent indents every line but the first by n spaces.
+func indent(s string, n int) string {
+	pad := strings.Repeat(" ", n)
+	lines := strings.Split(s, "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = pad + lines[i]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markOrder is the nesting order of the marks with a Markdown form, from
+// outermost to innermost. Code is always innermost.
+var markOrder = map[string]int{"link": 0, "strong": 1, "em": 2, "strike": 3}
+
+// renderInline renders inline nodes. Marks shared by consecutive text
+// nodes are opened once, so **a *b*** is not split into separate runs, and
+// whitespace is kept outside of delimiters so that they stay valid.
+func renderInline(nodes []*Node) string {
+	var b strings.Builder
+	var open []*Mark
+	pending := "" // trailing whitespace, written once the next marks are known
+
+	closeTo := func(n int) {
+		for len(open) > n {
+			b.WriteString(closeDelim(open[len(open)-1]))
+			open = open[:len(open)-1]
+		}
+	}
+
+	for _, n := range mergeTextNodes(nodes) {
+		if n.Type != "text" {
+			closeTo(0)
+			b.WriteString(pending)
+			pending = ""
+			b.WriteString(renderInlineNode(n))
+			continue
+		}
+
+		marks, code := textMarks(n)
+		k := commonMarks(open, marks)
+
+		if code {
+			closeTo(k)
+			b.WriteString(pending)
+			pending = ""
+			for _, m := range marks[k:] {
+				b.WriteString(openDelim(m))
+				open = append(open, m)
+			}
+			b.WriteString(codeSpan(n.Text))
+			continue
+		}
+
+		// Autolinks read better than [url](url)
+		if len(marks) == 1 && marks[0].Type == "link" && stringAttr(marks[0].Attrs, "href") == n.Text && k == 0 {
+			closeTo(0)
+			b.WriteString(pending)
+			pending = ""
+			b.WriteString("<" + n.Text + ">")
+			continue
+		}
+
+		lines := strings.Split(n.Text, "\n")
+		for i, line := range lines {
+			if i > 0 {
+				closeTo(0)
+				b.WriteString(pending)
+				pending = ""
+				b.WriteString("\\\n")
+				k = 0
+			}
+			core := strings.TrimLeftFunc(line, unicode.IsSpace)
+			lead := line[:len(line)-len(core)]
+			core = strings.TrimRightFunc(core, unicode.IsSpace)
+			trail := line[len(lead)+len(core):]
+
+			if core == "" {
+				closeTo(k)
+				pending += line
+				continue
+			}
+			closeTo(k)
+			b.WriteString(pending + lead)
+			pending = ""
+			for _, m := range marks[k:] {
+				b.WriteString(openDelim(m))
+				open = append(open, m)
+			}
+			b.WriteString(escapeText(core))
+			pending = trail
+			k = len(marks)
+		}
+	}
+	closeTo(0)
+	b.WriteString(pending)
+	return b.String()
+}
+
+func renderInlineNode(n *Node) string {
+	switch n.Type {
+	case "hardBreak":
+		return "\\\n"
+	case "mention":
+		name := strings.TrimPrefix(stringAttr(n.Attrs, "text"), "@")
+		id := stringAttr(n.Attrs, "id")
+		if name == "" {
+			name = id
+		}
+		return "@[" + escapeText(name) + "](" + id + ")"
+	case "emoji":
+		name := stringAttr(n.Attrs, "shortName")
+		if name == "" {
+			return stringAttr(n.Attrs, "text")
+		}
+		return ":" + strings.Trim(name, ":") + ":"
+	case "status":
+		s := "{status:" + statusEscaper.Replace(stringAttr(n.Attrs, "text"))
+		if color := stringAttr(n.Attrs, "color"); color != "" {
+			s += "|" + color
+		}
+		return s + "}"
+	case "date":
+		ms, err := strconv.ParseInt(stringAttr(n.Attrs, "timestamp"), 10, 64)
+		if err != nil {
+			return stringAttr(n.Attrs, "timestamp")
+		}
+		return "<date:" + time.UnixMilli(ms).UTC().Format("2006-01-02") + ">"
+	case "inlineCard":
+		if url := stringAttr(n.Attrs, "url"); url != "" {
+			return "<" + url + ">"
+		}
+		return ""
+	case "mediaInline", "media":
+		return renderMedia(n)
+	case "placeholder":
+		return escapeText(stringAttr(n.Attrs, "text"))
+	}
+	return renderInline(n.Content) + escapeText(n.Text)
+}
+
+// statusEscaper escapes the characters that end a status lozenge's text.
+var statusEscaper = strings.NewReplacer(`\`, `\\`, "|", `\|`, "}", `\}`)
+
+func isInline(n *Node) bool {
+	switch n.Type {
+	case "text", "hardBreak", "mention", "emoji", "status", "date", "inlineCard", "mediaInline", "placeholder":
+		return true
+	}
+	return false
+}
+
+// textMarks returns the marks of a text node that have a Markdown form, in
+// markOrder, and whether it has the code mark.
+func textMarks(n *Node) ([]*Mark, bool) {
+	var marks []*Mark
+	code := false
+	for _, m := range n.Marks {
+		if m.Type == "code" {
+			code = true
+			continue
+		}
+		if _, ok := markOrder[m.Type]; ok {
+			marks = append(marks, m)
+		}
+	}
+	for i := 1; i < len(marks); i++ {
+		for j := i; j > 0 && markOrder[marks[j].Type] < markOrder[marks[j-1].Type]; j-- {
+			marks[j], marks[j-1] = marks[j-1], marks[j]
+		}
+	}
+	return marks, code
+}
+
+// commonMarks returns how many leading marks a and b share.
+func commonMarks(a, b []*Mark) int {
+	n := 0
+	for n < len(a) && n < len(b) && sameMark(a[n], b[n]) {
+		n++
+	}
+	return n
+}
+
+func sameMark(a, b *Mark) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	return a.Type != "link" || stringAttr(a.Attrs, "href") == stringAttr(b.Attrs, "href")
+}
+
+func openDelim(m *Mark) string {
+	switch m.Type {
+	case "link":
+		return "["
+	case "strong":
+		return "**"
+	case "em":
+		return "*"
+	case "strike":
+		return "~~"
+	}
+	return ""
+}
+
+func closeDelim(m *Mark) string {
+	if m.Type == "link" {
+		return "](" + stringAttr(m.Attrs, "href") + ")"
+	}
+	return openDelim(m)
+}
+
+// codeSpan wraps text in enough backticks to contain any it holds.
+func codeSpan(text string) string {
+	text = strings.ReplaceAll(text, "\n", " ")
+	fence := "`"
+	for strings.Contains(text, fence) {
+		fence += "`"
+	}
+	if strings.HasPrefix(text, "`") || strings.HasSuffix(text, "`") {
+		return fence + " " + text + " " + fence
+	}
+	return fence + text + fence
+}
+
+// escapeText backslash-escapes characters that Markdown, or the ADF dialect
+// of ToDocument, would otherwise read as formatting. Underscores inside
+// words are left alone, as GFM does not treat them as emphasis.
+func escapeText(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		switch r {
+		case '\\', '*', '`', '[', ']', '~', '<':
+			b.WriteByte('\\')
+		case ':':
+			prev, _ := utf8.DecodeLastRuneInString(s[:i])
+			if !isWordRune(prev) && emojiPattern.MatchString(s[i:]) {
+				b.WriteByte('\\')
+			}
+		case '{':
+			if strings.HasPrefix(s[i:], "{status:") {
+				b.WriteByte('\\')
+			}
+		case '_':
+			prev, _ := utf8.DecodeLastRuneInString(s[:i])
+			next, _ := utf8.DecodeRuneInString(s[i+1:])
+			if !isWordRune(prev) || !isWordRune(next) {
+				b.WriteByte('\\')
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func isWordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+var blockStart = regexp.MustCompile(`^(#|>|[-+=]|\d+[.)])`)
+
+// escapeLineStarts escapes text at the start of a line that would begin a
+// heading, quote, list or setext underline.
+func escapeLineStarts(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if m := blockStart.FindStringIndex(line); m != nil {
+			lines[i] = line[:m[1]-1] + "\\" + line[m[1]-1:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func stringAttr(attrs map[string]interface{}, key string) string {
+	switch v := attrs[key].(type) {
+	case string:
+		return v
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func intAttr(attrs map[string]interface{}, key string, def int) int {
+	switch v := attrs[key].(type) {
+	case int:
+		return v
+	case float64:
+		return int(v)
+	case string:
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+	}
+	return def
+}
@@ -0,0 +1,139 @@
+package adf
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToMarkdown_Nil(t *testing.T) {
+	var doc *Document
+	assert.Equal(t, "", doc.ToMarkdown())
+}
+
+func TestToMarkdown_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+	}{
+		{"paragraphs", "First paragraph\n\nSecond paragraph"},
+		{"headings", "# One\n\n## Two\n\n###### Six"},
+		{"marks", "**bold** *italic* ~~strike~~ `code` ***both***"},
+		{"nested marks", "**bold *and italic* text**"},
+		{"link", "See [the **docs**](https://example.com/docs) now"},
+		{"autolink", "Visit <https://example.com>"},
+		{"code in link", "[`cmd`](https://example.com)"},
+		{"backticks in code", "Use `` a`b `` here"},
+		{"escapes", `Literal \*stars\*, \[brackets\], snake_case and \_under\_`},
+		{"line starts", "\\# not a heading\n\n\\- not a list\n\n1\\. not ordered"},
+		{"hard break", "line one\\\nline two"},
+		{"bullet list", "- one\n- two\n- three"},
+		{"ordered list", "3. three\n4. four"},
+		{"nested list", "- parent\n  - child\n    1. grandchild\n- sibling"},
+		{"list with paragraphs", "- first\n\n  more of first\n- second"},
+		{"code block", "```go\nfunc main() {}\n```"},
+		{"code block with fence", "````\n```\nnested\n```\n````"},
+		{"plain code block", "```\nplain\n```"},
+		{"blockquote", "> quoted **text**\n>\n> second"},
+		{"nested blockquote", "> outer\n>\n> > inner"},
+		{"rule", "above\n\n---\n\nbelow"},
+		{"table", "| Name | Value |\n| --- | --- |\n| a \\| b | `x` |\n| c |  |"},
+		{"soft break", "one\ntwo"},
+		{"mention", "Ping @[Jane Doe](557058:f58131cb) please"},
+		{"task list", "- [ ] todo\n- [x] done\n  - [ ] nested"},
+		{"panel", "> [!WARNING]\n> Mind the **gap**\n>\n> - one"},
+		{"status", "State {status:In Progress|green} and {status:x \\| y}"},
+		{"emoji", "Nice :thumbsup: at 10:30:00"},
+		{"date", "Due <date:2026-10-17>"},
+		{"dialect escapes", `Literal \:smile: and \{status:no}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := ToDocument(tt.markdown)
+			md := doc.ToMarkdown()
+			again := ToDocument(md)
+			assert.Equal(t, adfJSON(t, doc), adfJSON(t, again), "markdown:\n%s", md)
+			assert.Equal(t, md, again.ToMarkdown(), "not stable")
+		})
+	}
+}
+
+func TestToMarkdown_Output(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{"marks share delimiters", "**a *b***", "**a *b***"},
+		{"whitespace outside delimiters", "**bold** text", "**bold** text"},
+		{"autolink", "<https://example.com>", "<https://example.com>"},
+		{"ordered start", "5. five", "5. five"},
+		{"table", "| A | B |\n|---|---|\n| 1 | 2 |", "| A | B |\n| --- | --- |\n| 1 | 2 |"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ToDocument(tt.markdown).ToMarkdown())
+		})
+	}
+}
+
+func TestToMarkdown_RichNodes(t *testing.T) {
+	doc := &Document{Type: "doc", Version: 1, Content: []*Node{
+		{Type: "paragraph", Content: []*Node{
+			{Type: "text", Text: "Hi "},
+			{Type: "mention", Attrs: map[string]interface{}{"id": "5b10a2844c20165700ede21g", "text": "@Jane Doe"}},
+			{Type: "text", Text: " "},
+			{Type: "emoji", Attrs: map[string]interface{}{"shortName": ":smile:", "text": "😄"}},
+			{Type: "text", Text: " "},
+			{Type: "status", Attrs: map[string]interface{}{"text": "In Progress", "color": "blue"}},
+			{Type: "text", Text: " due "},
+			{Type: "date", Attrs: map[string]interface{}{"timestamp": "1792195200000"}},
+			{Type: "text", Text: " see "},
+			{Type: "inlineCard", Attrs: map[string]interface{}{"url": "https://example.atlassian.net/browse/PROJ-1"}},
+		}},
+		{Type: "taskList", Attrs: map[string]interface{}{"localId": "a"}, Content: []*Node{
+			{Type: "taskItem", Attrs: map[string]interface{}{"state": "TODO"}, Content: []*Node{{Type: "text", Text: "Write tests"}}},
+			{Type: "taskItem", Attrs: map[string]interface{}{"state": "DONE"}, Content: []*Node{{Type: "text", Text: "Ship it"}}},
+		}},
+		{Type: "panel", Attrs: map[string]interface{}{"panelType": "warning"}, Content: []*Node{
+			{Type: "paragraph", Content: []*Node{{Type: "text", Text: "Careful", Marks: []*Mark{{Type: "strong"}, {Type: "underline"}}}}},
+		}},
+		{Type: "mediaSingle", Content: []*Node{
+			{Type: "media", Attrs: map[string]interface{}{"id": "abc-123", "type": "file", "alt": "screenshot.png"}},
+		}},
+		{Type: "expand", Attrs: map[string]interface{}{"title": "Details"}, Content: []*Node{
+			{Type: "paragraph", Content: []*Node{{Type: "text", Text: "Hidden"}}},
+		}},
+	}}
+
+	want := "Hi @[Jane Doe](5b10a2844c20165700ede21g) :smile: {status:In Progress|blue} due <date:2026-10-17> see <https://example.atlassian.net/browse/PROJ-1>\n\n" +
+		"- [ ] Write tests\n- [x] Ship it\n\n" +
+		"> [!WARNING]\n> **Careful**\n\n" +
+		"![screenshot.png](media:abc-123)\n\n" +
+		"**Details**\n\nHidden"
+	assert.Equal(t, want, doc.ToMarkdown())
+}
+
+func TestToMarkdown_DecodedJSON(t *testing.T) {
+	// Attributes decoded from JSON are float64
+	var doc Document
+	require.NoError(t, json.Unmarshal([]byte(`{"type":"doc","version":1,"content":[
+		{"type":"heading","attrs":{"level":3},"content":[{"type":"text","text":"Title"}]},
+		{"type":"orderedList","attrs":{"order":2},"content":[
+			{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"two"}]}]}]},
+		{"type":"paragraph","content":[{"type":"text","text":"multi\nline"}]}
+	]}`), &doc))
+
+	assert.Equal(t, "### Title\n\n2. two\n\nmulti\\\nline", doc.ToMarkdown())
+}
+
+func adfJSON(t *testing.T, doc *Document) string {
+	t.Helper()
+	data, err := json.Marshal(doc)
+	require.NoError(t, err)
+	return string(data)
+}
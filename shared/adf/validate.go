@@ -0,0 +1,573 @@
+package adf
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Problem is a place where a document does not follow the ADF schema.
+type Problem struct {
+	// Path is a JSON pointer to the offending value, such as
+	// /content/0/attrs/level. It is empty for the document itself.
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (p Problem) String() string {
+	if p.Path == "" {
+		return "document: " + p.Message
+	}
+	return p.Path + ": " + p.Message
+}
+
+// ValidationError reports the problems found in a document.
+type ValidationError struct {
+	Problems []Problem
+}
+
+func (e *ValidationError) Error() string {
+	var b strings.Builder
+	b.WriteString("invalid ADF document:")
+	for _, p := range e.Problems {
+		b.WriteString("\n  " + p.String())
+	}
+	return b.String()
+}
+
+// Check validates doc and returns a *ValidationError listing its problems,
+// or nil if it has none.
+func Check(doc *Document) error {
+	if problems := Validate(doc); len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// ParseJSON decodes an ADF document. Unlike json.Unmarshal, it rejects
+// fields that ADF does not have, such as a misspelled "attrs", which would
+// otherwise be dropped without notice.
+func ParseJSON(data []byte) (*Document, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	var doc Document
+	if err := dec.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid ADF JSON: %w", err)
+	}
+	return &doc, nil
+}
+
+// Validate checks doc against the structure of the ADF schema: which nodes
+// may contain which, the attributes each node requires and their values,
+// and the marks allowed on each node. Problems are reported in document
+// order. Attributes the validator does not know about are not reported.
+func Validate(doc *Document) []Problem {
+	if doc == nil {
+		return []Problem{{Message: "document is missing"}}
+	}
+
+	v := &validator{}
+	if doc.Type != "doc" {
+		v.report("/type", `must be "doc", got %q`, doc.Type)
+	}
+	if doc.Version != 1 {
+		v.report("/version", "must be 1, got %d", doc.Version)
+	}
+	v.children("", "doc", doc.Content, topLevelBlocks, 0)
+	return v.problems
+}
+
+type validator struct {
+	problems []Problem
+}
+
+func (v *validator) report(path, format string, args ...interface{}) {
+	v.problems = append(v.problems, Problem{Path: path, Message: fmt.Sprintf(format, args...)})
+}
+
+// children checks the content of a node of type parent.
+func (v *validator) children(path, parent string, nodes []*Node, allowed []string, min int) {
+	if len(nodes) < min {
+		v.report(path, "%s must contain at least %d node(s)", parent, min)
+	}
+	for i, n := range nodes {
+		v.node(fmt.Sprintf("%s/content/%d", path, i), parent, n, allowed)
+	}
+}
+
+func (v *validator) node(path, parent string, n *Node, allowed []string) {
+	if n == nil {
+		v.report(path, "node is null")
+		return
+	}
+	spec, ok := nodeSpecs[n.Type]
+	if !ok {
+		if n.Type == "" {
+			v.report(path+"/type", "node type is missing")
+		} else {
+			v.report(path+"/type", "unknown node type %q", n.Type)
+		}
+		return
+	}
+	if !contains(allowed, n.Type) {
+		v.report(path, "%s is not allowed in %s", n.Type, parent)
+	}
+
+	for _, name := range sortedKeys(spec.attrs) {
+		v.attr(path+"/attrs/"+name, name, n.Attrs[name], spec.attrs[name])
+	}
+	if spec.check != nil {
+		if name, msg := spec.check(n); msg != "" {
+			v.report(path+"/attrs/"+name, "%s", msg)
+		}
+	}
+
+	if n.Type == "text" {
+		if n.Text == "" {
+			v.report(path+"/text", "text nodes must not be empty")
+		}
+	} else if n.Text != "" {
+		v.report(path+"/text", "only text nodes have text")
+	}
+
+	v.marks(path, n, spec.marks)
+
+	if spec.content == nil {
+		if len(n.Content) > 0 {
+			v.report(path+"/content", "%s nodes cannot have content", n.Type)
+		}
+		return
+	}
+	v.children(path, n.Type, n.Content, spec.content, spec.minContent)
+}
+
+func (v *validator) marks(path string, n *Node, allowed []string) {
+	seen := map[string]bool{}
+	hasCode := false
+	for i, m := range n.Marks {
+		mpath := fmt.Sprintf("%s/marks/%d", path, i)
+		if m == nil {
+			v.report(mpath, "mark is null")
+			continue
+		}
+		spec, ok := markSpecs[m.Type]
+		if !ok {
+			v.report(mpath+"/type", "unknown mark type %q", m.Type)
+			continue
+		}
+		if !contains(allowed, m.Type) {
+			v.report(mpath, "%s marks are not allowed on %s", m.Type, n.Type)
+			continue
+		}
+		if seen[m.Type] {
+			v.report(mpath, "duplicate %s mark", m.Type)
+		}
+		seen[m.Type] = true
+		if m.Type == "code" {
+			hasCode = true
+		}
+		for _, name := range sortedKeys(spec) {
+			v.attr(mpath+"/attrs/"+name, name, m.Attrs[name], spec[name])
+		}
+	}
+
+	// Code is only combined with links and annotations
+	if hasCode {
+		for i, m := range n.Marks {
+			if m != nil && m.Type != "code" && m.Type != "link" && m.Type != "annotation" && markSpecs[m.Type] != nil {
+				v.report(fmt.Sprintf("%s/marks/%d", path, i), "%s marks cannot be combined with code", m.Type)
+			}
+		}
+	}
+}
+
+func (v *validator) attr(path, name string, value interface{}, spec attrSpec) {
+	if value == nil {
+		if spec.required {
+			v.report(path, "required attribute %s is missing", name)
+		}
+		return
+	}
+
+	switch spec.kind {
+	case attrString:
+		s, ok := value.(string)
+		if !ok {
+			v.report(path, "must be a string")
+			return
+		}
+		if len(spec.enum) > 0 && !contains(spec.enum, s) {
+			v.report(path, "must be one of %s, got %q", strings.Join(spec.enum, ", "), s)
+		}
+		if spec.pattern != nil && !spec.pattern.MatchString(s) {
+			v.report(path, "invalid value %q", s)
+		}
+	case attrInt:
+		i, ok := intValue(value)
+		if !ok {
+			v.report(path, "must be an integer")
+			return
+		}
+		if i < spec.min || (spec.max > 0 && i > spec.max) {
+			if spec.max > 0 {
+				v.report(path, "must be between %d and %d, got %d", spec.min, spec.max, i)
+			} else {
+				v.report(path, "must be at least %d, got %d", spec.min, i)
+			}
+		}
+	case attrNumber:
+		if _, ok := numberValue(value); !ok {
+			v.report(path, "must be a number")
+		}
+	case attrBool:
+		if _, ok := value.(bool); !ok {
+			v.report(path, "must be true or false")
+		}
+	}
+}
+
+type attrKind int
+
+const (
+	attrString attrKind = iota
+	attrInt
+	attrNumber
+	attrBool
+	attrAny
+)
+
+type attrSpec struct {
+	kind     attrKind
+	required bool
+	enum     []string
+	pattern  *regexp.Regexp
+	min, max int // bounds of integers; a max of 0 means no upper bound
+}
+
+type nodeSpec struct {
+	content    []string // node types allowed as children; nil for leaf nodes
+	minContent int
+	attrs      map[string]attrSpec
+	marks      []string
+	// check reports a problem involving several attributes, naming the
+	// attribute to report it on
+	check func(n *Node) (attr, message string)
+}
+
+var (
+	inlineNodes = []string{
+		"text", "hardBreak", "mention", "emoji", "status", "date",
+		"inlineCard", "placeholder", "mediaInline", "inlineExtension",
+	}
+	textMarkTypes = []string{
+		"code", "em", "link", "strike", "strong", "subsup",
+		"textColor", "underline", "backgroundColor", "annotation",
+	}
+	topLevelBlocks = []string{
+		"paragraph", "heading", "bulletList", "orderedList", "taskList",
+		"decisionList", "codeBlock", "blockquote", "panel", "rule", "table",
+		"mediaSingle", "mediaGroup", "expand", "blockCard", "embedCard",
+		"layoutSection", "extension", "bodiedExtension",
+	}
+	listItemBlocks = []string{
+		"paragraph", "bulletList", "orderedList", "taskList", "codeBlock",
+		"mediaSingle", "extension",
+	}
+	quoteBlocks = []string{
+		"paragraph", "bulletList", "orderedList", "codeBlock", "mediaSingle",
+		"mediaGroup", "extension",
+	}
+	panelBlocks = []string{
+		"paragraph", "heading", "bulletList", "orderedList", "taskList",
+		"decisionList", "codeBlock", "rule", "mediaGroup", "mediaSingle",
+		"blockCard", "extension",
+	}
+	cellBlocks = []string{
+		"paragraph", "heading", "bulletList", "orderedList", "taskList",
+		"decisionList", "codeBlock", "blockquote", "panel", "rule",
+		"mediaGroup", "mediaSingle", "blockCard", "embedCard", "extension",
+		"nestedExpand",
+	}
+	expandBlocks = append(append([]string{}, cellBlocks...), "table")
+	columnBlocks = without(topLevelBlocks, "layoutSection")
+)
+
+var (
+	hexColor = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	digits   = regexp.MustCompile(`^\d+$`)
+)
+
+// localIDAttrs are the attributes of nodes that have only a localId.
+var localIDAttrs = map[string]attrSpec{"localId": {kind: attrString}}
+
+var nodeSpecs = map[string]*nodeSpec{
+	"paragraph": {content: inlineNodes, attrs: localIDAttrs, marks: []string{"alignment", "indentation"}},
+	"heading": {
+		content: inlineNodes,
+		attrs:   map[string]attrSpec{"level": {kind: attrInt, required: true, min: 1, max: 6}, "localId": {kind: attrString}},
+		marks:   []string{"alignment", "indentation"},
+	},
+	"bulletList":  {content: []string{"listItem"}, minContent: 1, attrs: localIDAttrs},
+	"orderedList": {content: []string{"listItem"}, minContent: 1, attrs: map[string]attrSpec{"order": {kind: attrInt}, "localId": {kind: attrString}}},
+	"listItem":    {content: listItemBlocks, minContent: 1, attrs: localIDAttrs},
+	"taskList": {
+		content: []string{"taskItem", "taskList"}, minContent: 1,
+		attrs: map[string]attrSpec{"localId": {kind: attrString, required: true}},
+	},
+	"taskItem": {
+		content: inlineNodes,
+		attrs: map[string]attrSpec{
+			"localId": {kind: attrString, required: true},
+			"state":   {kind: attrString, required: true, enum: []string{"TODO", "DONE"}},
+		},
+	},
+	"decisionList": {
+		content: []string{"decisionItem"}, minContent: 1,
+		attrs: map[string]attrSpec{"localId": {kind: attrString, required: true}},
+	},
+	"decisionItem": {
+		content: inlineNodes,
+		attrs: map[string]attrSpec{
+			"localId": {kind: attrString, required: true},
+			"state":   {kind: attrString, required: true},
+		},
+	},
+	"codeBlock": {
+		content: []string{"text"},
+		attrs:   map[string]attrSpec{"language": {kind: attrString}, "localId": {kind: attrString}},
+		marks:   []string{"breakout"},
+	},
+	"blockquote": {content: quoteBlocks, minContent: 1, attrs: localIDAttrs},
+	"panel": {
+		content: panelBlocks, minContent: 1,
+		attrs: map[string]attrSpec{
+			"panelType":  {kind: attrString, required: true, enum: []string{"info", "note", "tip", "warning", "error", "success", "custom"}},
+			"panelColor": {kind: attrString},
+			"panelIcon":  {kind: attrString},
+		},
+	},
+	"rule": {attrs: localIDAttrs},
+	"table": {
+		content: []string{"tableRow"}, minContent: 1,
+		attrs: map[string]attrSpec{
+			"layout":                {kind: attrString, enum: []string{"default", "center", "wide", "full-width", "align-start", "align-end"}},
+			"displayMode":           {kind: attrString, enum: []string{"default", "fixed"}},
+			"isNumberColumnEnabled": {kind: attrBool},
+			"width":                 {kind: attrNumber},
+			"localId":               {kind: attrString},
+		},
+	},
+	"tableRow":    {content: []string{"tableCell", "tableHeader"}, minContent: 1, attrs: localIDAttrs},
+	"tableCell":   {content: cellBlocks, minContent: 1, attrs: cellAttrs},
+	"tableHeader": {content: cellBlocks, minContent: 1, attrs: cellAttrs},
+	"mediaSingle": {
+		content: []string{"media", "caption"}, minContent: 1,
+		attrs: map[string]attrSpec{
+			"layout":    {kind: attrString, enum: []string{"wrap-left", "center", "wrap-right", "wide", "full-width", "align-start", "align-end"}},
+			"width":     {kind: attrNumber},
+			"widthType": {kind: attrString, enum: []string{"percentage", "pixel"}},
+		},
+		marks: []string{"link"},
+	},
+	"mediaGroup": {content: []string{"media"}, minContent: 1},
+	"media":      {attrs: mediaAttrs, marks: []string{"link", "annotation", "border"}, check: checkMedia},
+	"caption":    {content: inlineNodes, attrs: localIDAttrs},
+	"expand": {
+		content: expandBlocks, minContent: 1,
+		attrs: map[string]attrSpec{"title": {kind: attrString}, "localId": {kind: attrString}},
+		marks: []string{"breakout"},
+	},
+	"nestedExpand": {
+		content: cellBlocks, minContent: 1,
+		attrs: map[string]attrSpec{"title": {kind: attrString}, "localId": {kind: attrString}},
+	},
+	"blockCard":     {attrs: cardAttrs, check: checkCard},
+	"embedCard":     {attrs: cardAttrs, check: checkCard},
+	"layoutSection": {content: []string{"layoutColumn"}, minContent: 1, attrs: localIDAttrs, marks: []string{"breakout"}},
+	"layoutColumn": {
+		content: columnBlocks, minContent: 1,
+		attrs: map[string]attrSpec{"width": {kind: attrNumber, required: true}, "localId": {kind: attrString}},
+	},
+	"extension":       {attrs: extensionAttrs},
+	"bodiedExtension": {content: without(topLevelBlocks, "bodiedExtension", "extension", "layoutSection"), minContent: 1, attrs: extensionAttrs},
+	"inlineExtension": {attrs: extensionAttrs},
+
+	"text":      {marks: textMarkTypes},
+	"hardBreak": {attrs: map[string]attrSpec{"text": {kind: attrString}}},
+	"mention": {
+		attrs: map[string]attrSpec{
+			"id":          {kind: attrString, required: true},
+			"text":        {kind: attrString},
+			"accessLevel": {kind: attrString},
+			"userType":    {kind: attrString, enum: []string{"DEFAULT", "SPECIAL", "APP"}},
+		},
+		marks: []string{"annotation"},
+	},
+	"emoji": {
+		attrs: map[string]attrSpec{
+			"shortName": {kind: attrString, required: true},
+			"id":        {kind: attrString},
+			"text":      {kind: attrString},
+		},
+		marks: []string{"annotation"},
+	},
+	"status": {
+		attrs: map[string]attrSpec{
+			"text":    {kind: attrString, required: true},
+			"color":   {kind: attrString, required: true, enum: []string{"neutral", "purple", "blue", "red", "yellow", "green"}},
+			"localId": {kind: attrString},
+			"style":   {kind: attrString},
+		},
+		marks: []string{"annotation"},
+	},
+	"date": {
+		attrs: map[string]attrSpec{"timestamp": {kind: attrString, required: true, pattern: digits}},
+		marks: []string{"annotation"},
+	},
+	"inlineCard":  {attrs: cardAttrs, check: checkCard, marks: []string{"annotation"}},
+	"placeholder": {attrs: map[string]attrSpec{"text": {kind: attrString, required: true}}},
+	"mediaInline": {attrs: mediaAttrs, marks: []string{"link", "annotation", "border"}, check: checkMedia},
+}
+
+var cellAttrs = map[string]attrSpec{
+	"colspan":    {kind: attrInt, min: 1},
+	"rowspan":    {kind: attrInt, min: 1},
+	"colwidth":   {kind: attrAny},
+	"background": {kind: attrString},
+	"localId":    {kind: attrString},
+}
+
+var mediaAttrs = map[string]attrSpec{
+	"type":       {kind: attrString, required: true, enum: []string{"file", "link", "external"}},
+	"id":         {kind: attrString},
+	"collection": {kind: attrString},
+	"url":        {kind: attrString},
+	"alt":        {kind: attrString},
+	"width":      {kind: attrNumber},
+	"height":     {kind: attrNumber},
+}
+
+var cardAttrs = map[string]attrSpec{
+	"url":  {kind: attrString},
+	"data": {kind: attrAny},
+}
+
+var extensionAttrs = map[string]attrSpec{
+	"extensionKey":  {kind: attrString, required: true},
+	"extensionType": {kind: attrString, required: true},
+	"parameters":    {kind: attrAny},
+	"text":          {kind: attrString},
+	"layout":        {kind: attrString},
+	"localId":       {kind: attrString},
+}
+
+// markSpecs are the attributes of each mark type.
+var markSpecs = map[string]map[string]attrSpec{
+	"code":   {},
+	"em":     {},
+	"strike": {},
+	"strong": {},
+	"link": {
+		"href":  {kind: attrString, required: true},
+		"title": {kind: attrString},
+	},
+	"subsup":          {"type": {kind: attrString, required: true, enum: []string{"sub", "sup"}}},
+	"textColor":       {"color": {kind: attrString, required: true, pattern: hexColor}},
+	"backgroundColor": {"color": {kind: attrString, required: true, pattern: hexColor}},
+	"underline":       {},
+	"annotation": {
+		"id":             {kind: attrString, required: true},
+		"annotationType": {kind: attrString, required: true, enum: []string{"inlineComment"}},
+	},
+	"alignment":   {"align": {kind: attrString, required: true, enum: []string{"center", "end"}}},
+	"indentation": {"level": {kind: attrInt, required: true, min: 1, max: 6}},
+	"breakout":    {"mode": {kind: attrString, required: true, enum: []string{"wide", "full-width"}}},
+	"border": {
+		"size":  {kind: attrNumber, required: true},
+		"color": {kind: attrString, required: true},
+	},
+}
+
+// checkMedia requires the attribute that locates a media item: a URL for
+// external media, and an ID otherwise.
+func checkMedia(n *Node) (string, string) {
+	if stringAttr(n.Attrs, "type") == "external" {
+		if stringAttr(n.Attrs, "url") == "" {
+			return "url", "external media requires a url"
+		}
+	} else if stringAttr(n.Attrs, "id") == "" {
+		return "id", "media requires an id"
+	}
+	return "", ""
+}
+
+func checkCard(n *Node) (string, string) {
+	if n.Attrs["url"] == nil && n.Attrs["data"] == nil {
+		return "url", "cards require a url or data"
+	}
+	return "", ""
+}
+
+// intValue returns an attribute value as an integer. Values decoded from
+// JSON are float64.
+func intValue(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case int:
+		return v, true
+	case float64:
+		if v == float64(int(v)) {
+			return int(v), true
+		}
+	case json.Number:
+		if i, err := strconv.Atoi(v.String()); err == nil {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+func numberValue(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func sortedKeys(attrs map[string]attrSpec) []string {
+	keys := make([]string, 0, len(attrs))
+	for k := range attrs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func without(list []string, remove ...string) []string {
+	var result []string
+	for _, item := range list {
+		if !contains(remove, item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,151 @@
+package adf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate_ConvertedMarkdown(t *testing.T) {
+	inputs := []string{
+		"# Title\n\nSome **bold** and `code` with a [link](https://example.com)",
+		"- one\n  - nested\n1. first\n2. second",
+		"| A | B |\n|---|---|\n| 1 |  |",
+		"```\n```",
+		"```go\nfunc main() {}\n```",
+		"> quote\n\n---",
+		"@[Jane](abc123) {status:Done|green} :tada: <date:2026-10-17>",
+		"- [ ] todo\n- [x] done\n  - [ ] nested",
+		"> [!WARNING]\n> Careful\n>\n> - with a list",
+		"line one  \nline two",
+	}
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			assert.Empty(t, Validate(ToDocument(input)))
+		})
+	}
+}
+
+func TestValidate_Problems(t *testing.T) {
+	paragraph := func(content ...*Node) *Node { return &Node{Type: "paragraph", Content: content} }
+	text := func(s string, marks ...*Mark) *Node { return &Node{Type: "text", Text: s, Marks: marks} }
+
+	tests := []struct {
+		name    string
+		content []*Node
+		want    Problem
+	}{
+		{
+			"unknown node",
+			[]*Node{{Type: "widget"}},
+			Problem{"/content/0/type", `unknown node type "widget"`},
+		},
+		{
+			"inline at top level",
+			[]*Node{text("loose")},
+			Problem{"/content/0", "text is not allowed in doc"},
+		},
+		{
+			"block in paragraph",
+			[]*Node{paragraph(&Node{Type: "rule"})},
+			Problem{"/content/0/content/0", "rule is not allowed in paragraph"},
+		},
+		{
+			"empty text",
+			[]*Node{paragraph(text(""))},
+			Problem{"/content/0/content/0/text", "text nodes must not be empty"},
+		},
+		{
+			"heading level",
+			[]*Node{{Type: "heading", Attrs: map[string]interface{}{"level": float64(7)}}},
+			Problem{"/content/0/attrs/level", "must be between 1 and 6, got 7"},
+		},
+		{
+			"missing attribute",
+			[]*Node{{Type: "heading"}},
+			Problem{"/content/0/attrs/level", "required attribute level is missing"},
+		},
+		{
+			"enum",
+			[]*Node{{Type: "panel", Attrs: map[string]interface{}{"panelType": "danger"}, Content: []*Node{paragraph(text("x"))}}},
+			Problem{"/content/0/attrs/panelType", `must be one of info, note, tip, warning, error, success, custom, got "danger"`},
+		},
+		{
+			"empty list",
+			[]*Node{{Type: "bulletList"}},
+			Problem{"/content/0", "bulletList must contain at least 1 node(s)"},
+		},
+		{
+			"link without href",
+			[]*Node{paragraph(text("x", &Mark{Type: "link"}))},
+			Problem{"/content/0/content/0/marks/0/attrs/href", "required attribute href is missing"},
+		},
+		{
+			"code with strong",
+			[]*Node{paragraph(text("x", &Mark{Type: "code"}, &Mark{Type: "strong"}))},
+			Problem{"/content/0/content/0/marks/1", "strong marks cannot be combined with code"},
+		},
+		{
+			"mark on wrong node",
+			[]*Node{{Type: "rule", Marks: []*Mark{{Type: "strong"}}}},
+			Problem{"/content/0/marks/0", "strong marks are not allowed on rule"},
+		},
+		{
+			"leaf with content",
+			[]*Node{paragraph(&Node{Type: "hardBreak", Content: []*Node{text("x")}})},
+			Problem{"/content/0/content/0/content", "hardBreak nodes cannot have content"},
+		},
+		{
+			"media without id",
+			[]*Node{{Type: "mediaGroup", Content: []*Node{{Type: "media", Attrs: map[string]interface{}{"type": "file"}}}}},
+			Problem{"/content/0/content/0/attrs/id", "media requires an id"},
+		},
+		{
+			"date timestamp",
+			[]*Node{paragraph(&Node{Type: "date", Attrs: map[string]interface{}{"timestamp": "2026-10-17"}})},
+			Problem{"/content/0/content/0/attrs/timestamp", `invalid value "2026-10-17"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc := &Document{Type: "doc", Version: 1, Content: tt.content}
+			assert.Equal(t, []Problem{tt.want}, Validate(doc))
+		})
+	}
+}
+
+func TestValidate_Document(t *testing.T) {
+	assert.Equal(t, []Problem{{Message: "document is missing"}}, Validate(nil))
+
+	problems := Validate(&Document{Type: "page", Version: 2})
+	assert.Equal(t, []Problem{
+		{"/type", `must be "doc", got "page"`},
+		{"/version", "must be 1, got 2"},
+	}, problems)
+}
+
+func TestCheck(t *testing.T) {
+	assert.NoError(t, Check(ToDocument("fine")))
+
+	err := Check(&Document{Type: "doc", Version: 1, Content: []*Node{{Type: "widget"}, {Type: "bulletList"}}})
+	var verr *ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.Len(t, verr.Problems, 2)
+	assert.Equal(t, "invalid ADF document:\n"+
+		"  /content/0/type: unknown node type \"widget\"\n"+
+		"  /content/1: bulletList must contain at least 1 node(s)", err.Error())
+}
+
+func TestParseJSON(t *testing.T) {
+	doc, err := ParseJSON([]byte(`{"type":"doc","version":1,"content":[{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Hi"}]}]}`))
+	require.NoError(t, err)
+	assert.Empty(t, Validate(doc))
+
+	_, err = ParseJSON([]byte(`{"type":"doc","version":1,"content":[{"type":"heading","atrs":{"level":2}}]}`))
+	assert.ErrorContains(t, err, `unknown field "atrs"`)
+
+	_, err = ParseJSON([]byte(`{"type":`))
+	assert.ErrorContains(t, err, "invalid ADF JSON")
+}
@@ -1,4 +1,5 @@
-// Package auth provides authentication utilities for Atlassian APIs.
+// Package auth provides authentication for Atlassian APIs: Basic, bearer
+// token and OAuth 2.0 (3LO) authenticators, and storage for their secrets.
 package auth
 
 import (
@@ -2,6 +2,8 @@ package auth
 
 import (
 	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 )
@@ -66,3 +68,37 @@ func TestBasicAuthHeader(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthenticators(t *testing.T) {
+	tests := []struct {
+		name  string
+		authn Authenticator
+		want  string
+	}{
+		{"basic", &Basic{Email: "user@example.com", APIToken: "token"}, BasicAuthHeader("user@example.com", "token")},
+		{"bearer", &Bearer{Token: "pat"}, "Bearer pat"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "https://example.atlassian.net/", nil)
+			if err := tt.authn.Authorize(req); err != nil {
+				t.Fatalf("Authorize() error = %v", err)
+			}
+			if got := req.Header.Get("Authorization"); got != tt.want {
+				t.Errorf("Authorization = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateMethod(t *testing.T) {
+	for _, m := range append([]string{""}, Methods...) {
+		if err := ValidateMethod(m); err != nil {
+			t.Errorf("ValidateMethod(%q) error = %v", m, err)
+		}
+	}
+	if err := ValidateMethod("kerberos"); err == nil {
+		t.Error("ValidateMethod(\"kerberos\") should fail")
+	}
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Authentication methods, as stored in tool configs.
+const (
+	// MethodBasic authenticates with an account email and API token.
+	MethodBasic = "basic"
+
+	// MethodBearer authenticates with a bearer token, such as a Jira or
+	// Confluence Data Center personal access token.
+	MethodBearer = "bearer"
+
+	// MethodOAuth authenticates with an OAuth 2.0 (3LO) access token,
+	// refreshed as needed. Requests go through the api.atlassian.com gateway.
+	MethodOAuth = "oauth"
+)
+
+// Methods lists the supported authentication methods.
+var Methods = []string{MethodBasic, MethodBearer, MethodOAuth}
+
+// ValidateMethod checks that method is a supported authentication method.
+// Empty is accepted and means MethodBasic.
+func ValidateMethod(method string) error {
+	switch method {
+	case "", MethodBasic, MethodBearer, MethodOAuth:
+		return nil
+	}
+	return fmt.Errorf("unknown auth method %q (use basic, bearer, or oauth)", method)
+}
+
+// Authenticator adds credentials to outgoing API requests.
+type Authenticator interface {
+	// Authorize sets the credentials on req, typically its Authorization
+	// header. It may block to refresh expired credentials, honouring the
+	// request context.
+	Authorize(req *http.Request) error
+}
+
+// Basic authenticates with an Atlassian account email and API token.
+type Basic struct {
+	Email    string
+	APIToken string
+}
+
+// Authorize implements Authenticator.
+func (a *Basic) Authorize(req *http.Request) error {
+	req.Header.Set("Authorization", BasicAuthHeader(a.Email, a.APIToken))
+	return nil
+}
+
+// Bearer authenticates with a static bearer token, such as a Data Center
+// personal access token.
+type Bearer struct {
+	Token string
+}
+
+// Authorize implements Authenticator.
+func (a *Bearer) Authorize(req *http.Request) error {
+	req.Header.Set("Authorization", BearerAuthHeader(a.Token))
+	return nil
+}
+
+// BearerAuthHeader returns the HTTP Bearer Authentication header value
+// for token.
+func BearerAuthHeader(token string) string {
+	return "Bearer " + token
+}
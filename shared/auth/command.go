@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// CommandStore delegates secret storage to an external helper program, in
+// the style of git credential helpers. This allows tokens to live in pass(1),
+// 1Password, Vault and the like.
+//
+// The helper is run through the shell as "<Command> <action>", where action
+// is get, store or erase. It receives key=value lines on stdin:
+//
+//	service=jira-ticket-cli
+//	account=me@example.com@example.atlassian.net
+//	secret=...            (store only)
+//
+// For get, the helper prints "secret=<value>" on stdout, or nothing if it
+// has no secret for the account. A non-zero exit status is an error.
+type CommandStore struct {
+	// Command is the helper command line, e.g. "atlassian-pass".
+	Command string
+}
+
+// Name implements Store.
+func (s *CommandStore) Name() string { return BackendCommand + ":" + s.Command }
+
+// Get implements Store.
+func (s *CommandStore) Get(service, account string) (string, error) {
+	out, err := s.run("get", service, account, "")
+	if err != nil {
+		return "", err
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		if v, ok := strings.CutPrefix(scanner.Text(), "secret="); ok && v != "" {
+			return v, nil
+		}
+	}
+	return "", ErrNotFound
+}
+
+// Set implements Store.
+func (s *CommandStore) Set(service, account, secret string) error {
+	if strings.ContainsAny(secret, "\r\n") {
+		return errors.New("secret must not contain newlines")
+	}
+	_, err := s.run("store", service, account, secret)
+	return err
+}
+
+// Delete implements Store.
+func (s *CommandStore) Delete(service, account string) error {
+	_, err := s.run("erase", service, account, "")
+	return err
+}
+
+func (s *CommandStore) run(action, service, account, secret string) ([]byte, error) {
+	var input strings.Builder
+	fmt.Fprintf(&input, "service=%s\naccount=%s\n", service, account)
+	if secret != "" {
+		fmt.Fprintf(&input, "secret=%s\n", secret)
+	}
+
+	line := s.Command + " " + action
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", line)
+	} else {
+		cmd = exec.Command("sh", "-c", line)
+	}
+	cmd.Stdin = strings.NewReader(input.String())
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			msg = err.Error()
+		}
+		return nil, fmt.Errorf("credential helper %q %s failed: %s", s.Command, action, msg)
+	}
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// EnvCredentialPassphrase supplies the passphrase for FileStore when the file
+// backend is selected by OpenStore.
+const EnvCredentialPassphrase = "ATLASSIAN_CREDENTIAL_PASSPHRASE"
+
+const (
+	fileStoreVersion    = 1
+	fileStoreKeySize    = 32
+	fileStoreSaltSize   = 16
+	fileStoreIterations = 600_000
+	fileStoreFileMode   = 0600
+	fileStoreDirMode    = 0700
+)
+
+// FileStore stores secrets in a single AES-256-GCM encrypted file, for
+// systems without a usable OS keyring such as headless Linux.
+//
+// With a Passphrase the key is derived from it using PBKDF2-SHA256. Without
+// one, a random key is generated and kept next to the file in Path+".key",
+// readable only by the owner. That protects secrets from casual disclosure
+// (backups, screen sharing, a config pasted into a bug report) but not from
+// anyone who can read both files.
+type FileStore struct {
+	// Path is the encrypted file. Its directory is created as needed.
+	Path string
+
+	// Passphrase, if set, is used to derive the encryption key.
+	Passphrase string
+}
+
+// fileStoreEnvelope is the on-disk format of a FileStore.
+type fileStoreEnvelope struct {
+	Version int    `json:"version"`
+	Salt    []byte `json:"salt,omitempty"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+// fileStoreSecrets maps service to account to secret.
+type fileStoreSecrets map[string]map[string]string
+
+// Name implements Store.
+func (s *FileStore) Name() string { return BackendFile }
+
+// Get implements Store.
+func (s *FileStore) Get(service, account string) (string, error) {
+	secrets, _, err := s.load()
+	if err != nil {
+		return "", err
+	}
+	secret, ok := secrets[service][account]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return secret, nil
+}
+
+// Set implements Store.
+func (s *FileStore) Set(service, account, secret string) error {
+	secrets, salt, err := s.load()
+	if err != nil {
+		return err
+	}
+	if secrets[service] == nil {
+		secrets[service] = map[string]string{}
+	}
+	secrets[service][account] = secret
+	return s.save(secrets, salt)
+}
+
+// Delete implements Store.
+func (s *FileStore) Delete(service, account string) error {
+	secrets, salt, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[service][account]; !ok {
+		return nil
+	}
+	delete(secrets[service], account)
+	if len(secrets[service]) == 0 {
+		delete(secrets, service)
+	}
+	return s.save(secrets, salt)
+}
+
+func (s *FileStore) keyPath() string {
+	return s.Path + ".key"
+}
+
+// load decrypts the file, returning an empty set if it does not exist yet.
+func (s *FileStore) load() (fileStoreSecrets, []byte, error) {
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fileStoreSecrets{}, nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read credential file: %w", err)
+	}
+
+	var env fileStoreEnvelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse credential file: %w", err)
+	}
+	if env.Version != fileStoreVersion {
+		return nil, nil, fmt.Errorf("unsupported credential file version %d", env.Version)
+	}
+
+	key, err := s.key(env.Salt, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	plain, err := gcm.Open(nil, env.Nonce, env.Data, nil)
+	if err != nil {
+		return nil, nil, errors.New("failed to decrypt credential file: wrong passphrase or corrupted file")
+	}
+
+	secrets := fileStoreSecrets{}
+	if err := json.Unmarshal(plain, &secrets); err != nil {
+		return nil, nil, fmt.Errorf("failed to parse credential file: %w", err)
+	}
+	return secrets, env.Salt, nil
+}
+
+// save encrypts and atomically replaces the file.
+func (s *FileStore) save(secrets fileStoreSecrets, salt []byte) error {
+	if s.Passphrase != "" && salt == nil {
+		salt = make([]byte, fileStoreSaltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+	}
+	if s.Passphrase == "" {
+		salt = nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.Path), fileStoreDirMode); err != nil {
+		return fmt.Errorf("failed to create credential directory: %w", err)
+	}
+
+	key, err := s.key(salt, true)
+	if err != nil {
+		return err
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	plain, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(fileStoreEnvelope{
+		Version: fileStoreVersion,
+		Salt:    salt,
+		Nonce:   nonce,
+		Data:    gcm.Seal(nil, nonce, plain, nil),
+	})
+	if err != nil {
+		return err
+	}
+
+	tmp := s.Path + ".tmp"
+	if err := os.WriteFile(tmp, data, fileStoreFileMode); err != nil {
+		return fmt.Errorf("failed to write credential file: %w", err)
+	}
+	if err := os.Rename(tmp, s.Path); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to write credential file: %w", err)
+	}
+	return nil
+}
+
+// key returns the encryption key, deriving it from the passphrase or
+// reading (and, if create is set, generating) the key file.
+func (s *FileStore) key(salt []byte, create bool) ([]byte, error) {
+	if s.Passphrase != "" {
+		if salt == nil {
+			return nil, fmt.Errorf("credential file was not encrypted with a passphrase; unset %s", EnvCredentialPassphrase)
+		}
+		return pbkdf2.Key(sha256.New, s.Passphrase, salt, fileStoreIterations, fileStoreKeySize)
+	}
+	if salt != nil {
+		return nil, fmt.Errorf("credential file is encrypted with a passphrase; set %s", EnvCredentialPassphrase)
+	}
+
+	key, err := os.ReadFile(s.keyPath())
+	if err == nil {
+		if len(key) != fileStoreKeySize {
+			return nil, errors.New("credential key file is corrupted")
+		}
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) || !create {
+		return nil, fmt.Errorf("failed to read credential key file: %w", err)
+	}
+
+	key = make([]byte, fileStoreKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(s.keyPath(), key, fileStoreFileMode); err != nil {
+		return nil, fmt.Errorf("failed to write credential key file: %w", err)
+	}
+	return key, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
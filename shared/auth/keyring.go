@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"errors"
+
+	"github.com/zalando/go-keyring"
+)
+
+// keyringProbeService is looked up to check that the OS keyring responds.
+const keyringProbeService = "atlassian-go-probe"
+
+// KeyringStore stores secrets in the OS keychain.
+type KeyringStore struct{}
+
+// Name implements Store.
+func (KeyringStore) Name() string { return BackendKeyring }
+
+// Get implements Store.
+func (KeyringStore) Get(service, account string) (string, error) {
+	secret, err := keyring.Get(service, account)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", ErrNotFound
+	}
+	return secret, err
+}
+
+// Set implements Store.
+func (KeyringStore) Set(service, account, secret string) error {
+	return keyring.Set(service, account, secret)
+}
+
+// Delete implements Store.
+func (KeyringStore) Delete(service, account string) error {
+	err := keyring.Delete(service, account)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// keyringAvailable reports whether the OS keyring can be used, which is
+// typically not the case on headless Linux without a Secret Service.
+func keyringAvailable() bool {
+	_, err := keyring.Get(keyringProbeService, "probe")
+	return err == nil || errors.Is(err, keyring.ErrNotFound)
+}
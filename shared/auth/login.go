@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"time"
+)
+
+// Login runs the OAuth 2.0 authorization code flow with PKCE. It serves the
+// redirect URL on localhost, asks open to show the consent page in a browser
+// (and prints its URL to w in case that fails), then waits for the callback
+// and exchanges the code for a token.
+//
+// The redirect URL must be an http://localhost or http://127.0.0.1 address
+// with an explicit port, registered as a callback URL on the OAuth app.
+func (c *OAuthConfig) Login(ctx context.Context, w io.Writer, open func(string) error) (*Token, error) {
+	redirect, err := url.Parse(c.redirectURL())
+	if err != nil {
+		return nil, fmt.Errorf("invalid redirect URL: %w", err)
+	}
+	if redirect.Scheme != "http" || (redirect.Hostname() != "localhost" && redirect.Hostname() != "127.0.0.1") || redirect.Port() == "" {
+		return nil, fmt.Errorf("redirect URL %s must be http://localhost:<port>/...", redirect)
+	}
+
+	verifier, challenge, err := NewPKCE()
+	if err != nil {
+		return nil, err
+	}
+	state, err := randomString(16)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", redirect.Host)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s for the OAuth callback: %w", redirect.Host, err)
+	}
+
+	type result struct {
+		code string
+		err  error
+	}
+	results := make(chan result, 1)
+
+	mux := http.NewServeMux()
+	path := redirect.Path
+	if path == "" {
+		path = "/"
+	}
+	mux.HandleFunc(path, func(rw http.ResponseWriter, req *http.Request) {
+		q := req.URL.Query()
+		var res result
+		switch {
+		case q.Get("state") != state:
+			res.err = errors.New("OAuth callback has a mismatched state")
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("authorization denied: %s %s", q.Get("error"), q.Get("error_description"))
+		case q.Get("code") == "":
+			res.err = errors.New("OAuth callback has no authorization code")
+		default:
+			res.code = q.Get("code")
+		}
+
+		if res.err != nil {
+			http.Error(rw, res.err.Error(), http.StatusBadRequest)
+		} else {
+			_, _ = fmt.Fprintln(rw, "Authorization complete. You can close this window and return to the terminal.")
+		}
+
+		select {
+		case results <- res:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Close() }()
+
+	authURL := c.AuthCodeURL(state, challenge)
+	_, _ = fmt.Fprintf(w, "Open this URL in your browser to authorize access:\n\n  %s\n\n", authURL)
+	if open != nil {
+		_ = open(authURL)
+	}
+
+	select {
+	case res := <-results:
+		if res.err != nil {
+			return nil, res.err
+		}
+		return c.Exchange(ctx, res.code, verifier)
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for OAuth callback: %w", ctx.Err())
+	}
+}
+
+// OpenBrowser opens url in the user's default browser.
+func OpenBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
@@ -0,0 +1,356 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Atlassian OAuth 2.0 (3LO) endpoints.
+const (
+	DefaultAuthURL      = "https://auth.atlassian.com/authorize"
+	DefaultTokenURL     = "https://auth.atlassian.com/oauth/token"
+	DefaultResourcesURL = "https://api.atlassian.com/oauth/token/accessible-resources"
+
+	// GatewayURL is the API gateway that requests authorized with OAuth 2.0
+	// tokens must go through, instead of the site URL.
+	GatewayURL = "https://api.atlassian.com"
+
+	// DefaultRedirectURL is the callback URL used by Login. It must be
+	// registered on the OAuth app in the Atlassian developer console.
+	DefaultRedirectURL = "http://localhost:8085/callback"
+)
+
+// tokenExpiryDelta is how long before its expiry a token is refreshed, to
+// allow for clock skew and request latency.
+const tokenExpiryDelta = time.Minute
+
+// OAuthConfig describes an OAuth 2.0 (3LO) app registered in the Atlassian
+// developer console.
+type OAuthConfig struct {
+	ClientID     string
+	ClientSecret string
+
+	// RedirectURL is the callback URL. Defaults to DefaultRedirectURL.
+	RedirectURL string
+
+	// Scopes requested for the token. Include "offline_access" to receive
+	// a refresh token.
+	Scopes []string
+
+	// AuthURL, TokenURL and ResourcesURL override the Atlassian endpoints,
+	// for testing.
+	AuthURL      string
+	TokenURL     string
+	ResourcesURL string
+
+	// HTTPClient is used for token and resource requests. Defaults to a
+	// client with a 30 second timeout.
+	HTTPClient *http.Client
+}
+
+// Token is an OAuth 2.0 access token with its refresh token.
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Valid reports whether the access token is set and not about to expire.
+func (t *Token) Valid() bool {
+	if t == nil || t.AccessToken == "" {
+		return false
+	}
+	return t.Expiry.IsZero() || time.Now().Add(tokenExpiryDelta).Before(t.Expiry)
+}
+
+// NewPKCE returns a PKCE code verifier and its S256 code challenge.
+func NewPKCE() (verifier, challenge string, err error) {
+	verifier, err = randomString(32)
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// AuthCodeURL returns the URL of the consent page where the user authorizes
+// the app. The state is echoed back to the redirect URL.
+func (c *OAuthConfig) AuthCodeURL(state, challenge string) string {
+	q := url.Values{}
+	q.Set("audience", "api.atlassian.com")
+	q.Set("client_id", c.ClientID)
+	q.Set("scope", strings.Join(c.Scopes, " "))
+	q.Set("redirect_uri", c.redirectURL())
+	q.Set("state", state)
+	q.Set("response_type", "code")
+	q.Set("prompt", "consent")
+	q.Set("code_challenge", challenge)
+	q.Set("code_challenge_method", "S256")
+	return orDefault(c.AuthURL, DefaultAuthURL) + "?" + q.Encode()
+}
+
+// Exchange trades an authorization code for a token.
+func (c *OAuthConfig) Exchange(ctx context.Context, code, verifier string) (*Token, error) {
+	return c.token(ctx, map[string]string{
+		"grant_type":    "authorization_code",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"code":          code,
+		"redirect_uri":  c.redirectURL(),
+		"code_verifier": verifier,
+	})
+}
+
+// Refresh obtains a new token using a refresh token. Atlassian rotates
+// refresh tokens, so the returned token must replace the old one.
+func (c *OAuthConfig) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	if refreshToken == "" {
+		return nil, errors.New("OAuth token expired and no refresh token is available (request the offline_access scope)")
+	}
+	token, err := c.token(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"client_id":     c.ClientID,
+		"client_secret": c.ClientSecret,
+		"refresh_token": refreshToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+	return token, nil
+}
+
+// tokenResponse is the body of a successful token endpoint response.
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// tokenError is the body of a failed token endpoint response.
+type tokenError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (c *OAuthConfig) token(ctx context.Context, params map[string]string) (*Token, error) {
+	body, err := json.Marshal(params)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, orDefault(c.TokenURL, DefaultTokenURL), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	respBody, status, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request failed: %w", err)
+	}
+	if status != http.StatusOK {
+		var te tokenError
+		if json.Unmarshal(respBody, &te) == nil && te.Error != "" {
+			if te.Description != "" {
+				return nil, fmt.Errorf("token request failed: %s: %s", te.Error, te.Description)
+			}
+			return nil, fmt.Errorf("token request failed: %s", te.Error)
+		}
+		return nil, fmt.Errorf("token request failed: HTTP %d", status)
+	}
+
+	var tr tokenResponse
+	if err := json.Unmarshal(respBody, &tr); err != nil {
+		return nil, fmt.Errorf("failed to parse token response: %w", err)
+	}
+	if tr.AccessToken == "" {
+		return nil, errors.New("token response has no access token")
+	}
+
+	token := &Token{AccessToken: tr.AccessToken, RefreshToken: tr.RefreshToken}
+	if tr.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(tr.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// Resource is an Atlassian site that an OAuth 2.0 token grants access to.
+type Resource struct {
+	ID     string   `json:"id"` // The cloud ID
+	URL    string   `json:"url"`
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// AccessibleResources lists the sites token grants access to.
+func (c *OAuthConfig) AccessibleResources(ctx context.Context, token *Token) ([]Resource, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, orDefault(c.ResourcesURL, DefaultResourcesURL), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", BearerAuthHeader(token.AccessToken))
+	req.Header.Set("Accept", "application/json")
+
+	body, status, err := c.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list accessible resources: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("failed to list accessible resources: HTTP %d", status)
+	}
+
+	var resources []Resource
+	if err := json.Unmarshal(body, &resources); err != nil {
+		return nil, fmt.Errorf("failed to parse accessible resources: %w", err)
+	}
+	return resources, nil
+}
+
+// FindResource returns the resource for siteURL, matching on host name.
+func FindResource(resources []Resource, siteURL string) (*Resource, bool) {
+	host := Account(siteURL, "")
+	for i := range resources {
+		if strings.EqualFold(Account(resources[i].URL, ""), host) {
+			return &resources[i], true
+		}
+	}
+	return nil, false
+}
+
+// GatewayBaseURL returns the base URL of the API gateway for product
+// ("jira" or "confluence") on the site with cloudID.
+func GatewayBaseURL(product, cloudID string) string {
+	return fmt.Sprintf("%s/ex/%s/%s", GatewayURL, product, cloudID)
+}
+
+func (c *OAuthConfig) do(req *http.Request) ([]byte, int, error) {
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+	return body, resp.StatusCode, nil
+}
+
+func (c *OAuthConfig) redirectURL() string {
+	return orDefault(c.RedirectURL, DefaultRedirectURL)
+}
+
+// OAuth authenticates with OAuth 2.0 access tokens, refreshing them when
+// they expire. It is safe for concurrent use.
+type OAuth struct {
+	Config *OAuthConfig
+
+	// OnRefresh, if set, is called with every refreshed token so that it
+	// can be persisted. The old refresh token stops working once used.
+	OnRefresh func(*Token) error
+
+	mu    sync.Mutex
+	token *Token
+}
+
+// NewOAuth returns an authenticator starting from token.
+func NewOAuth(config *OAuthConfig, token *Token) *OAuth {
+	return &OAuth{Config: config, token: token}
+}
+
+// Token returns a valid access token, refreshing it if needed.
+func (a *OAuth) Token(ctx context.Context) (*Token, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token.Valid() {
+		return a.token, nil
+	}
+
+	var refreshToken string
+	if a.token != nil {
+		refreshToken = a.token.RefreshToken
+	}
+	token, err := a.Config.Refresh(ctx, refreshToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh OAuth token: %w", err)
+	}
+	a.token = token
+
+	if a.OnRefresh != nil {
+		if err := a.OnRefresh(token); err != nil {
+			return nil, fmt.Errorf("failed to save refreshed OAuth token: %w", err)
+		}
+	}
+	return token, nil
+}
+
+// Authorize implements Authenticator.
+func (a *OAuth) Authorize(req *http.Request) error {
+	token, err := a.Token(req.Context())
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", BearerAuthHeader(token.AccessToken))
+	return nil
+}
+
+// OAuthCredentials are the secrets of an OAuth 2.0 login: the app's client
+// secret and the current token. Tools keep them in a Store as one secret.
+type OAuthCredentials struct {
+	ClientSecret string `json:"client_secret,omitempty"`
+	Token
+}
+
+// Encode returns the credentials as a single-line secret for a Store.
+func (c *OAuthCredentials) Encode() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode OAuth credentials: %w", err)
+	}
+	return string(data), nil
+}
+
+// ParseOAuthCredentials decodes credentials produced by Encode.
+func ParseOAuthCredentials(secret string) (*OAuthCredentials, error) {
+	var creds OAuthCredentials
+	if err := json.Unmarshal([]byte(secret), &creds); err != nil {
+		return nil, errors.New("stored OAuth credentials are invalid; log in again with init")
+	}
+	return &creds, nil
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func orDefault(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
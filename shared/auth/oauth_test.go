@@ -0,0 +1,248 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// tokenServer fakes the Atlassian token endpoint, checking the PKCE verifier
+// against challenge when exchanging codes.
+func tokenServer(t *testing.T, challenge *string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var refreshes atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var params map[string]string
+		if err := json.NewDecoder(r.Body).Decode(&params); err != nil {
+			t.Errorf("decode token request: %v", err)
+		}
+		if params["client_id"] != "client" || params["client_secret"] != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = io.WriteString(w, `{"error":"access_denied","error_description":"bad client"}`)
+			return
+		}
+
+		switch params["grant_type"] {
+		case "authorization_code":
+			sum := sha256.Sum256([]byte(params["code_verifier"]))
+			if params["code"] != "the-code" || base64.RawURLEncoding.EncodeToString(sum[:]) != *challenge {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = io.WriteString(w, `{"error":"invalid_grant"}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"access_token":"access-1","refresh_token":"refresh-1","expires_in":3600}`)
+		case "refresh_token":
+			n := refreshes.Add(1)
+			if params["refresh_token"] != "refresh-"+string(rune('0'+n)) {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = io.WriteString(w, `{"error":"invalid_grant"}`)
+				return
+			}
+			next := string(rune('0' + n + 1))
+			_, _ = io.WriteString(w, `{"access_token":"access-`+next+`","refresh_token":"refresh-`+next+`","expires_in":3600}`)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &refreshes
+}
+
+func TestOAuthConfig_AuthCodeURL(t *testing.T) {
+	cfg := &OAuthConfig{ClientID: "client", Scopes: []string{"read:jira-work", "offline_access"}}
+	u, err := url.Parse(cfg.AuthCodeURL("xyz", "challenge"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	q := u.Query()
+	want := map[string]string{
+		"audience":              "api.atlassian.com",
+		"client_id":             "client",
+		"scope":                 "read:jira-work offline_access",
+		"redirect_uri":          DefaultRedirectURL,
+		"state":                 "xyz",
+		"response_type":         "code",
+		"code_challenge":        "challenge",
+		"code_challenge_method": "S256",
+	}
+	for k, v := range want {
+		if got := q.Get(k); got != v {
+			t.Errorf("%s = %q, want %q", k, got, v)
+		}
+	}
+	if !strings.HasPrefix(u.String(), DefaultAuthURL+"?") {
+		t.Errorf("AuthCodeURL() = %s, want prefix %s", u, DefaultAuthURL)
+	}
+}
+
+func TestOAuthConfig_ExchangeAndRefresh(t *testing.T) {
+	verifier, challenge, err := NewPKCE()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, _ := tokenServer(t, &challenge)
+	cfg := &OAuthConfig{ClientID: "client", ClientSecret: "secret", TokenURL: srv.URL}
+
+	token, err := cfg.Exchange(context.Background(), "the-code", verifier)
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+	if token.AccessToken != "access-1" || token.RefreshToken != "refresh-1" || !token.Valid() {
+		t.Errorf("Exchange() = %+v", token)
+	}
+
+	if _, err := cfg.Exchange(context.Background(), "the-code", "wrong-verifier"); err == nil || !strings.Contains(err.Error(), "invalid_grant") {
+		t.Errorf("Exchange() with wrong verifier error = %v", err)
+	}
+
+	token, err = cfg.Refresh(context.Background(), "refresh-1")
+	if err != nil {
+		t.Fatalf("Refresh() error = %v", err)
+	}
+	if token.AccessToken != "access-2" || token.RefreshToken != "refresh-2" {
+		t.Errorf("Refresh() = %+v", token)
+	}
+
+	bad := &OAuthConfig{ClientID: "client", ClientSecret: "nope", TokenURL: srv.URL}
+	if _, err := bad.Refresh(context.Background(), "refresh-2"); err == nil || !strings.Contains(err.Error(), "bad client") {
+		t.Errorf("Refresh() with bad secret error = %v", err)
+	}
+	if _, err := cfg.Refresh(context.Background(), ""); err == nil || !strings.Contains(err.Error(), "offline_access") {
+		t.Errorf("Refresh() without refresh token error = %v", err)
+	}
+}
+
+func TestOAuth_RefreshesExpiredToken(t *testing.T) {
+	challenge := ""
+	srv, refreshes := tokenServer(t, &challenge)
+
+	var saved []*Token
+	authn := NewOAuth(
+		&OAuthConfig{ClientID: "client", ClientSecret: "secret", TokenURL: srv.URL},
+		&Token{AccessToken: "access-1", RefreshToken: "refresh-1", Expiry: time.Now().Add(30 * time.Second)},
+	)
+	authn.OnRefresh = func(tok *Token) error {
+		saved = append(saved, tok)
+		return nil
+	}
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "https://api.atlassian.com/", nil)
+		if err := authn.Authorize(req); err != nil {
+			t.Fatalf("Authorize() error = %v", err)
+		}
+		if got := req.Header.Get("Authorization"); got != "Bearer access-2" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer access-2")
+		}
+	}
+
+	if refreshes.Load() != 1 {
+		t.Errorf("refreshes = %d, want 1", refreshes.Load())
+	}
+	if len(saved) != 1 || saved[0].RefreshToken != "refresh-2" {
+		t.Errorf("OnRefresh saw %+v", saved)
+	}
+}
+
+func TestOAuthConfig_Login(t *testing.T) {
+	// Find a free port for the callback listener
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	redirect := "http://" + l.Addr().String() + "/callback"
+	_ = l.Close()
+
+	var challenge string
+	srv, _ := tokenServer(t, &challenge)
+	cfg := &OAuthConfig{ClientID: "client", ClientSecret: "secret", TokenURL: srv.URL, RedirectURL: redirect}
+
+	// The "browser" approves immediately and follows the redirect
+	open := func(authURL string) error {
+		u, err := url.Parse(authURL)
+		if err != nil {
+			return err
+		}
+		challenge = u.Query().Get("code_challenge")
+		go func() {
+			resp, err := http.Get(redirect + "?code=the-code&state=" + url.QueryEscape(u.Query().Get("state")))
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var out strings.Builder
+	token, err := cfg.Login(ctx, &out, open)
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if token.AccessToken != "access-1" {
+		t.Errorf("Login() token = %+v", token)
+	}
+	if !strings.Contains(out.String(), "code_challenge=") {
+		t.Errorf("Login() did not print the authorization URL: %q", out.String())
+	}
+
+	cfg.RedirectURL = "https://example.com/callback"
+	if _, err := cfg.Login(ctx, io.Discard, nil); err == nil {
+		t.Error("Login() with non-local redirect URL should fail")
+	}
+}
+
+func TestOAuthCredentials_RoundTrip(t *testing.T) {
+	creds := &OAuthCredentials{
+		ClientSecret: "secret",
+		Token:        Token{AccessToken: "a", RefreshToken: "r", Expiry: time.Unix(1700000000, 0).UTC()},
+	}
+	encoded, err := creds.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.ContainsAny(encoded, "\r\n") {
+		t.Error("Encode() must return a single line")
+	}
+
+	got, err := ParseOAuthCredentials(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != *creds {
+		t.Errorf("ParseOAuthCredentials() = %+v, want %+v", got, creds)
+	}
+
+	if _, err := ParseOAuthCredentials("plain-api-token"); err == nil {
+		t.Error("ParseOAuthCredentials() of a plain token should fail")
+	}
+}
+
+func TestFindResource(t *testing.T) {
+	resources := []Resource{
+		{ID: "1", URL: "https://one.atlassian.net"},
+		{ID: "2", URL: "https://two.atlassian.net"},
+	}
+
+	if r, ok := FindResource(resources, "https://TWO.atlassian.net/wiki"); !ok || r.ID != "2" {
+		t.Errorf("FindResource() = %+v, %v", r, ok)
+	}
+	if _, ok := FindResource(resources, "https://three.atlassian.net"); ok {
+		t.Error("FindResource() found a missing site")
+	}
+	if got := GatewayBaseURL("jira", "abc"); got != "https://api.atlassian.com/ex/jira/abc" {
+		t.Errorf("GatewayBaseURL() = %s", got)
+	}
+}
@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// EnvCredentialStore selects the credential backend, overriding the
+// configured one. See OpenStore for accepted values.
+const EnvCredentialStore = "ATLASSIAN_CREDENTIAL_STORE"
+
+// Backend names accepted by OpenStore.
+const (
+	BackendKeyring = "keyring"
+	BackendFile    = "file"
+	BackendCommand = "command"
+)
+
+// ErrNotFound is returned by Store.Get when no secret is stored.
+var ErrNotFound = errors.New("credential not found")
+
+// Store persists secrets such as API tokens outside the plaintext config file.
+//
+// Secrets are addressed by service (one per CLI, e.g. "jira-ticket-cli") and
+// account (see Account).
+type Store interface {
+	// Get returns the secret, or ErrNotFound if none is stored.
+	Get(service, account string) (string, error)
+
+	// Set stores the secret, replacing any existing one.
+	Set(service, account, secret string) error
+
+	// Delete removes the secret. Deleting a missing secret is not an error.
+	Delete(service, account string) error
+
+	// Name describes the backend for display, e.g. "keyring".
+	Name() string
+}
+
+// StoreOptions configures OpenStore.
+type StoreOptions struct {
+	// Backend is the configured backend. It is overridden by the
+	// ATLASSIAN_CREDENTIAL_STORE environment variable when that is set.
+	Backend string
+
+	// FilePath is where the file backend keeps its encrypted secrets.
+	// Required when the file backend may be selected.
+	FilePath string
+}
+
+// OpenStore returns the credential store selected by opts.
+//
+// Accepted backends are:
+//   - "keyring": the OS keychain (macOS Keychain, Windows Credential
+//     Manager, or the Secret Service on Linux)
+//   - "file": an encrypted file at opts.FilePath (see FileStore)
+//   - "command:<helper>": an external credential helper (see CommandStore)
+//   - "": the keyring if it is usable, otherwise the encrypted file
+func OpenStore(opts StoreOptions) (Store, error) {
+	backend := opts.Backend
+	if v := os.Getenv(EnvCredentialStore); v != "" {
+		backend = v
+	}
+
+	name, arg, _ := strings.Cut(backend, ":")
+	switch strings.TrimSpace(name) {
+	case "":
+		if keyringAvailable() {
+			return KeyringStore{}, nil
+		}
+		return newFileStore(opts.FilePath)
+	case BackendKeyring:
+		return KeyringStore{}, nil
+	case BackendFile:
+		return newFileStore(opts.FilePath)
+	case BackendCommand:
+		arg = strings.TrimSpace(arg)
+		if arg == "" {
+			return nil, errors.New("credential store \"command\" requires a helper, e.g. command:my-helper")
+		}
+		return &CommandStore{Command: arg}, nil
+	default:
+		return nil, fmt.Errorf("unknown credential store %q: must be keyring, file, or command:<helper>", backend)
+	}
+}
+
+func newFileStore(path string) (Store, error) {
+	if path == "" {
+		return nil, errors.New("credential store \"file\" requires a file path")
+	}
+	return &FileStore{Path: path, Passphrase: os.Getenv(EnvCredentialPassphrase)}, nil
+}
+
+// Account returns the account name under which the API token for email on
+// the site at siteURL is stored, in the form "email@host". Without an email,
+// as with bearer and OAuth tokens, it is just the host.
+func Account(siteURL, email string) string {
+	host := siteURL
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.IndexByte(host, '/'); i >= 0 {
+		host = host[:i]
+	}
+	if host == "" {
+		return email
+	}
+	if email == "" {
+		return host
+	}
+	return email + "@" + host
+}
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+func testStore(t *testing.T, store Store) {
+	t.Helper()
+
+	if _, err := store.Get("svc", "acct"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get() on empty store error = %v, want ErrNotFound", err)
+	}
+
+	if err := store.Set("svc", "acct", "s3cret"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+	if err := store.Set("other", "acct", "unrelated"); err != nil {
+		t.Fatalf("Set() error = %v", err)
+	}
+
+	got, err := store.Get("svc", "acct")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got != "s3cret" {
+		t.Errorf("Get() = %q, want %q", got, "s3cret")
+	}
+
+	if err := store.Delete("svc", "acct"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if _, err := store.Get("svc", "acct"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get() after Delete error = %v, want ErrNotFound", err)
+	}
+	if err := store.Delete("svc", "acct"); err != nil {
+		t.Errorf("Delete() of missing secret error = %v", err)
+	}
+
+	got, err = store.Get("other", "acct")
+	if err != nil || got != "unrelated" {
+		t.Errorf("Get() of other service = %q, %v; want %q", got, err, "unrelated")
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	t.Run("key file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "sub", "credentials")
+		store := &FileStore{Path: path}
+		testStore(t, store)
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(string(data), "unrelated") {
+			t.Error("credential file contains plaintext secret")
+		}
+
+		if runtime.GOOS != "windows" {
+			for _, p := range []string{path, path + ".key"} {
+				info, err := os.Stat(p)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if perm := info.Mode().Perm(); perm != 0600 {
+					t.Errorf("%s mode = %o, want 600", filepath.Base(p), perm)
+				}
+			}
+		}
+	})
+
+	t.Run("passphrase", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "credentials")
+		testStore(t, &FileStore{Path: path, Passphrase: "hunter2"})
+
+		if _, err := os.Stat(path + ".key"); !os.IsNotExist(err) {
+			t.Error("key file written despite passphrase")
+		}
+
+		_, err := (&FileStore{Path: path, Passphrase: "wrong"}).Get("other", "acct")
+		if err == nil || !strings.Contains(err.Error(), "wrong passphrase") {
+			t.Errorf("Get() with wrong passphrase error = %v", err)
+		}
+
+		_, err = (&FileStore{Path: path}).Get("other", "acct")
+		if err == nil || !strings.Contains(err.Error(), EnvCredentialPassphrase) {
+			t.Errorf("Get() without passphrase error = %v", err)
+		}
+	})
+}
+
+func TestCommandStore(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("helper script requires sh")
+	}
+
+	dir := t.TempDir()
+	helper := filepath.Join(dir, "helper")
+	script := `#!/bin/sh
+set -e
+dir="` + dir + `"
+while IFS='=' read -r key value; do
+	eval "in_$key=\$value"
+done
+file="$dir/$in_service.$in_account"
+case "$1" in
+	get) [ -f "$file" ] && printf 'secret=%s\n' "$(cat "$file")" || true ;;
+	store) printf '%s' "$in_secret" > "$file" ;;
+	erase) rm -f "$file" ;;
+	*) echo "unknown action $1" >&2; exit 1 ;;
+esac
+`
+	if err := os.WriteFile(helper, []byte(script), 0700); err != nil {
+		t.Fatal(err)
+	}
+
+	testStore(t, &CommandStore{Command: helper})
+
+	_, err := (&CommandStore{Command: "exit 3; true"}).Get("svc", "acct")
+	if err == nil || !strings.Contains(err.Error(), "failed") {
+		t.Errorf("Get() with failing helper error = %v", err)
+	}
+}
+
+func TestOpenStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+
+	tests := []struct {
+		name     string
+		env      string
+		backend  string
+		wantName string
+		wantErr  string
+	}{
+		{name: "file", backend: "file", wantName: "file"},
+		{name: "keyring", backend: "keyring", wantName: "keyring"},
+		{name: "command", backend: "command: pass-helper", wantName: "command:pass-helper"},
+		{name: "env overrides config", env: "file", backend: "keyring", wantName: "file"},
+		{name: "command without helper", backend: "command", wantErr: "requires a helper"},
+		{name: "unknown", backend: "vault", wantErr: "unknown credential store"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvCredentialStore, tt.env)
+
+			store, err := OpenStore(StoreOptions{Backend: tt.backend, FilePath: path})
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("OpenStore() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("OpenStore() error = %v", err)
+			}
+			if store.Name() != tt.wantName {
+				t.Errorf("Name() = %q, want %q", store.Name(), tt.wantName)
+			}
+		})
+	}
+}
+
+func TestAccount(t *testing.T) {
+	tests := []struct {
+		url, email, want string
+	}{
+		{"https://example.atlassian.net", "me@example.com", "me@example.com@example.atlassian.net"},
+		{"https://example.atlassian.net/wiki/", "me@example.com", "me@example.com@example.atlassian.net"},
+		{"", "me@example.com", "me@example.com"},
+		{"https://example.atlassian.net", "", "example.atlassian.net"},
+	}
+	for _, tt := range tests {
+		if got := Account(tt.url, tt.email); got != tt.want {
+			t.Errorf("Account(%q, %q) = %q, want %q", tt.url, tt.email, got, tt.want)
+		}
+	}
+}
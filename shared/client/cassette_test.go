@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/open-cli-collective/atlassian-go/auth"
+)
+
+func TestCassette_RecordAndReplay(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Set-Cookie", "session=secret-cookie")
+		switch r.URL.Path {
+		case "/token":
+			_, _ = io.WriteString(w, `{"access_token": "secret-access", "expires_in": 3600}`)
+		case "/binary":
+			_, _ = w.Write([]byte{0xff, 0x00, 0xfe})
+		default:
+			_, _ = io.WriteString(w, `{"n":`+string(rune('0'+calls))+`}`)
+		}
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	c := New(server.URL, "", "", &Options{
+		Auth:      &auth.Bearer{Token: "secret-pat"},
+		Transport: NewRecorder(path, nil),
+	})
+
+	ctx := context.Background()
+	for _, p := range []string{"/item", "/item", "/token?api_token=secret-query"} {
+		if _, err := c.Get(ctx, p); err != nil {
+			t.Fatalf("Get(%s) error = %v", p, err)
+		}
+	}
+	if _, err := c.Post(ctx, "/item", map[string]string{"password": "secret-password", "name": "kept"}); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+	if _, err := c.Get(ctx, "/binary"); err != nil {
+		t.Fatalf("Get(/binary) error = %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"secret-pat", "secret-cookie", "secret-access", "secret-query", "secret-password"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, data)
+		}
+	}
+	if !strings.Contains(string(data), `\"name\":\"kept\"`) {
+		t.Errorf("cassette lost non-secret request body:\n%s", data)
+	}
+
+	// Replay without a server, getting the recorded responses back in order
+	server.Close()
+	replayer, err := NewReplayer(path)
+	if err != nil {
+		t.Fatalf("NewReplayer() error = %v", err)
+	}
+	c = New(server.URL, "", "", &Options{
+		Auth:      &auth.Bearer{Token: "another-pat"},
+		Transport: replayer,
+	})
+
+	for _, want := range []string{`{"n":1}`, `{"n":2}`} {
+		got, err := c.Get(ctx, "/item")
+		if err != nil {
+			t.Fatalf("replay Get(/item) error = %v", err)
+		}
+		if string(got) != want {
+			t.Errorf("replay Get(/item) = %s, want %s", got, want)
+		}
+	}
+
+	got, err := c.Get(ctx, "/token?api_token=different")
+	if err != nil {
+		t.Fatalf("replay Get(/token) error = %v", err)
+	}
+	if !strings.Contains(string(got), `"access_token": "REDACTED"`) {
+		t.Errorf("replay Get(/token) = %s", got)
+	}
+
+	if _, err := c.Post(ctx, "/item", nil); err != nil {
+		t.Fatalf("replay Post() error = %v", err)
+	}
+
+	got, err = c.Get(ctx, "/binary")
+	if err != nil {
+		t.Fatalf("replay Get(/binary) error = %v", err)
+	}
+	if string(got) != "\xff\x00\xfe" {
+		t.Errorf("replay Get(/binary) = %q", got)
+	}
+
+	if replayer.Remaining() != 0 {
+		t.Errorf("Remaining() = %d, want 0", replayer.Remaining())
+	}
+
+	_, err = c.Get(ctx, "/item")
+	if err == nil || !strings.Contains(err.Error(), "no recorded response for GET "+server.URL+"/item") {
+		t.Errorf("exhausted replay error = %v", err)
+	}
+}
+
+func TestCassette_ReplaysErrors(t *testing.T) {
+	cassette := &Cassette{
+		Version: CassetteVersion,
+		Interactions: []Interaction{{
+			Request: RecordedRequest{Method: http.MethodGet, URL: "https://example.atlassian.net/missing"},
+			Response: RecordedResponse{
+				Status:  http.StatusNotFound,
+				Headers: http.Header{"Content-Type": {"application/json"}},
+				Body:    `{"errorMessages":["Issue does not exist"]}`,
+			},
+		}},
+	}
+	c := New("https://example.atlassian.net", "user@example.com", "token", &Options{
+		Transport: NewCassetteReplayer(cassette),
+	})
+
+	_, err := c.Get(context.Background(), "/missing")
+	if err == nil || !strings.Contains(err.Error(), "Issue does not exist") {
+		t.Errorf("Get() error = %v", err)
+	}
+}
+
+func TestCassetteTransport(t *testing.T) {
+	if rt, err := CassetteTransport("", ""); rt != nil || err != nil {
+		t.Errorf("CassetteTransport() = %v, %v; want nil, nil", rt, err)
+	}
+	if _, err := CassetteTransport("a.json", "b.json"); err == nil {
+		t.Error("CassetteTransport() with both files should fail")
+	}
+	if _, err := CassetteTransport("", filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("CassetteTransport() with a missing replay file should fail")
+	}
+	if rt, err := CassetteTransport(filepath.Join(t.TempDir(), "out.json"), ""); err != nil {
+		t.Errorf("CassetteTransport() error = %v", err)
+	} else if _, ok := rt.(*Recorder); !ok {
+		t.Errorf("CassetteTransport() = %T, want *Recorder", rt)
+	}
+}
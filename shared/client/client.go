@@ -5,11 +5,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/open-cli-collective/atlassian-go/auth"
 	"github.com/open-cli-collective/atlassian-go/errors"
@@ -20,8 +22,8 @@ type Client struct {
 	// BaseURL is the base URL for API requests (e.g., "https://example.atlassian.net/wiki").
 	BaseURL string
 
-	// AuthHeader is the pre-computed Authorization header value.
-	AuthHeader string
+	// Auth adds credentials to each request.
+	Auth auth.Authenticator
 
 	// HTTPClient is the underlying HTTP client.
 	HTTPClient *http.Client
@@ -31,18 +33,26 @@ type Client struct {
 
 	// VerboseOut is the writer for verbose output.
 	VerboseOut io.Writer
+
+	// Retry controls retrying of failed requests. Nil disables retries.
+	Retry *RetryPolicy
 }
 
 // New creates a new API client.
 //
 // The baseURL should include any required path prefix (e.g., "/wiki" for Confluence).
-// The email and apiToken are used to generate Basic authentication.
+// The email and apiToken are used for Basic authentication, unless opts.Auth
+// supplies another Authenticator.
 func New(baseURL, email, apiToken string, opts *Options) *Client {
 	baseURL = strings.TrimSuffix(baseURL, "/")
 
 	var timeout = DefaultTimeout
 	var verbose bool
 	var verboseOut io.Writer = os.Stderr
+	retry := DefaultRetryPolicy()
+	var authn auth.Authenticator = &auth.Basic{Email: email, APIToken: apiToken}
+	var transport http.RoundTripper
+	var traceOut io.Writer
 
 	if opts != nil {
 		timeout = opts.timeoutOrDefault()
@@ -50,16 +60,36 @@ func New(baseURL, email, apiToken string, opts *Options) *Client {
 		if opts.VerboseOut != nil {
 			verboseOut = opts.VerboseOut
 		}
+		if opts.Retry != nil {
+			retry = opts.Retry
+		}
+		if opts.Auth != nil {
+			authn = opts.Auth
+		}
+		transport = opts.Transport
+		traceOut = opts.TraceOut
+	}
+
+	// All requests, including multipart uploads and downloads built outside
+	// of Do, are logged by the transport
+	if verbose || traceOut != nil {
+		tracer := &Tracer{Next: transport, Out: traceOut}
+		if verbose {
+			tracer.Verbose = verboseOut
+		}
+		transport = tracer
 	}
 
 	return &Client{
-		BaseURL:    baseURL,
-		AuthHeader: auth.BasicAuthHeader(email, apiToken),
+		BaseURL: baseURL,
+		Auth:    authn,
 		HTTPClient: &http.Client{
-			Timeout: timeout,
+			Timeout:   timeout,
+			Transport: transport,
 		},
 		Verbose:    verbose,
 		VerboseOut: verboseOut,
+		Retry:      retry,
 	}
 }
 
@@ -68,6 +98,7 @@ func New(baseURL, email, apiToken string, opts *Options) *Client {
 // The path can be either relative to the BaseURL (e.g., "/rest/api/3/issue")
 // or an absolute URL (e.g., "https://example.com/api/resource").
 // If body is not nil, it will be JSON-encoded.
+// Failed requests are retried according to c.Retry.
 // Returns the response body or an error (which may be an *errors.APIError).
 func (c *Client) Do(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
 	var url string
@@ -83,50 +114,91 @@ func (c *Client) Do(ctx context.Context, method, path string, body interface{})
 		url = c.BaseURL + path
 	}
 
-	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			return nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
+	}
+
+	canRetry := c.Retry != nil && c.Retry.isIdempotent(ctx, method)
+
+	for attempt := 1; ; attempt++ {
+		respBody, retryable, err := c.send(withAttempt(ctx, attempt), method, url, jsonBody)
+		if err == nil {
+			return respBody, nil
+		}
+		if !canRetry || !retryable || attempt > c.Retry.MaxRetries {
+			return nil, err
+		}
+
+		delay, ok := c.Retry.backoff(attempt, errors.RetryAfter(err))
+		if !ok {
+			return nil, err
+		}
+
+		if c.Verbose {
+			_, _ = fmt.Fprintf(c.VerboseOut, "↻ retrying in %s (retry %d/%d): %v\n",
+				delay.Round(time.Millisecond), attempt, c.Retry.MaxRetries, err)
+		}
+
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
+
+// send performs a single HTTP attempt. The retryable result reports whether
+// a failure is transient (network error, 429 or 5xx) and may be retried.
+func (c *Client) send(ctx context.Context, method, url string, jsonBody []byte) ([]byte, bool, error) {
+	var reqBody io.Reader
+	if jsonBody != nil {
 		reqBody = bytes.NewReader(jsonBody)
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, false, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	// Set headers
-	req.Header.Set("Authorization", c.AuthHeader)
+	if err := c.Authorize(req); err != nil {
+		return nil, false, err
+	}
 	req.Header.Set("Accept", "application/json")
 	req.Header.Set("Content-Type", "application/json")
 
-	if c.Verbose {
-		_, _ = fmt.Fprintf(c.VerboseOut, "→ %s %s\n", method, url)
-	}
-
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		// Network failures are transient unless the caller gave up or the
+		// request is missing from a replayed cassette
+		retryable := ctx.Err() == nil && !stderrors.Is(err, ErrNotRecorded)
+		return nil, retryable, fmt.Errorf("request failed: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read response body: %w", err)
-	}
-
-	if c.Verbose {
-		_, _ = fmt.Fprintf(c.VerboseOut, "← %d %s\n", resp.StatusCode, http.StatusText(resp.StatusCode))
+		return nil, ctx.Err() == nil, fmt.Errorf("failed to read response body: %w", err)
 	}
 
 	// Handle error responses
 	if resp.StatusCode >= 400 {
-		return nil, errors.ParseAPIError(resp.StatusCode, respBody)
+		return nil, retryableStatus(resp.StatusCode), errors.ParseAPIErrorWithHeader(resp.StatusCode, resp.Header, respBody)
 	}
 
-	return respBody, nil
+	return respBody, false, nil
+}
+
+// Authorize adds the client's credentials to req, for requests built outside
+// of Do such as multipart uploads.
+func (c *Client) Authorize(req *http.Request) error {
+	if c.Auth == nil {
+		return nil
+	}
+	return c.Auth.Authorize(req)
 }
 
 // Get performs a GET request.
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -11,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
 	"github.com/open-cli-collective/atlassian-go/errors"
 )
 
@@ -22,8 +24,12 @@ func TestNew(t *testing.T) {
 			t.Errorf("BaseURL = %v, want https://example.atlassian.net", c.BaseURL)
 		}
 
-		if !strings.HasPrefix(c.AuthHeader, "Basic ") {
-			t.Error("AuthHeader should start with 'Basic '")
+		req := httptest.NewRequest(http.MethodGet, c.BaseURL, nil)
+		if err := c.Authorize(req); err != nil {
+			t.Fatalf("Authorize() error = %v", err)
+		}
+		if !strings.HasPrefix(req.Header.Get("Authorization"), "Basic ") {
+			t.Error("Authorization header should start with 'Basic '")
 		}
 
 		if c.HTTPClient.Timeout != DefaultTimeout {
@@ -61,6 +67,14 @@ func TestNew(t *testing.T) {
 			t.Error("VerboseOut not set correctly")
 		}
 	})
+
+	t.Run("default retry policy", func(t *testing.T) {
+		c := New("https://example.atlassian.net", "user@example.com", "token", nil)
+
+		if c.Retry == nil || c.Retry.MaxRetries != DefaultMaxRetries {
+			t.Errorf("Retry = %+v, want default policy", c.Retry)
+		}
+	})
 }
 
 func TestClient_Do(t *testing.T) {
@@ -217,6 +231,38 @@ func TestClient_Do(t *testing.T) {
 	})
 }
 
+// failingAuth is an Authenticator that cannot produce credentials
+type failingAuth struct{}
+
+func (failingAuth) Authorize(*http.Request) error {
+	return fmt.Errorf("token expired")
+}
+
+func TestClient_Auth(t *testing.T) {
+	var calls int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if got := r.Header.Get("Authorization"); got != "Bearer pat" {
+			t.Errorf("Authorization = %q, want %q", got, "Bearer pat")
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c := New(server.URL, "", "", &Options{Auth: &auth.Bearer{Token: "pat"}})
+	if _, err := c.Get(context.Background(), "/test"); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	c = New(server.URL, "", "", &Options{Auth: failingAuth{}})
+	if _, err := c.Get(context.Background(), "/test"); err == nil || !strings.Contains(err.Error(), "token expired") {
+		t.Errorf("Get() error = %v, want authorization failure", err)
+	}
+	if calls != 1 {
+		t.Errorf("server calls = %d, want 1 (no request without credentials)", calls)
+	}
+}
+
 func TestClient_ErrorHandling(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -270,7 +316,7 @@ func TestClient_ErrorHandling(t *testing.T) {
 			}))
 			defer server.Close()
 
-			c := New(server.URL, "user@example.com", "token", nil)
+			c := New(server.URL, "user@example.com", "token", &Options{Retry: &RetryPolicy{}})
 			_, err := c.Get(context.Background(), "/api/test")
 
 			if err == nil {
@@ -340,6 +386,232 @@ func TestClient_ContextCancellation(t *testing.T) {
 	}
 }
 
+func TestClient_Retry(t *testing.T) {
+	fastRetry := func(retryPOST bool) *Options {
+		return &Options{Retry: &RetryPolicy{
+			MaxRetries: 3,
+			BaseDelay:  time.Millisecond,
+			MaxDelay:   50 * time.Millisecond,
+			RetryPOST:  retryPOST,
+		}}
+	}
+
+	t.Run("retries 503 then succeeds", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{"ok": true}`))
+		}))
+		defer server.Close()
+
+		c := New(server.URL, "user@example.com", "token", fastRetry(false))
+		body, err := c.Get(context.Background(), "/api/test")
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("calls = %d, want 3", calls)
+		}
+		if !strings.Contains(string(body), "ok") {
+			t.Errorf("Body = %v, want to contain 'ok'", string(body))
+		}
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message": "slow down"}`))
+		}))
+		defer server.Close()
+
+		c := New(server.URL, "user@example.com", "token", fastRetry(false))
+		_, err := c.Get(context.Background(), "/api/test")
+		if !errors.IsRateLimited(err) {
+			t.Fatalf("Expected ErrRateLimited, got %v", err)
+		}
+		if !strings.Contains(err.Error(), "slow down") {
+			t.Errorf("Error should keep response details, got %v", err)
+		}
+		if calls != 4 {
+			t.Errorf("calls = %d, want 4", calls)
+		}
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadRequest)
+		}))
+		defer server.Close()
+
+		c := New(server.URL, "user@example.com", "token", fastRetry(false))
+		_, _ = c.Get(context.Background(), "/api/test")
+		if calls != 1 {
+			t.Errorf("calls = %d, want 1", calls)
+		}
+	})
+
+	t.Run("does not retry POST by default", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		c := New(server.URL, "user@example.com", "token", fastRetry(false))
+		_, _ = c.Post(context.Background(), "/api/test", map[string]string{"a": "b"})
+		if calls != 1 {
+			t.Errorf("calls = %d, want 1", calls)
+		}
+	})
+
+	t.Run("retries POST when opted in and resends body", func(t *testing.T) {
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			if len(bodies) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		c := New(server.URL, "user@example.com", "token", fastRetry(true))
+		if _, err := c.Post(context.Background(), "/api/test", map[string]string{"a": "b"}); err != nil {
+			t.Fatalf("Post() error = %v", err)
+		}
+		if len(bodies) != 2 || bodies[0] != bodies[1] || bodies[1] == "" {
+			t.Errorf("bodies = %q, want the same body sent twice", bodies)
+		}
+	})
+
+	t.Run("retries POST marked idempotent", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		c := New(server.URL, "user@example.com", "token", fastRetry(false))
+		if _, err := c.Post(WithIdempotent(context.Background()), "/api/search", nil); err != nil {
+			t.Fatalf("Post() error = %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("calls = %d, want 2", calls)
+		}
+	})
+
+	t.Run("honors Retry-After", func(t *testing.T) {
+		var times []time.Time
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			times = append(times, time.Now())
+			if len(times) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		opts := fastRetry(false)
+		opts.Retry.MaxDelay = 2 * time.Second
+		c := New(server.URL, "user@example.com", "token", opts)
+		if _, err := c.Get(context.Background(), "/api/test"); err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if len(times) != 2 {
+			t.Fatalf("calls = %d, want 2", len(times))
+		}
+		if gap := times[1].Sub(times[0]); gap < 900*time.Millisecond {
+			t.Errorf("retry came after %v, want >= 1s", gap)
+		}
+	})
+
+	t.Run("fails fast when Retry-After exceeds MaxDelay", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		c := New(server.URL, "user@example.com", "token", fastRetry(false))
+		_, err := c.Get(context.Background(), "/api/test")
+		if calls != 1 {
+			t.Errorf("calls = %d, want 1", calls)
+		}
+		if got := errors.RetryAfter(err); got != time.Hour {
+			t.Errorf("RetryAfter(err) = %v, want 1h", got)
+		}
+	})
+
+	t.Run("stops when context is cancelled", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		opts := fastRetry(false)
+		opts.Retry.BaseDelay = time.Hour
+		opts.Retry.MaxDelay = time.Hour
+		c := New(server.URL, "user@example.com", "token", opts)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		_, err := c.Get(ctx, "/api/test")
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("Get() took %v, should stop when the context ends", elapsed)
+		}
+	})
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	for retry := 1; retry <= 6; retry++ {
+		d, ok := p.backoff(retry, 0)
+		if !ok {
+			t.Fatalf("backoff(%d) not ok", retry)
+		}
+		full := p.BaseDelay << (retry - 1)
+		if full > p.MaxDelay {
+			full = p.MaxDelay
+		}
+		if d < full/2 || d > full {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", retry, d, full/2, full)
+		}
+	}
+
+	if d, ok := p.backoff(1, 700*time.Millisecond); !ok || d != 700*time.Millisecond {
+		t.Errorf("backoff with hint = %v, %v; want 700ms, true", d, ok)
+	}
+	if _, ok := p.backoff(1, 2*time.Second); ok {
+		t.Error("backoff with hint beyond MaxDelay should give up")
+	}
+}
+
 func TestOptions_timeoutOrDefault(t *testing.T) {
 	t.Run("nil options", func(t *testing.T) {
 		var opts *Options
@@ -2,7 +2,10 @@ package client
 
 import (
 	"io"
+	"net/http"
 	"time"
+
+	"github.com/open-cli-collective/atlassian-go/auth"
 )
 
 // DefaultTimeout is the default HTTP request timeout.
@@ -13,11 +16,28 @@ type Options struct {
 	// Timeout for HTTP requests. Defaults to 30 seconds if not set.
 	Timeout time.Duration
 
-	// Verbose enables request/response logging.
+	// Verbose enables a one-line log of each request and response.
 	Verbose bool
 
 	// VerboseOut is the writer for verbose output. Defaults to os.Stderr.
 	VerboseOut io.Writer
+
+	// Retry controls retrying of failed requests. Defaults to
+	// DefaultRetryPolicy(); pass &RetryPolicy{} to disable retries.
+	Retry *RetryPolicy
+
+	// Auth authenticates requests, e.g. with a bearer token or OAuth 2.0.
+	// Defaults to Basic authentication with the email and API token given
+	// to New.
+	Auth auth.Authenticator
+
+	// Transport sends requests, e.g. a Recorder or Replayer. Defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// TraceOut, if set, receives a JSON TraceEvent per request with timing,
+	// headers and bodies, with credentials redacted.
+	TraceOut io.Writer
 }
 
 // timeoutOrDefault returns the configured timeout or the default.
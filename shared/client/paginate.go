@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"iter"
+)
+
+// Cursor identifies a page of results.
+//
+// Atlassian APIs use three pagination styles, and an endpoint normally uses
+// only one of them:
+//   - offset: startAt/maxResults (Jira REST and Agile APIs)
+//   - token: nextPageToken (Jira JQL search)
+//   - link: a "next" URL such as _links.next (Confluence v2, Automation)
+//
+// The zero Cursor identifies the first page.
+type Cursor struct {
+	// StartAt is the offset of the first item on the page.
+	StartAt int
+
+	// Token is an opaque page token returned by the previous page.
+	Token string
+
+	// URL is the link to the page, as returned by the previous page.
+	URL string
+}
+
+// PageFunc fetches the page identified by cursor. It returns the items on
+// that page and the cursor of the following page, or nil if it was the last.
+type PageFunc[T any] func(ctx context.Context, cursor Cursor) ([]T, *Cursor, error)
+
+// Paginate returns an iterator over every item of a paginated endpoint,
+// fetching pages lazily as the caller ranges over it.
+//
+// Iteration stops after the last page, on the first error (which is yielded
+// with a zero item), when ctx is done, or when the caller breaks out of the
+// loop. A page that returns its own cursor as the next cursor is treated as
+// the last page, so a misbehaving endpoint cannot cause an infinite loop.
+func Paginate[T any](ctx context.Context, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		cursor := Cursor{}
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, next, err := fetch(ctx, cursor)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if next == nil || *next == cursor || len(items) == 0 {
+				return
+			}
+			cursor = *next
+		}
+	}
+}
+
+// Collect gathers items from seq into a slice, stopping after limit items.
+// A limit of zero or less collects every item. The first error ends
+// collection and is returned along with nothing collected.
+func Collect[T any](seq iter.Seq2[T, error], limit int) ([]T, error) {
+	var items []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if limit > 0 && len(items) >= limit {
+			break
+		}
+	}
+	return items, nil
+}
+
+// NextOffset returns the cursor of the page after an offset-paginated page
+// that started at startAt and held count items, or nil if it was the last.
+// Pass total <= 0 when the endpoint does not report a total.
+func NextOffset(startAt, count, total int, isLast bool) *Cursor {
+	if isLast || count == 0 {
+		return nil
+	}
+	next := startAt + count
+	if total > 0 && next >= total {
+		return nil
+	}
+	return &Cursor{StartAt: next}
+}
+
+// NextToken returns the cursor for a nextPageToken, or nil if token is empty.
+func NextToken(token string) *Cursor {
+	if token == "" {
+		return nil
+	}
+	return &Cursor{Token: token}
+}
+
+// NextLink returns the cursor for a "next" link, or nil if link is empty.
+func NextLink(link string) *Cursor {
+	if link == "" {
+		return nil
+	}
+	return &Cursor{URL: link}
+}
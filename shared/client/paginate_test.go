@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Run("offset pages", func(t *testing.T) {
+		data := []int{1, 2, 3, 4, 5, 6, 7}
+		var calls int
+		fetch := func(_ context.Context, c Cursor) ([]int, *Cursor, error) {
+			calls++
+			end := min(c.StartAt+3, len(data))
+			page := data[c.StartAt:end]
+			return page, NextOffset(c.StartAt, len(page), len(data), false), nil
+		}
+
+		got, err := Collect(Paginate(context.Background(), fetch), 0)
+		if err != nil {
+			t.Fatalf("Collect() error = %v", err)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(data) {
+			t.Errorf("got %v, want %v", got, data)
+		}
+		if calls != 3 {
+			t.Errorf("calls = %d, want 3", calls)
+		}
+	})
+
+	t.Run("token pages", func(t *testing.T) {
+		pages := map[string][]string{"": {"a", "b"}, "t1": {"c"}, "t2": {"d"}}
+		next := map[string]string{"": "t1", "t1": "t2", "t2": ""}
+		fetch := func(_ context.Context, c Cursor) ([]string, *Cursor, error) {
+			return pages[c.Token], NextToken(next[c.Token]), nil
+		}
+
+		got, err := Collect(Paginate(context.Background(), fetch), 0)
+		if err != nil {
+			t.Fatalf("Collect() error = %v", err)
+		}
+		if fmt.Sprint(got) != "[a b c d]" {
+			t.Errorf("got %v, want [a b c d]", got)
+		}
+	})
+
+	t.Run("link pages", func(t *testing.T) {
+		fetch := func(_ context.Context, c Cursor) ([]string, *Cursor, error) {
+			if c.URL == "" {
+				return []string{"first"}, NextLink("/next?cursor=abc"), nil
+			}
+			return []string{"second"}, NextLink(""), nil
+		}
+
+		got, err := Collect(Paginate(context.Background(), fetch), 0)
+		if err != nil {
+			t.Fatalf("Collect() error = %v", err)
+		}
+		if fmt.Sprint(got) != "[first second]" {
+			t.Errorf("got %v, want [first second]", got)
+		}
+	})
+
+	t.Run("limit stops fetching", func(t *testing.T) {
+		var calls int
+		fetch := func(_ context.Context, c Cursor) ([]int, *Cursor, error) {
+			calls++
+			return []int{c.StartAt, c.StartAt + 1}, NextOffset(c.StartAt, 2, 0, false), nil
+		}
+
+		got, err := Collect(Paginate(context.Background(), fetch), 3)
+		if err != nil {
+			t.Fatalf("Collect() error = %v", err)
+		}
+		if len(got) != 3 {
+			t.Errorf("len = %d, want 3", len(got))
+		}
+		if calls != 2 {
+			t.Errorf("calls = %d, want 2", calls)
+		}
+	})
+
+	t.Run("error ends iteration", func(t *testing.T) {
+		fetch := func(_ context.Context, c Cursor) ([]int, *Cursor, error) {
+			if c.StartAt > 0 {
+				return nil, nil, fmt.Errorf("boom")
+			}
+			return []int{1}, &Cursor{StartAt: 1}, nil
+		}
+
+		_, err := Collect(Paginate(context.Background(), fetch), 0)
+		if err == nil || err.Error() != "boom" {
+			t.Errorf("err = %v, want boom", err)
+		}
+	})
+
+	t.Run("repeated cursor stops", func(t *testing.T) {
+		var calls int
+		fetch := func(_ context.Context, c Cursor) ([]int, *Cursor, error) {
+			calls++
+			return []int{calls}, NextLink("/same"), nil
+		}
+
+		got, err := Collect(Paginate(context.Background(), fetch), 0)
+		if err != nil {
+			t.Fatalf("Collect() error = %v", err)
+		}
+		if len(got) != 2 || calls != 2 {
+			t.Errorf("got %v after %d calls, want 2 items after 2 calls", got, calls)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		fetch := func(_ context.Context, _ Cursor) ([]int, *Cursor, error) {
+			t.Error("fetch should not be called")
+			return nil, nil, nil
+		}
+
+		if _, err := Collect(Paginate(ctx, fetch), 0); err == nil {
+			t.Error("Expected error for cancelled context")
+		}
+	})
+}
+
+func TestNextOffset(t *testing.T) {
+	tests := []struct {
+		name                  string
+		startAt, count, total int
+		isLast                bool
+		want                  *Cursor
+	}{
+		{"more by total", 0, 50, 120, false, &Cursor{StartAt: 50}},
+		{"reached total", 100, 20, 120, false, nil},
+		{"is last", 0, 50, 0, true, nil},
+		{"empty page", 50, 0, 0, false, nil},
+		{"unknown total", 50, 50, 0, false, &Cursor{StartAt: 100}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NextOffset(tt.startAt, tt.count, tt.total, tt.isLast)
+			if (got == nil) != (tt.want == nil) || (got != nil && *got != *tt.want) {
+				t.Errorf("NextOffset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// Default retry settings used when Options.Retry is nil.
+const (
+	DefaultMaxRetries = 3
+	DefaultBaseDelay  = 500 * time.Millisecond
+	DefaultMaxDelay   = 30 * time.Second
+)
+
+// RetryPolicy controls how failed requests are retried.
+//
+// Requests are retried on network errors, 429 Too Many Requests and 5xx
+// responses (except 501 Not Implemented). Delays grow exponentially from
+// BaseDelay with random jitter, unless the server asks for a specific wait
+// via Retry-After or X-RateLimit-Reset.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	// Zero disables retrying.
+	MaxRetries int
+
+	// BaseDelay is the delay before the first retry. Defaults to DefaultBaseDelay.
+	BaseDelay time.Duration
+
+	// MaxDelay caps the delay between attempts. If the server asks for a
+	// longer wait than this, the request fails instead of sleeping.
+	// Defaults to DefaultMaxDelay.
+	MaxDelay time.Duration
+
+	// RetryPOST allows POST requests to be retried. POST is not idempotent
+	// in general, so it is only retried when this is set or the request
+	// context is marked with WithIdempotent.
+	RetryPOST bool
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		BaseDelay:  DefaultBaseDelay,
+		MaxDelay:   DefaultMaxDelay,
+	}
+}
+
+type idempotentKey struct{}
+
+// WithIdempotent marks requests made with the returned context as safe to
+// retry regardless of method. Use it for read-only POST endpoints such as
+// JQL search.
+func WithIdempotent(ctx context.Context) context.Context {
+	return context.WithValue(ctx, idempotentKey{}, true)
+}
+
+// isIdempotent reports whether the request may be retried under this policy.
+func (p *RetryPolicy) isIdempotent(ctx context.Context, method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		if p.RetryPOST {
+			return true
+		}
+	}
+	marked, _ := ctx.Value(idempotentKey{}).(bool)
+	return marked
+}
+
+// retryableStatus reports whether a response status is worth retrying.
+func retryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests ||
+		(code >= 500 && code != http.StatusNotImplemented)
+}
+
+// backoff returns the delay before the given retry (1-based), using
+// exponential growth with equal jitter and honoring the server's hint.
+// The boolean is false when the server asked for a wait beyond MaxDelay.
+func (p *RetryPolicy) backoff(retry int, serverHint time.Duration) (time.Duration, bool) {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = DefaultBaseDelay
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = DefaultMaxDelay
+	}
+
+	if serverHint > 0 {
+		if serverHint > maxDelay {
+			return 0, false
+		}
+		return serverHint, true
+	}
+
+	d := base << (retry - 1)
+	if d <= 0 || d > maxDelay {
+		d = maxDelay
+	}
+	half := d / 2
+	return half + rand.N(half+1), true
+}
+
+// sleep waits for d or until ctx is done, whichever comes first.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
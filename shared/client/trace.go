@@ -0,0 +1,272 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+// DefaultTraceBodyLimit is the number of body bytes kept in a trace event
+// when Tracer.BodyLimit is zero.
+const DefaultTraceBodyLimit = 64 * 1024
+
+// TraceEvent describes one HTTP round trip. Tracers write them to the trace
+// sink as JSON lines.
+type TraceEvent struct {
+	Time       time.Time `json:"time"`
+	Method     string    `json:"method"`
+	URL        string    `json:"url"`
+	Attempt    int       `json:"attempt,omitempty"`
+	Status     int       `json:"status,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	DurationMS float64   `json:"duration_ms"`
+
+	// LatencyMS is the time until the response headers arrived;
+	// DurationMS also includes reading the response body.
+	LatencyMS float64 `json:"latency_ms"`
+
+	RequestHeaders  http.Header `json:"request_headers,omitempty"`
+	RequestBody     *TraceBody  `json:"request_body,omitempty"`
+	ResponseHeaders http.Header `json:"response_headers,omitempty"`
+	ResponseBody    *TraceBody  `json:"response_body,omitempty"`
+}
+
+// TraceBody is a request or response body in a trace event. Binary bodies
+// are reduced to their size.
+type TraceBody struct {
+	Size      int64  `json:"size"`
+	Text      string `json:"text,omitempty"`
+	Binary    bool   `json:"binary,omitempty"`
+	Truncated bool   `json:"truncated,omitempty"`
+}
+
+// Tracer is an http.RoundTripper middleware that logs every request passing
+// through it: a one-line summary to Verbose, and a TraceEvent with headers,
+// bodies and timing to Out. Credentials are redacted the same way as in
+// cassettes, and bodies are truncated to BodyLimit bytes.
+//
+// Bodies are captured as they stream, so uploads and downloads are not
+// buffered; an event is written once the response body is closed.
+type Tracer struct {
+	// Next sends the requests. Defaults to http.DefaultTransport.
+	Next http.RoundTripper
+
+	// Verbose receives "→ METHOD url" and "← status (duration)" lines.
+	Verbose io.Writer
+
+	// Out receives a JSON TraceEvent per line.
+	Out io.Writer
+
+	// BodyLimit caps the body bytes kept per event. Defaults to
+	// DefaultTraceBodyLimit.
+	BodyLimit int
+
+	mu sync.Mutex
+}
+
+type attemptKey struct{}
+
+// withAttempt records the retry attempt of a request for tracing.
+func withAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Tracer) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	start := time.Now()
+	event := &TraceEvent{
+		Time:           start.UTC(),
+		Method:         req.Method,
+		URL:            redactURL(req.URL),
+		RequestHeaders: redactHeaders(req.Header),
+	}
+	if attempt, ok := req.Context().Value(attemptKey{}).(int); ok {
+		event.Attempt = attempt
+	}
+
+	var reqCapture *bodyCapture
+	if req.Body != nil && req.Body != http.NoBody {
+		reqCapture = t.capture(req.Body)
+		req = req.Clone(req.Context())
+		req.Body = reqCapture
+	}
+
+	if t.Verbose != nil {
+		t.printf("→ %s %s\n", req.Method, event.URL)
+	}
+
+	resp, err := next.RoundTrip(req)
+	event.LatencyMS = milliseconds(time.Since(start))
+	event.RequestBody = reqCapture.body()
+	if err != nil {
+		event.Error = err.Error()
+		event.DurationMS = event.LatencyMS
+		if t.Verbose != nil {
+			t.printf("← error after %s: %v\n", time.Since(start).Round(time.Millisecond), err)
+		}
+		t.write(event)
+		return nil, err
+	}
+
+	event.Status = resp.StatusCode
+	event.ResponseHeaders = redactHeaders(resp.Header)
+	if t.Verbose != nil {
+		t.printf("← %d %s (%s)\n", resp.StatusCode, http.StatusText(resp.StatusCode), time.Since(start).Round(time.Millisecond))
+	}
+
+	respCapture := t.capture(resp.Body)
+	respCapture.onClose = func() {
+		event.DurationMS = milliseconds(time.Since(start))
+		event.ResponseBody = respCapture.body()
+		t.write(event)
+	}
+	resp.Body = respCapture
+	return resp, nil
+}
+
+func (t *Tracer) capture(body io.ReadCloser) *bodyCapture {
+	limit := t.BodyLimit
+	if limit <= 0 {
+		limit = DefaultTraceBodyLimit
+	}
+	return &bodyCapture{ReadCloser: body, limit: limit}
+}
+
+func (t *Tracer) printf(format string, args ...interface{}) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, _ = fmt.Fprintf(t.Verbose, format, args...)
+}
+
+func (t *Tracer) write(event *TraceEvent) {
+	if t.Out == nil {
+		return
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, _ = t.Out.Write(append(data, '\n'))
+}
+
+// bodyCapture keeps the first limit bytes read through a body.
+type bodyCapture struct {
+	io.ReadCloser
+	limit   int
+	onClose func()
+
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	size      int64
+	truncated bool
+	closed    bool
+}
+
+func (b *bodyCapture) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		b.mu.Lock()
+		b.size += int64(n)
+		if room := b.limit - b.buf.Len(); room > 0 {
+			b.buf.Write(p[:min(n, room)])
+		}
+		if b.buf.Len() >= b.limit && b.size > int64(b.limit) {
+			b.truncated = true
+		}
+		b.mu.Unlock()
+	}
+	return n, err
+}
+
+func (b *bodyCapture) Close() error {
+	err := b.ReadCloser.Close()
+	b.mu.Lock()
+	first := !b.closed
+	b.closed = true
+	b.mu.Unlock()
+	if first && b.onClose != nil {
+		b.onClose()
+	}
+	return err
+}
+
+// body returns the captured body, redacted, or nil if nothing was sent.
+func (b *bodyCapture) body() *TraceBody {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.size == 0 {
+		return nil
+	}
+
+	data := b.buf.Bytes()
+	tb := &TraceBody{Size: b.size, Truncated: b.truncated}
+	if b.truncated {
+		// Don't count a multi-byte character cut at the limit as binary
+		for i := 0; i < utf8.UTFMax && len(data) > 0 && !utf8.Valid(data); i++ {
+			data = data[:len(data)-1]
+		}
+	}
+	text, encoding := encodeBody(data)
+	if encoding != "" {
+		tb.Binary = true
+	} else {
+		tb.Text = text
+	}
+	return tb
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d.Microseconds()) / 1000
+}
+
+// TraceFile is the file given with --trace-file, opened on first use so
+// that every client of a command appends to it.
+type TraceFile struct {
+	f *os.File
+}
+
+// Open opens path for appending the first time it is called and returns
+// the same file afterwards. An empty path disables tracing and returns nil.
+func (t *TraceFile) Open(path string) (io.Writer, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if t.f == nil {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		t.f = f
+	}
+	return t.f, nil
+}
+
+// Close closes the file, if it was opened.
+func (t *TraceFile) Close() error {
+	if t.f == nil {
+		return nil
+	}
+	err := t.f.Close()
+	t.f = nil
+	if err != nil {
+		return fmt.Errorf("failed to close trace file: %w", err)
+	}
+	return nil
+}
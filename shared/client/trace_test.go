@@ -0,0 +1,204 @@
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/open-cli-collective/atlassian-go/auth"
+)
+
+func readTrace(t *testing.T, r io.Reader) []TraceEvent {
+	t.Helper()
+	var events []TraceEvent
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		var e TraceEvent
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			t.Fatalf("trace line %q is not JSON: %v", scanner.Text(), err)
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+func TestTracer_TracesClientRequests(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = io.WriteString(w, `{"refresh_token":"secret-refresh","big":"`+strings.Repeat("x", 100)+`"}`)
+	}))
+	defer server.Close()
+
+	var trace, verbose bytes.Buffer
+	c := New(server.URL, "", "", &Options{
+		Auth:       &auth.Bearer{Token: "secret-pat"},
+		Verbose:    true,
+		VerboseOut: &verbose,
+		TraceOut:   &trace,
+		Retry:      &RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond},
+	})
+	c.HTTPClient.Transport.(*Tracer).BodyLimit = 40
+
+	if _, err := c.Post(WithIdempotent(context.Background()), "/search", map[string]string{"jql": "project = X"}); err != nil {
+		t.Fatalf("Post() error = %v", err)
+	}
+
+	if strings.Contains(trace.String(), "secret-") {
+		t.Errorf("trace contains a secret:\n%s", trace.String())
+	}
+
+	events := readTrace(t, &trace)
+	if len(events) != 2 {
+		t.Fatalf("got %d trace events, want 2:\n%s", len(events), trace.String())
+	}
+
+	first, second := events[0], events[1]
+	if first.Attempt != 1 || first.Status != http.StatusServiceUnavailable {
+		t.Errorf("first event = %+v", first)
+	}
+	if second.Attempt != 2 || second.Status != http.StatusOK || second.Method != http.MethodPost {
+		t.Errorf("second event = %+v", second)
+	}
+	if got := second.RequestHeaders.Get("Authorization"); got != Redacted {
+		t.Errorf("request Authorization = %q, want %q", got, Redacted)
+	}
+	if second.RequestBody == nil || second.RequestBody.Text != `{"jql":"project = X"}` {
+		t.Errorf("request body = %+v", second.RequestBody)
+	}
+	if b := second.ResponseBody; b == nil || !b.Truncated || b.Size <= 40 || len(b.Text) > 40 {
+		t.Errorf("response body = %+v, want truncated to 40 bytes", b)
+	}
+	if second.DurationMS < second.LatencyMS {
+		t.Errorf("duration %v < latency %v", second.DurationMS, second.LatencyMS)
+	}
+
+	out := verbose.String()
+	for _, want := range []string{"→ POST " + server.URL + "/search", "← 503", "↻ retrying", "← 200 OK ("} {
+		if !strings.Contains(out, want) {
+			t.Errorf("verbose output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestTracer_StreamsUploadsAndDownloads(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n, _ := io.Copy(io.Discard, r.Body)
+		w.Header().Set("Content-Type", "application/octet-stream")
+		_, _ = w.Write(bytes.Repeat([]byte{0xff}, int(n)))
+	}))
+	defer server.Close()
+
+	var trace bytes.Buffer
+	c := New(server.URL, "user@example.com", "token", &Options{TraceOut: &trace})
+
+	// Requests built outside of Do, like multipart uploads, are traced too
+	pr, pw := io.Pipe()
+	go func() {
+		_, _ = pw.Write(bytes.Repeat([]byte("a"), 1000))
+		_ = pw.Close()
+	}()
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/upload", pr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Authorize(req); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	if trace.Len() != 0 {
+		t.Error("event written before the response body was closed")
+	}
+	data, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if len(data) != 1000 {
+		t.Errorf("downloaded %d bytes, want 1000", len(data))
+	}
+
+	events := readTrace(t, &trace)
+	if len(events) != 1 {
+		t.Fatalf("got %d trace events, want 1", len(events))
+	}
+	e := events[0]
+	if e.Attempt != 0 || e.RequestHeaders.Get("Authorization") != Redacted {
+		t.Errorf("event = %+v", e)
+	}
+	if e.RequestBody == nil || e.RequestBody.Size != 1000 {
+		t.Errorf("request body = %+v", e.RequestBody)
+	}
+	if e.ResponseBody == nil || !e.ResponseBody.Binary || e.ResponseBody.Text != "" || e.ResponseBody.Size != 1000 {
+		t.Errorf("response body = %+v", e.ResponseBody)
+	}
+}
+
+func TestTracer_TracesNetworkErrors(t *testing.T) {
+	var trace bytes.Buffer
+	c := New("http://127.0.0.1:1", "user@example.com", "token", &Options{
+		TraceOut: &trace,
+		Retry:    &RetryPolicy{},
+	})
+
+	if _, err := c.Get(context.Background(), "/unreachable"); err == nil {
+		t.Fatal("Get() should fail")
+	}
+
+	events := readTrace(t, &trace)
+	if len(events) != 1 || events[0].Error == "" || events[0].Status != 0 {
+		t.Errorf("events = %+v", events)
+	}
+}
+
+func TestTracer_RedactsTruncatedSecrets(t *testing.T) {
+	b := &bodyCapture{ReadCloser: io.NopCloser(strings.NewReader(`{"token":"secret-value"}`)), limit: 16}
+	_, _ = io.ReadAll(b)
+	if got := b.body(); !got.Truncated || strings.Contains(got.Text, "secret") {
+		t.Errorf("body() = %+v", got)
+	}
+}
+
+func TestTraceFile(t *testing.T) {
+	var trace TraceFile
+	w, err := trace.Open("")
+	if err != nil || w != nil {
+		t.Fatalf("Open(\"\") = %v, %v, want no writer", w, err)
+	}
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+	first, err := trace.Open(path)
+	if err != nil {
+		t.Fatalf("Open() error = %v", err)
+	}
+	second, _ := trace.Open(path)
+	if first != second {
+		t.Error("Open() should return the file opened first")
+	}
+	if _, err := io.WriteString(first, "{}\n"); err != nil {
+		t.Fatalf("write error = %v", err)
+	}
+
+	if err := trace.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	if err := trace.Close(); err != nil {
+		t.Errorf("second Close() error = %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "{}\n" {
+		t.Errorf("trace file = %q", data)
+	}
+}
@@ -0,0 +1,29 @@
+package config
+
+// Auth holds the authentication settings of a profile. Tools embed it in
+// their profile type.
+type Auth struct {
+	// AuthMethod is basic (email and API token, the default), bearer
+	// (personal access token) or oauth (OAuth 2.0 app).
+	AuthMethod string `json:"auth_method,omitempty" yaml:"auth_method,omitempty"`
+
+	// CloudID addresses the site on the api.atlassian.com gateway, used
+	// with OAuth.
+	CloudID string `json:"cloud_id,omitempty" yaml:"cloud_id,omitempty"`
+
+	// OAuthClientID identifies the OAuth 2.0 app. Its client secret is kept
+	// in the credential store along with the tokens.
+	OAuthClientID string `json:"oauth_client_id,omitempty" yaml:"oauth_client_id,omitempty"`
+
+	// CredentialStore selects where the API token is kept: keyring, file,
+	// or command:<helper>. Empty picks the keyring when available.
+	CredentialStore string `json:"credential_store,omitempty" yaml:"credential_store,omitempty"`
+
+	// APIToken is kept in the credential store, not the config file. It is
+	// only read from the file to migrate configs written by older versions.
+	// With OAuth it holds the encoded auth.OAuthCredentials.
+	APIToken string `json:"api_token,omitempty" yaml:"api_token,omitempty"`
+
+	// TokenStore names the credential store the API token was loaded from.
+	TokenStore string `json:"-" yaml:"-"`
+}
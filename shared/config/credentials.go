@@ -0,0 +1,90 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/open-cli-collective/atlassian-go/auth"
+)
+
+// CredentialsFileName is the encrypted file API tokens are kept in when no
+// OS keyring is available. It lives next to the config file.
+const CredentialsFileName = "credentials"
+
+// Credential locates the API token of a profile.
+type Credential struct {
+	// Backend selects the credential store, as auth.StoreOptions.Backend.
+	Backend string
+
+	// Account is the account the token is stored under; see auth.Account.
+	Account string
+}
+
+// Credentials keeps the API tokens of a tool's profiles in the credential
+// store each profile selects.
+type Credentials struct {
+	// Service is the service name tokens are stored under, such as the
+	// tool's name.
+	Service string
+
+	// ConfigPath is the path of the tool's config file.
+	ConfigPath string
+}
+
+// Store opens the credential store selected by backend.
+func (c Credentials) Store(backend string) (auth.Store, error) {
+	return auth.OpenStore(auth.StoreOptions{
+		Backend:  backend,
+		FilePath: filepath.Join(filepath.Dir(c.ConfigPath), CredentialsFileName),
+	})
+}
+
+// Get returns the API token of cred and the name of the store it was read
+// from. A token that was never stored is returned as empty.
+func (c Credentials) Get(cred Credential) (token, store string, err error) {
+	s, err := c.Store(cred.Backend)
+	if err != nil {
+		return "", "", err
+	}
+	token, err = s.Get(c.Service, cred.Account)
+	if err != nil && !errors.Is(err, auth.ErrNotFound) {
+		return "", "", fmt.Errorf("failed to read API token from %s: %w", s.Name(), err)
+	}
+	if token == "" {
+		return "", "", nil
+	}
+	return token, s.Name(), nil
+}
+
+// Set stores token as the API token of cred and returns the name of the
+// store it was written to.
+func (c Credentials) Set(cred Credential, token string) (string, error) {
+	s, err := c.Store(cred.Backend)
+	if err != nil {
+		return "", err
+	}
+	if err := s.Set(c.Service, cred.Account, token); err != nil {
+		return "", fmt.Errorf("failed to store API token in %s: %w", s.Name(), err)
+	}
+	return s.Name(), nil
+}
+
+// Delete removes the API token of cred, unless one of the remaining
+// profiles shares it.
+func (c Credentials) Delete(cred Credential, remaining []Credential) error {
+	for _, other := range remaining {
+		if other == cred {
+			return nil
+		}
+	}
+
+	s, err := c.Store(cred.Backend)
+	if err != nil {
+		return err
+	}
+	if err := s.Delete(c.Service, cred.Account); err != nil {
+		return fmt.Errorf("failed to remove API token from %s: %w", s.Name(), err)
+	}
+	return nil
+}
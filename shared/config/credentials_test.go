@@ -0,0 +1,51 @@
+package config
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/open-cli-collective/atlassian-go/auth"
+)
+
+func TestCredentials(t *testing.T) {
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+	t.Setenv(auth.EnvCredentialPassphrase, "")
+
+	creds := Credentials{Service: "tool", ConfigPath: filepath.Join(t.TempDir(), "config.yml")}
+	work := Credential{Account: "me@work.atlassian.net"}
+	shared := Credential{Account: "me@shared.atlassian.net"}
+
+	if token, store, err := creds.Get(work); err != nil || token != "" || store != "" {
+		t.Fatalf("Get() of missing token = %q, %q, %v", token, store, err)
+	}
+
+	for _, cred := range []Credential{work, shared} {
+		store, err := creds.Set(cred, "token-"+cred.Account)
+		if err != nil {
+			t.Fatalf("Set() error = %v", err)
+		}
+		if store != auth.BackendFile {
+			t.Errorf("Set() store = %q, want %q", store, auth.BackendFile)
+		}
+	}
+
+	token, store, err := creds.Get(work)
+	if err != nil || token != "token-me@work.atlassian.net" || store != auth.BackendFile {
+		t.Errorf("Get() = %q, %q, %v", token, store, err)
+	}
+
+	// A token still used by a remaining profile is kept
+	if err := creds.Delete(shared, []Credential{work, shared}); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if token, _, _ := creds.Get(shared); token == "" {
+		t.Error("Delete() removed a token still in use")
+	}
+
+	if err := creds.Delete(work, []Credential{shared}); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if token, _, _ := creds.Get(work); token != "" {
+		t.Errorf("Get() after Delete() = %q", token)
+	}
+}
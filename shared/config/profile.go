@@ -0,0 +1,110 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// DefaultProfile is the name of the profile used when none is selected.
+const DefaultProfile = "default"
+
+// EnvProfile selects the profile for all tools. Tool-specific variables such
+// as JIRA_PROFILE or CFL_PROFILE take precedence over it.
+const EnvProfile = "ATLASSIAN_PROFILE"
+
+var profileNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_.-]*$`)
+
+// Profiles is a set of named configuration profiles, such as one per
+// Atlassian site, one of which is the default.
+type Profiles[T any] struct {
+	// Default names the profile used when none is selected explicitly.
+	// Empty means DefaultProfile.
+	Default string `json:"default_profile,omitempty" yaml:"default_profile,omitempty"`
+
+	// Profiles maps profile names to their configuration.
+	Profiles map[string]*T `json:"profiles,omitempty" yaml:"profiles,omitempty"`
+}
+
+// DefaultName returns the name of the default profile.
+func (p *Profiles[T]) DefaultName() string {
+	if p.Default != "" {
+		return p.Default
+	}
+	return DefaultProfile
+}
+
+// Names returns the profile names in sorted order.
+func (p *Profiles[T]) Names() []string {
+	names := make([]string, 0, len(p.Profiles))
+	for name := range p.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the named profile.
+func (p *Profiles[T]) Get(name string) (*T, bool) {
+	cfg, ok := p.Profiles[name]
+	return cfg, ok && cfg != nil
+}
+
+// Set adds or replaces the named profile.
+func (p *Profiles[T]) Set(name string, cfg *T) {
+	if p.Profiles == nil {
+		p.Profiles = map[string]*T{}
+	}
+	p.Profiles[name] = cfg
+}
+
+// Remove deletes the named profile, reporting whether it existed. Removing
+// the default profile resets the default to DefaultProfile.
+func (p *Profiles[T]) Remove(name string) bool {
+	if _, ok := p.Profiles[name]; !ok {
+		return false
+	}
+	delete(p.Profiles, name)
+	if p.Default == name {
+		p.Default = ""
+	}
+	return true
+}
+
+// Use makes the named profile the default.
+func (p *Profiles[T]) Use(name string) error {
+	if _, ok := p.Get(name); !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+	if name == DefaultProfile {
+		name = ""
+	}
+	p.Default = name
+	return nil
+}
+
+// SelectProfile returns the name of the profile to use.
+//
+// Precedence: flag → toolEnv (e.g. JIRA_PROFILE) → ATLASSIAN_PROFILE →
+// configured default → DefaultProfile
+func SelectProfile(flag, toolEnv, configured string) string {
+	if flag != "" {
+		return flag
+	}
+	if v := GetEnvWithFallback(toolEnv, EnvProfile); v != "" {
+		return v
+	}
+	if configured != "" {
+		return configured
+	}
+	return DefaultProfile
+}
+
+// ValidateProfileName checks that name is usable as a profile name: letters,
+// digits, '.', '_' and '-', not starting with punctuation.
+func ValidateProfileName(name string) error {
+	if !profileNameRe.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use letters, digits, '.', '_' and '-'", name)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testProfile struct {
+	URL string
+}
+
+func TestProfiles(t *testing.T) {
+	var p Profiles[testProfile]
+
+	if got := p.DefaultName(); got != DefaultProfile {
+		t.Errorf("DefaultName() = %q, want %q", got, DefaultProfile)
+	}
+	if err := p.Use("prod"); err == nil {
+		t.Error("Use() of missing profile should fail")
+	}
+
+	p.Set("sandbox", &testProfile{URL: "https://sandbox.example.com"})
+	p.Set("prod", &testProfile{URL: "https://prod.example.com"})
+
+	if got := p.Names(); !reflect.DeepEqual(got, []string{"prod", "sandbox"}) {
+		t.Errorf("Names() = %v", got)
+	}
+
+	if err := p.Use("sandbox"); err != nil {
+		t.Fatalf("Use() error = %v", err)
+	}
+	if got := p.DefaultName(); got != "sandbox" {
+		t.Errorf("DefaultName() = %q, want sandbox", got)
+	}
+
+	cfg, ok := p.Get("sandbox")
+	if !ok || cfg.URL != "https://sandbox.example.com" {
+		t.Errorf("Get() = %v, %v", cfg, ok)
+	}
+
+	if !p.Remove("sandbox") {
+		t.Error("Remove() = false, want true")
+	}
+	if p.Remove("sandbox") {
+		t.Error("Remove() of missing profile = true, want false")
+	}
+	if got := p.DefaultName(); got != DefaultProfile {
+		t.Errorf("DefaultName() after removing default = %q, want %q", got, DefaultProfile)
+	}
+}
+
+func TestSelectProfile(t *testing.T) {
+	tests := []struct {
+		name       string
+		flag       string
+		toolEnv    string
+		sharedEnv  string
+		configured string
+		want       string
+	}{
+		{name: "nothing set", want: DefaultProfile},
+		{name: "configured", configured: "prod", want: "prod"},
+		{name: "shared env over config", sharedEnv: "sandbox", configured: "prod", want: "sandbox"},
+		{name: "tool env over shared env", toolEnv: "tool", sharedEnv: "sandbox", want: "tool"},
+		{name: "flag over everything", flag: "flag", toolEnv: "tool", sharedEnv: "sandbox", configured: "prod", want: "flag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TEST_PROFILE", tt.toolEnv)
+			t.Setenv(EnvProfile, tt.sharedEnv)
+
+			if got := SelectProfile(tt.flag, "TEST_PROFILE", tt.configured); got != tt.want {
+				t.Errorf("SelectProfile() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateProfileName(t *testing.T) {
+	for _, name := range []string{"default", "prod", "sandbox-2", "team_a.eu"} {
+		if err := ValidateProfileName(name); err != nil {
+			t.Errorf("ValidateProfileName(%q) error = %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "-x", "has space", "a/b"} {
+		if err := ValidateProfileName(name); err == nil {
+			t.Errorf("ValidateProfileName(%q) should fail", name)
+		}
+	}
+}
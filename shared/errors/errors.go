@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 )
 
 // Sentinel errors for common HTTP status codes.
@@ -27,6 +29,14 @@ type APIError struct {
 	ErrorMessages []string          `json:"errorMessages,omitempty"`
 	Errors        map[string]string `json:"errors,omitempty"`
 	ErrorList     []string          `json:"-"` // Confluence uses "errors" as array
+
+	// Body is the raw response body, for endpoints whose error responses
+	// carry more than the common fields, such as Jira's bulk operations.
+	Body []byte `json:"-"`
+
+	// RetryAfter is the server-requested wait before retrying, taken from the
+	// Retry-After or X-RateLimit-Reset response headers. Zero if not provided.
+	RetryAfter time.Duration `json:"-"`
 }
 
 // UnmarshalJSON handles both Jira and Confluence error formats.
@@ -93,15 +103,42 @@ func (e *APIError) Error() string {
 	return strings.Join(parts, "; ")
 }
 
+// statusError pairs a sentinel error with the APIError that produced it, so
+// callers can match the category with errors.Is and still reach the response
+// details (including RetryAfter) with errors.As.
+type statusError struct {
+	sentinel error
+	apiErr   *APIError
+}
+
+func (e *statusError) Error() string {
+	details := e.apiErr.Error()
+	if details == fmt.Sprintf("API error (status %d)", e.apiErr.StatusCode) {
+		return e.sentinel.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.sentinel, details)
+}
+
+func (e *statusError) Unwrap() []error {
+	return []error{e.sentinel, e.apiErr}
+}
+
 // ParseAPIError parses an HTTP response body into an appropriate error type.
 // It returns sentinel errors for common status codes, wrapping the APIError
 // details when additional information is available.
 func ParseAPIError(statusCode int, body []byte) error {
-	apiErr := &APIError{StatusCode: statusCode}
+	return ParseAPIErrorWithHeader(statusCode, nil, body)
+}
+
+// ParseAPIErrorWithHeader is like ParseAPIError but also records the
+// server's retry hint from the response headers in APIError.RetryAfter.
+func ParseAPIErrorWithHeader(statusCode int, header http.Header, body []byte) error {
+	apiErr := &APIError{StatusCode: statusCode, Body: body}
 
 	if len(body) > 0 {
 		_ = json.Unmarshal(body, apiErr)
 	}
+	apiErr.RetryAfter = ParseRetryAfter(header, time.Now())
 
 	// Determine the base sentinel error
 	var sentinel error
@@ -115,7 +152,7 @@ func ParseAPIError(statusCode int, body []byte) error {
 	case http.StatusBadRequest:
 		sentinel = ErrBadRequest
 	case http.StatusTooManyRequests:
-		return ErrRateLimited
+		sentinel = ErrRateLimited
 	default:
 		if statusCode >= 500 {
 			sentinel = ErrServerError
@@ -125,14 +162,60 @@ func ParseAPIError(statusCode int, body []byte) error {
 		}
 	}
 
-	// If we have additional details, wrap the sentinel error
-	details := apiErr.Error()
-	genericMsg := fmt.Sprintf("API error (status %d)", statusCode)
-	if details != genericMsg {
-		return fmt.Errorf("%w: %s", sentinel, details)
+	return &statusError{sentinel: sentinel, apiErr: apiErr}
+}
+
+// ParseRetryAfter returns how long the server asked the client to wait
+// before retrying, or zero if the headers carry no hint.
+//
+// It understands Retry-After (delta-seconds or an HTTP date), Atlassian's
+// Beta-Retry-After, and the X-RateLimit-Reset timestamp.
+func ParseRetryAfter(header http.Header, now time.Time) time.Duration {
+	if header == nil {
+		return 0
 	}
 
-	return sentinel
+	for _, name := range []string{"Retry-After", "Beta-Retry-After"} {
+		v := strings.TrimSpace(header.Get(name))
+		if v == "" {
+			continue
+		}
+		if secs, err := strconv.Atoi(v); err == nil {
+			if secs < 0 {
+				return 0
+			}
+			return time.Duration(secs) * time.Second
+		}
+		if t, err := http.ParseTime(v); err == nil {
+			return positiveUntil(t, now)
+		}
+	}
+
+	if v := strings.TrimSpace(header.Get("X-RateLimit-Reset")); v != "" {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return positiveUntil(t, now)
+		}
+	}
+
+	return 0
+}
+
+// positiveUntil returns the time from now until t, or zero if t has passed.
+func positiveUntil(t, now time.Time) time.Duration {
+	if d := t.Sub(now); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// RetryAfter returns the server-requested retry delay carried by err,
+// or zero if err is not an API error or carries no hint.
+func RetryAfter(err error) time.Duration {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.RetryAfter
+	}
+	return 0
 }
 
 // IsNotFound returns true if the error is or wraps ErrNotFound.
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestAPIError_UnmarshalJSON(t *testing.T) {
@@ -218,6 +219,9 @@ func TestParseAPIError_ReturnsAPIError(t *testing.T) {
 	if apiErr.StatusCode != 422 {
 		t.Errorf("StatusCode = %d, want 422", apiErr.StatusCode)
 	}
+	if string(apiErr.Body) != string(body) {
+		t.Errorf("Body = %q, want %q", apiErr.Body, body)
+	}
 }
 
 func TestIsHelpers(t *testing.T) {
@@ -292,3 +296,55 @@ func TestParseAPIError_WithComplexConfluenceResponse(t *testing.T) {
 		t.Errorf("Error should contain 'No content found', got: %s", errStr)
 	}
 }
+
+func TestParseAPIError_SentinelKeepsAPIError(t *testing.T) {
+	body := []byte(`{"message": "Rate limit exceeded"}`)
+	header := http.Header{"Retry-After": []string{"7"}}
+
+	err := ParseAPIErrorWithHeader(http.StatusTooManyRequests, header, body)
+
+	if !IsRateLimited(err) {
+		t.Errorf("Expected ErrRateLimited, got %v", err)
+	}
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected APIError to be reachable, got %T", err)
+	}
+	if apiErr.RetryAfter != 7*time.Second {
+		t.Errorf("RetryAfter = %v, want 7s", apiErr.RetryAfter)
+	}
+	if RetryAfter(err) != 7*time.Second {
+		t.Errorf("RetryAfter(err) = %v, want 7s", RetryAfter(err))
+	}
+	if !strings.Contains(err.Error(), "Rate limit exceeded") {
+		t.Errorf("Error should contain details, got: %s", err.Error())
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		header http.Header
+		want   time.Duration
+	}{
+		{"nil header", nil, 0},
+		{"no hint", http.Header{}, 0},
+		{"seconds", http.Header{"Retry-After": []string{"30"}}, 30 * time.Second},
+		{"http date", http.Header{"Retry-After": []string{now.Add(time.Minute).Format(http.TimeFormat)}}, time.Minute},
+		{"beta header", http.Header{"Beta-Retry-After": []string{"5"}}, 5 * time.Second},
+		{"rate limit reset", http.Header{"X-Ratelimit-Reset": []string{now.Add(10 * time.Second).Format(time.RFC3339)}}, 10 * time.Second},
+		{"reset in the past", http.Header{"X-Ratelimit-Reset": []string{now.Add(-time.Minute).Format(time.RFC3339)}}, 0},
+		{"garbage", http.Header{"Retry-After": []string{"soon"}}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseRetryAfter(tt.header, now); got != tt.want {
+				t.Errorf("ParseRetryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
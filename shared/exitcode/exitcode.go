@@ -32,4 +32,8 @@ const (
 
 	// ServerError indicates a server-side error
 	ServerError = 8
+
+	// Interrupted indicates the command was cancelled by SIGINT (Ctrl-C) or
+	// SIGTERM, following the shell convention of 128 + SIGINT
+	Interrupted = 130
 )
@@ -0,0 +1,223 @@
+package view
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// writeDelimited writes headers and rows as CSV (comma ',') or TSV
+// (comma '\t'), quoting fields that contain separators, quotes or newlines.
+func writeDelimited(w io.Writer, comma rune, headers []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+// writeObjectsDelimited writes data, a list of objects (or one object), as
+// CSV or TSV. The columns are the object keys in the order first seen;
+// nested values are written as compact JSON.
+func writeObjectsDelimited(w io.Writer, comma rune, data interface{}) error {
+	items, err := jsonItems(data)
+	if err != nil {
+		return err
+	}
+
+	var headers []string
+	seen := make(map[string]bool)
+	objects := make([]map[string]json.RawMessage, 0, len(items))
+	for _, item := range items {
+		keys, values, err := decodeObject(item)
+		if err != nil {
+			return err
+		}
+		for _, k := range keys {
+			if !seen[k] {
+				seen[k] = true
+				headers = append(headers, k)
+			}
+		}
+		objects = append(objects, values)
+	}
+
+	rows := make([][]string, 0, len(objects))
+	for _, obj := range objects {
+		row := make([]string, len(headers))
+		for i, h := range headers {
+			row[i] = cellText(obj[h])
+		}
+		rows = append(rows, row)
+	}
+	return writeDelimited(w, comma, headers, rows)
+}
+
+// writeNDJSON writes data as newline-delimited JSON: one compact line per
+// element if data is a list, otherwise a single line.
+func writeNDJSON(w io.Writer, data interface{}) error {
+	items, err := jsonItems(data)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, item); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeYAML writes data as YAML. Data is converted through its JSON
+// encoding so that json struct tags and field order carry over.
+func writeYAML(w io.Writer, data interface{}) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	node, err := yamlNode(dec)
+	if err != nil {
+		return err
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// jsonItems returns the JSON encoding of each element of data if it
+// encodes as an array, or of data itself otherwise.
+func jsonItems(data interface{}) ([]json.RawMessage, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.HasPrefix(raw, []byte("[")) {
+		return []json.RawMessage{raw}, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// decodeObject decodes a JSON object, returning its keys in order.
+func decodeObject(raw json.RawMessage) ([]string, map[string]json.RawMessage, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, nil, errors.New("csv and tsv output need a list of objects; use -o json or yaml for this command")
+	}
+
+	var keys []string
+	values := make(map[string]json.RawMessage)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		key, _ := tok.(string)
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return nil, nil, err
+		}
+		if _, dup := values[key]; !dup {
+			keys = append(keys, key)
+		}
+		values[key] = value
+	}
+	return keys, values, nil
+}
+
+// cellText returns a JSON value as CSV cell text: strings unquoted, null
+// empty, and anything else as compact JSON.
+func cellText(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return string(raw)
+	}
+	return buf.String()
+}
+
+// yamlNode builds a YAML node from the next JSON value in dec, keeping
+// object keys in order.
+func yamlNode(dec *json.Decoder) (*yaml.Node, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := tok.(type) {
+	case json.Delim:
+		node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+		if t == '{' {
+			node = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		}
+		for dec.More() {
+			if node.Kind == yaml.MappingNode {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, yamlScalar("!!str", fmt.Sprint(key)))
+			}
+			child, err := yamlNode(dec)
+			if err != nil {
+				return nil, err
+			}
+			node.Content = append(node.Content, child)
+		}
+		// Consume the closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return node, nil
+	case string:
+		return yamlScalar("!!str", t), nil
+	case json.Number:
+		if strings.ContainsAny(t.String(), ".eE") {
+			return yamlScalar("!!float", t.String()), nil
+		}
+		return yamlScalar("!!int", t.String()), nil
+	case bool:
+		return yamlScalar("!!bool", fmt.Sprint(t)), nil
+	case nil:
+		return yamlScalar("!!null", "null"), nil
+	}
+	return nil, fmt.Errorf("unexpected JSON token %v", tok)
+}
+
+func yamlScalar(tag, value string) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: value}
+	if tag == "!!str" && strings.Contains(value, "\n") {
+		node.Style = yaml.LiteralStyle
+	}
+	return node
+}
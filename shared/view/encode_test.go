@@ -0,0 +1,128 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type encodeIssue struct {
+	Key     string   `json:"key"`
+	Summary string   `json:"summary"`
+	Points  float64  `json:"points,omitempty"`
+	Labels  []string `json:"labels,omitempty"`
+}
+
+func TestView_Table_Delimited(t *testing.T) {
+	headers := []string{"KEY", "SUMMARY"}
+	rows := [][]string{
+		{"PROJ-1", `Fix "quoted", comma`},
+		{"PROJ-2", "tab\there\nnewline"},
+	}
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{FormatCSV, "KEY,SUMMARY\nPROJ-1,\"Fix \"\"quoted\"\", comma\"\nPROJ-2,\"tab\there\nnewline\"\n"},
+		{FormatTSV, "KEY\tSUMMARY\nPROJ-1\t\"Fix \"\"quoted\"\", comma\"\nPROJ-2\t\"tab\there\nnewline\"\n"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			v := New(tt.format, true)
+			v.SetOutput(buf)
+
+			if err := v.Table(headers, rows); err != nil {
+				t.Fatalf("Table() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("Table() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestView_StructuredFormats(t *testing.T) {
+	issues := []encodeIssue{
+		{Key: "PROJ-1", Summary: "First", Points: 3, Labels: []string{"a", "b"}},
+		{Key: "PROJ-2", Summary: "Two\nlines"},
+	}
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{FormatNDJSON, `{"key":"PROJ-1","summary":"First","points":3,"labels":["a","b"]}` + "\n" +
+			`{"key":"PROJ-2","summary":"Two\nlines"}` + "\n"},
+		{FormatYAML, "- key: PROJ-1\n  summary: First\n  points: 3\n  labels:\n    - a\n    - b\n" +
+			"- key: PROJ-2\n  summary: |-\n    Two\n    lines\n"},
+		{FormatCSV, "key,summary,points,labels\nPROJ-1,First,3,\"[\"\"a\"\",\"\"b\"\"]\"\nPROJ-2,\"Two\nlines\",,\n"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			v := New(tt.format, true)
+			v.SetOutput(buf)
+
+			if err := v.JSON(issues); err != nil {
+				t.Fatalf("JSON() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("JSON() =\n%s\nwant\n%s", buf.String(), tt.want)
+			}
+		})
+	}
+
+	t.Run("csv of a scalar list fails", func(t *testing.T) {
+		v := New(FormatCSV, true)
+		v.SetOutput(&bytes.Buffer{})
+		if err := v.JSON([]string{"a"}); err == nil {
+			t.Error("JSON() should fail for a list of strings")
+		}
+	})
+}
+
+func TestView_RenderList_Formats(t *testing.T) {
+	headers := []string{"ID", "NAME"}
+	rows := [][]string{{"1", "First"}, {"2", "Second"}}
+
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{FormatNDJSON, "{\"id\":\"1\",\"name\":\"First\"}\n{\"id\":\"2\",\"name\":\"Second\"}\n"},
+		{FormatYAML, "results:\n  - id: \"1\"\n    name: First\n  - id: \"2\"\n    name: Second\n_meta:\n  count: 2\n  hasMore: true\n"},
+		{FormatTSV, "ID\tNAME\n1\tFirst\n2\tSecond\n"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			v := New(tt.format, true)
+			v.SetOutput(buf)
+
+			if err := v.RenderList(headers, rows, true); err != nil {
+				t.Fatalf("RenderList() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("RenderList() =\n%s\nwant\n%s", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestIsStructured(t *testing.T) {
+	for _, f := range ValidFormats() {
+		structured := f == "json" || f == "yaml" || f == "ndjson" || f == "template"
+		if got := IsStructured(f); got != structured {
+			t.Errorf("IsStructured(%q) = %v", f, got)
+		}
+		machine := structured || f == "csv" || f == "tsv"
+		if got := IsMachineReadable(f); got != machine {
+			t.Errorf("IsMachineReadable(%q) = %v", f, got)
+		}
+	}
+	if !strings.Contains(ValidateFormat("xml").Error(), "ndjson") {
+		t.Error("ValidateFormat() error should list the valid formats")
+	}
+}
@@ -0,0 +1,98 @@
+package view
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/itchyny/gojq"
+)
+
+// ParseQuery parses and compiles a --jq expression. Syntax errors report
+// the position of the offending token in the expression.
+func ParseQuery(expr string) (*gojq.Code, error) {
+	query, err := gojq.Parse(expr)
+	if err != nil {
+		var perr *gojq.ParseError
+		if errors.As(err, &perr) {
+			return nil, queryPositionError(expr, perr)
+		}
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	code, err := gojq.Compile(query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --jq expression: %w", err)
+	}
+	return code, nil
+}
+
+// queryPositionError describes a syntax error with its 1-based character
+// position, followed by the expression and a caret under the position.
+func queryPositionError(expr string, perr *gojq.ParseError) error {
+	offset := perr.Offset - len(perr.Token)
+	if offset < 0 || offset > len(expr) {
+		offset = len(expr)
+	}
+	pos := utf8.RuneCountInString(expr[:offset])
+	return fmt.Errorf("invalid --jq expression: %s at position %d\n    %s\n    %s^",
+		perr.Error(), pos+1, expr, strings.Repeat(" ", pos))
+}
+
+// runQuery evaluates expr over data, as it would appear in JSON output,
+// and returns every result.
+func runQuery(expr string, data interface{}) ([]interface{}, error) {
+	code, err := ParseQuery(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+	var input interface{}
+	if err := json.Unmarshal(raw, &input); err != nil {
+		return nil, err
+	}
+
+	var results []interface{}
+	iter := code.Run(input)
+	for {
+		result, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := result.(error); ok {
+			var herr *gojq.HaltError
+			if errors.As(err, &herr) && herr.Value() == nil {
+				break
+			}
+			return nil, fmt.Errorf("jq: %w", err)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// writeQueryResults writes query results the way jq does: each result on
+// its own, as indented JSON, except that strings are written raw so they
+// can be used directly in shell scripts.
+func writeQueryResults(w io.Writer, results []interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	for _, result := range results {
+		if s, ok := result.(string); ok {
+			if _, err := fmt.Fprintln(w, s); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := enc.Encode(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
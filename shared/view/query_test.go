@@ -0,0 +1,92 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestView_Query(t *testing.T) {
+	issues := []encodeIssue{
+		{Key: "PROJ-1", Summary: "First", Points: 3},
+		{Key: "PROJ-2", Summary: "Second"},
+	}
+
+	tests := []struct {
+		name   string
+		format Format
+		query  string
+		want   string
+	}{
+		{"raw strings", FormatJSON, ".[].key", "PROJ-1\nPROJ-2\n"},
+		{"objects", FormatJSON, `.[] | select(.points > 0) | {key}`, "{\n  \"key\": \"PROJ-1\"\n}\n"},
+		{"numbers", FormatJSON, "length", "2\n"},
+		{"no results", FormatJSON, ".[] | select(.key == \"PROJ-9\")", ""},
+		{"yaml list", FormatYAML, "[.[].key]", "- PROJ-1\n- PROJ-2\n"},
+		{"ndjson results", FormatNDJSON, ".[] | {key}", "{\"key\":\"PROJ-1\"}\n{\"key\":\"PROJ-2\"}\n"},
+		{"csv single result", FormatCSV, "map({key})", "key\nPROJ-1\nPROJ-2\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			v := New(tt.format, true)
+			v.SetOutput(buf)
+			v.Query = tt.query
+
+			if err := v.JSON(issues); err != nil {
+				t.Fatalf("JSON() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("JSON() = %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestView_Query_TableRows(t *testing.T) {
+	buf := &bytes.Buffer{}
+	v := New(FormatJSON, true)
+	v.SetOutput(buf)
+	v.Query = `.[] | select(.status == "Done") | .key`
+
+	if err := v.Table([]string{"KEY", "STATUS"}, [][]string{{"PROJ-1", "Done"}, {"PROJ-2", "To Do"}}); err != nil {
+		t.Fatalf("Table() error = %v", err)
+	}
+	if buf.String() != "PROJ-1\n" {
+		t.Errorf("Table() = %q", buf.String())
+	}
+
+	buf.Reset()
+	v.Query = ".results | length"
+	if err := v.RenderList([]string{"KEY"}, [][]string{{"PROJ-1"}}, false); err != nil {
+		t.Fatalf("RenderList() error = %v", err)
+	}
+	if buf.String() != "1\n" {
+		t.Errorf("RenderList() = %q", buf.String())
+	}
+}
+
+func TestParseQuery_Errors(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{".[0]]", "unexpected token \"]\" at position 5\n    .[0]]\n        ^"},
+		{".foo | ", "unexpected EOF at position 8"},
+		{`.a | "é" | )`, "unexpected token \")\" at position 12"},
+		{"nosuchfunc", "function not defined: nosuchfunc/0"},
+	}
+	for _, tt := range tests {
+		_, err := ParseQuery(tt.expr)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseQuery(%q) error = %v, want %q", tt.expr, err, tt.want)
+		}
+	}
+
+	v := New(FormatJSON, true)
+	v.SetOutput(&bytes.Buffer{})
+	v.Query = ".[]"
+	if err := v.JSON("text"); err == nil || !strings.Contains(err.Error(), "jq: cannot iterate over") {
+		t.Errorf("JSON() error = %v", err)
+	}
+}
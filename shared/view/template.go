@@ -0,0 +1,216 @@
+package view
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/open-cli-collective/atlassian-go/adf"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+// timeLayouts are the timestamp formats used by Jira and Confluence.
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000-0700",
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02",
+}
+
+// TemplateFuncs are the functions available in --template templates, in
+// addition to the text/template builtins:
+//
+//	date LAYOUT TIME    reformat a timestamp with a Go time layout
+//	ago TIME            relative time, such as "3 days ago"
+//	truncate N TEXT     shorten text to N characters, ending in "..."
+//	adfText VALUE       plain text of an ADF document (or a plain string)
+//	join SEP LIST       join list elements with a separator
+//	json VALUE          compact JSON encoding of a value
+//
+// Timestamps may be RFC 3339, Jira's "2006-01-02T15:04:05.000-0700" format,
+// or milliseconds since the epoch.
+var TemplateFuncs = template.FuncMap{
+	"date":     templateDate,
+	"ago":      templateAgo,
+	"truncate": templateTruncate,
+	"adfText":  templateADFText,
+	"join":     templateJoin,
+	"json":     templateJSON,
+}
+
+// ParseTemplate parses a --template value with TemplateFuncs.
+func ParseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(TemplateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// writeTemplate executes text over data. The template sees data as it
+// would appear in JSON output, so field names match the -o json keys.
+func writeTemplate(w io.Writer, text string, data interface{}) error {
+	tmpl, err := ParseTemplate(text)
+	if err != nil {
+		return err
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	var value interface{}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	if err := dec.Decode(&value); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, value); err != nil {
+		return fmt.Errorf("template: %w", err)
+	}
+	if buf.Len() > 0 && !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+		buf.WriteByte('\n')
+	}
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+func parseTime(value interface{}) (time.Time, error) {
+	switch v := value.(type) {
+	case time.Time:
+		return v, nil
+	case json.Number:
+		ms, err := v.Int64()
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid timestamp %s", v)
+		}
+		return time.UnixMilli(ms).UTC(), nil
+	case string:
+		if ms, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return time.UnixMilli(ms).UTC(), nil
+		}
+		for _, layout := range timeLayouts {
+			if t, err := time.Parse(layout, v); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("invalid timestamp %q", v)
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %v", value)
+}
+
+func templateDate(layout string, value interface{}) (string, error) {
+	if value == nil || value == "" {
+		return "", nil
+	}
+	t, err := parseTime(value)
+	if err != nil {
+		return "", err
+	}
+	return t.Format(layout), nil
+}
+
+func templateAgo(value interface{}) (string, error) {
+	if value == nil || value == "" {
+		return "", nil
+	}
+	t, err := parseTime(value)
+	if err != nil {
+		return "", err
+	}
+
+	d := now().Sub(t)
+	suffix := "ago"
+	if d < 0 {
+		d, suffix = -d, "from now"
+	}
+
+	units := []struct {
+		name string
+		size time.Duration
+	}{
+		{"year", 365 * 24 * time.Hour},
+		{"month", 30 * 24 * time.Hour},
+		{"day", 24 * time.Hour},
+		{"hour", time.Hour},
+		{"minute", time.Minute},
+	}
+	for _, u := range units {
+		if d >= u.size {
+			n := int(math.Floor(float64(d) / float64(u.size)))
+			if n == 1 {
+				return fmt.Sprintf("1 %s %s", u.name, suffix), nil
+			}
+			return fmt.Sprintf("%d %ss %s", n, u.name, suffix), nil
+		}
+	}
+	return "just now", nil
+}
+
+func templateTruncate(n int, value interface{}) string {
+	return Truncate(templateText(value), n)
+}
+
+func templateADFText(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	var doc adf.Document
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return "", fmt.Errorf("adfText: value is not an ADF document")
+	}
+	return strings.TrimSpace(doc.ToPlainText()), nil
+}
+
+func templateJoin(sep string, value interface{}) string {
+	list, ok := value.([]interface{})
+	if !ok {
+		return templateText(value)
+	}
+	parts := make([]string, len(list))
+	for i, item := range list {
+		parts[i] = templateText(item)
+	}
+	return strings.Join(parts, sep)
+}
+
+func templateJSON(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// templateText formats a value for text output: strings as-is, nil as
+// empty, and objects or lists as JSON.
+func templateText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case map[string]interface{}, []interface{}:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,163 @@
+package view
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestView_Template(t *testing.T) {
+	type fields struct {
+		Summary     string                 `json:"summary"`
+		Created     string                 `json:"created"`
+		Description map[string]interface{} `json:"description"`
+		Labels      []string               `json:"labels"`
+	}
+	type issue struct {
+		Key    string `json:"key"`
+		Fields fields `json:"fields"`
+	}
+
+	issues := []issue{{
+		Key: "PROJ-1",
+		Fields: fields{
+			Summary: "A rather long summary line",
+			Created: "2024-01-15T10:30:00.000+0000",
+			Description: map[string]interface{}{
+				"type":    "doc",
+				"version": 1,
+				"content": []interface{}{map[string]interface{}{
+					"type":    "paragraph",
+					"content": []interface{}{map[string]interface{}{"type": "text", "text": "Hello world"}},
+				}},
+			},
+			Labels: []string{"a", "b"},
+		},
+	}}
+
+	buf := &bytes.Buffer{}
+	v := New(FormatTemplate, true)
+	v.SetOutput(buf)
+	v.Template = `{{range .}}{{.key}} {{.fields.summary | truncate 12}} {{date "2006-01-02" .fields.created}} [{{join "," .fields.labels}}] {{adfText .fields.description}}{{end}}`
+
+	if err := v.JSON(issues); err != nil {
+		t.Fatalf("JSON() error = %v", err)
+	}
+	want := "PROJ-1 A rather ... 2024-01-15 [a,b] Hello world\n"
+	if buf.String() != want {
+		t.Errorf("JSON() = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestView_Template_TableRows(t *testing.T) {
+	buf := &bytes.Buffer{}
+	v := New(FormatTemplate, true)
+	v.SetOutput(buf)
+	v.Template = `{{range .}}{{.key}}={{.status}}{{"\n"}}{{end}}`
+
+	if err := v.Table([]string{"KEY", "STATUS"}, [][]string{{"PROJ-1", "Done"}, {"PROJ-2", "To Do"}}); err != nil {
+		t.Fatalf("Table() error = %v", err)
+	}
+	if buf.String() != "PROJ-1=Done\nPROJ-2=To Do\n" {
+		t.Errorf("Table() = %q", buf.String())
+	}
+}
+
+func TestView_RenderListOf(t *testing.T) {
+	items := []map[string]interface{}{{"id": "1", "title": "First"}}
+	rows := [][]string{{"1", "First"}}
+
+	buf := &bytes.Buffer{}
+	v := New(FormatTemplate, true)
+	v.SetOutput(buf)
+	v.Template = `{{range .}}{{.title}}{{end}}`
+	if err := v.RenderListOf([]string{"ID", "TITLE"}, rows, items, false); err != nil {
+		t.Fatalf("RenderListOf() error = %v", err)
+	}
+	if buf.String() != "First\n" {
+		t.Errorf("RenderListOf() = %q, want the items rendered", buf.String())
+	}
+
+	buf.Reset()
+	v = New(FormatPlain, true)
+	v.SetOutput(buf)
+	if err := v.RenderListOf([]string{"ID", "TITLE"}, rows, items, false); err != nil {
+		t.Fatalf("RenderListOf() error = %v", err)
+	}
+	if !strings.Contains(buf.String(), "First") {
+		t.Errorf("RenderListOf() = %q, want the rows rendered", buf.String())
+	}
+}
+
+func TestView_Template_Errors(t *testing.T) {
+	if _, err := ParseTemplate("{{.key"); err == nil || !strings.Contains(err.Error(), "invalid template") {
+		t.Errorf("ParseTemplate() error = %v", err)
+	}
+
+	v := New(FormatTemplate, true)
+	v.SetOutput(&bytes.Buffer{})
+	v.Template = `{{date "2006" .created}}`
+	if err := v.JSON(map[string]string{"created": "yesterday"}); err == nil || !strings.Contains(err.Error(), `invalid timestamp "yesterday"`) {
+		t.Errorf("JSON() error = %v", err)
+	}
+}
+
+func TestTemplateAgo(t *testing.T) {
+	defer func(orig func() time.Time) { now = orig }(now)
+	now = func() time.Time { return time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		value interface{}
+		want  string
+	}{
+		{"2024-01-15T11:59:30.000+0000", "just now"},
+		{"2024-01-15T11:00:00Z", "1 hour ago"},
+		{"2024-01-12T12:00:00.000+0000", "3 days ago"},
+		{"2022-01-01", "2 years ago"},
+		{"2024-01-15T12:05:00Z", "5 minutes from now"},
+		{"1705316400000", "1 hour ago"},
+		{nil, ""},
+	}
+	for _, tt := range tests {
+		got, err := templateAgo(tt.value)
+		if err != nil {
+			t.Errorf("ago(%v) error = %v", tt.value, err)
+		}
+		if got != tt.want {
+			t.Errorf("ago(%v) = %q, want %q", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestView_Columns(t *testing.T) {
+	headers := []string{"KEY", "SUMMARY", "STATUS"}
+	rows := [][]string{{"PROJ-1", "First", "Done"}}
+
+	buf := &bytes.Buffer{}
+	v := New(FormatCSV, true)
+	v.SetOutput(buf)
+	v.Columns = []string{"status", "Key"}
+
+	if err := v.Table(headers, rows); err != nil {
+		t.Fatalf("Table() error = %v", err)
+	}
+	if buf.String() != "STATUS,KEY\nDone,PROJ-1\n" {
+		t.Errorf("Table() = %q", buf.String())
+	}
+
+	buf.Reset()
+	v.Format = FormatJSON
+	if err := v.RenderList(headers, rows, false); err != nil {
+		t.Fatalf("RenderList() error = %v", err)
+	}
+	if strings.Contains(buf.String(), "summary") {
+		t.Errorf("RenderList() kept an unselected column:\n%s", buf.String())
+	}
+
+	v.Columns = []string{"KEY", "PRIORITY"}
+	err := v.Table(headers, rows)
+	if err == nil || !strings.Contains(err.Error(), `unknown column "PRIORITY" (available: KEY, SUMMARY, STATUS)`) {
+		t.Errorf("Table() error = %v", err)
+	}
+}
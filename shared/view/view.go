@@ -17,25 +17,96 @@ type Format string
 
 // Output format constants.
 const (
-	FormatTable Format = "table"
-	FormatJSON  Format = "json"
-	FormatPlain Format = "plain"
+	FormatTable  Format = "table"
+	FormatJSON   Format = "json"
+	FormatPlain  Format = "plain"
+	FormatCSV    Format = "csv"
+	FormatTSV    Format = "tsv"
+	FormatYAML   Format = "yaml"
+	FormatNDJSON Format = "ndjson"
+
+	// FormatTemplate renders the View's Template. Setting --template
+	// selects it.
+	FormatTemplate Format = "template"
 )
 
+// formats lists the valid output formats, in the order shown in help.
+var formats = []Format{FormatTable, FormatJSON, FormatPlain, FormatCSV, FormatTSV, FormatYAML, FormatNDJSON, FormatTemplate}
+
 // ValidFormats returns the list of valid output formats.
 func ValidFormats() []string {
-	return []string{string(FormatTable), string(FormatJSON), string(FormatPlain)}
+	names := make([]string, len(formats))
+	for i, f := range formats {
+		names[i] = string(f)
+	}
+	return names
 }
 
 // ValidateFormat checks if a format string is valid.
 // Returns an error if the format is not supported.
 func ValidateFormat(format string) error {
-	switch format {
-	case "", string(FormatTable), string(FormatJSON), string(FormatPlain):
+	if format == "" {
 		return nil
-	default:
-		return fmt.Errorf("invalid output format: %q (valid formats: table, json, plain)", format)
 	}
+	for _, f := range formats {
+		if format == string(f) {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid output format: %q (valid formats: %s)", format, strings.Join(ValidFormats(), ", "))
+}
+
+// IsStructured reports whether format renders the underlying data (JSON,
+// YAML, NDJSON or a template) rather than table rows. Commands use it to
+// decide between passing API objects to View.JSON and building table rows.
+func IsStructured(format string) bool {
+	switch Format(format) {
+	case FormatJSON, FormatYAML, FormatNDJSON, FormatTemplate:
+		return true
+	}
+	return false
+}
+
+// IsMachineReadable reports whether format is meant for other programs, so
+// that hints such as "more results available" should not be printed to
+// stdout. This covers the structured formats, CSV and TSV.
+func IsMachineReadable(format string) bool {
+	return IsStructured(format) || Format(format) == FormatCSV || Format(format) == FormatTSV
+}
+
+// ResolveFormat returns the output format selected by the --output,
+// --template and --jq flags: a template selects FormatTemplate, and a query
+// selects FormatJSON unless the output is already machine readable.
+// outputSet reports whether --output was given explicitly, in which case it
+// must agree with the other two. The template and query are parsed so that
+// errors are reported before any request is made.
+func ResolveFormat(output string, outputSet bool, template, query string) (string, error) {
+	if template == "" {
+		if output == string(FormatTemplate) {
+			return "", fmt.Errorf("--output template requires --template")
+		}
+	} else {
+		if outputSet && output != string(FormatTemplate) {
+			return "", fmt.Errorf("--template cannot be used with --output %s", output)
+		}
+		if _, err := ParseTemplate(template); err != nil {
+			return "", err
+		}
+		output = string(FormatTemplate)
+	}
+
+	if query != "" {
+		if _, err := ParseQuery(query); err != nil {
+			return "", err
+		}
+		if !IsMachineReadable(output) {
+			if outputSet {
+				return "", fmt.Errorf("--jq cannot be used with --output %s", output)
+			}
+			output = string(FormatJSON)
+		}
+	}
+	return output, nil
 }
 
 // View handles output formatting.
@@ -44,6 +115,17 @@ type View struct {
 	NoColor bool
 	Out     io.Writer
 	Err     io.Writer
+
+	// Template is the text/template executed for FormatTemplate.
+	Template string
+
+	// Columns selects and orders the table columns by header name, case
+	// insensitively. Empty means all columns.
+	Columns []string
+
+	// Query is a jq expression applied to the data passed to JSON, and so
+	// also to table rows rendered as JSON.
+	Query string
 }
 
 // New creates a new View with the given format.
@@ -78,14 +160,23 @@ func (v *View) SetError(w io.Writer) {
 }
 
 // Table renders data as a formatted table with aligned columns.
-// For JSON format, use the JSON method instead.
+// CSV and TSV include the header row; for JSON, YAML, NDJSON and templates
+// each row becomes an object keyed by the lowercased headers.
 func (v *View) Table(headers []string, rows [][]string) error {
-	if v.Format == FormatJSON {
-		return v.tableAsJSON(headers, rows)
+	headers, rows, err := v.selectColumns(headers, rows)
+	if err != nil {
+		return err
 	}
 
-	if v.Format == FormatPlain {
+	switch v.Format {
+	case FormatJSON, FormatYAML, FormatNDJSON, FormatTemplate:
+		return v.tableAsJSON(headers, rows)
+	case FormatPlain:
 		return v.Plain(rows)
+	case FormatCSV:
+		return writeDelimited(v.Out, ',', headers, rows)
+	case FormatTSV:
+		return writeDelimited(v.Out, '\t', headers, rows)
 	}
 
 	w := tabwriter.NewWriter(v.Out, 0, 0, 2, ' ', 0)
@@ -106,6 +197,43 @@ func (v *View) Table(headers []string, rows [][]string) error {
 	return w.Flush()
 }
 
+// selectColumns returns the headers and rows reduced to v.Columns, in that
+// order.
+func (v *View) selectColumns(headers []string, rows [][]string) ([]string, [][]string, error) {
+	if len(v.Columns) == 0 {
+		return headers, rows, nil
+	}
+
+	indexes := make([]int, len(v.Columns))
+	for i, col := range v.Columns {
+		indexes[i] = -1
+		for j, h := range headers {
+			if strings.EqualFold(strings.TrimSpace(col), h) {
+				indexes[i] = j
+				break
+			}
+		}
+		if indexes[i] < 0 {
+			return nil, nil, fmt.Errorf("unknown column %q (available: %s)", col, strings.Join(headers, ", "))
+		}
+	}
+
+	selected := make([]string, len(indexes))
+	for i, j := range indexes {
+		selected[i] = headers[j]
+	}
+	selectedRows := make([][]string, len(rows))
+	for r, row := range rows {
+		selectedRows[r] = make([]string, len(indexes))
+		for i, j := range indexes {
+			if j < len(row) {
+				selectedRows[r][i] = row[j]
+			}
+		}
+	}
+	return selected, selectedRows, nil
+}
+
 // tableAsJSON renders table data as JSON array of objects.
 func (v *View) tableAsJSON(headers []string, rows [][]string) error {
 	results := make([]map[string]string, 0, len(rows))
@@ -121,8 +249,42 @@ func (v *View) tableAsJSON(headers []string, rows [][]string) error {
 	return v.JSON(results)
 }
 
-// JSON renders data as formatted JSON.
+// JSON renders data as formatted JSON, or in the view's format if that is
+// YAML, NDJSON (one line per element of a list), CSV or TSV (one row per
+// element of a list of objects), or a template.
+//
+// If the view has a Query, it is applied to data first. JSON output then
+// has each result written separately, as jq does; other formats render the
+// single result, or a list of the results if there are several.
 func (v *View) JSON(data interface{}) error {
+	if v.Query != "" {
+		results, err := runQuery(v.Query, data)
+		if err != nil {
+			return err
+		}
+		if v.Format == FormatJSON || v.Format == "" {
+			return writeQueryResults(v.Out, results)
+		}
+		if len(results) == 1 {
+			data = results[0]
+		} else {
+			data = results
+		}
+	}
+
+	switch v.Format {
+	case FormatTemplate:
+		return writeTemplate(v.Out, v.Template, data)
+	case FormatYAML:
+		return writeYAML(v.Out, data)
+	case FormatNDJSON:
+		return writeNDJSON(v.Out, data)
+	case FormatCSV:
+		return writeObjectsDelimited(v.Out, ',', data)
+	case FormatTSV:
+		return writeObjectsDelimited(v.Out, '\t', data)
+	}
+
 	enc := json.NewEncoder(v.Out)
 	enc.SetIndent("", "  ")
 	return enc.Encode(data)
@@ -137,14 +299,18 @@ func (v *View) Plain(rows [][]string) error {
 }
 
 // Render renders data based on the current format.
-// For table format, uses headers and rows.
-// For JSON format, uses jsonData.
+// For table, CSV and TSV formats, uses headers and rows.
+// For JSON, YAML, NDJSON and template formats, uses jsonData.
 // For plain format, uses rows without headers.
 func (v *View) Render(headers []string, rows [][]string, jsonData interface{}) error {
 	switch v.Format {
-	case FormatJSON:
+	case FormatJSON, FormatYAML, FormatNDJSON, FormatTemplate:
 		return v.JSON(jsonData)
 	case FormatPlain:
+		_, rows, err := v.selectColumns(headers, rows)
+		if err != nil {
+			return err
+		}
 		return v.Plain(rows)
 	default:
 		return v.Table(headers, rows)
@@ -210,16 +376,32 @@ type ListResponse struct {
 }
 
 // RenderList renders tabular data with pagination metadata.
-// For JSON output, wraps results in an object with _meta field.
+// For JSON and YAML output, wraps results in an object with _meta field.
+// NDJSON output has one line per result and no metadata.
 // For other formats, delegates to Table.
 func (v *View) RenderList(headers []string, rows [][]string, hasMore bool) error {
-	if v.Format == FormatJSON {
+	if v.Format == FormatJSON || v.Format == FormatYAML {
 		return v.renderListAsJSON(headers, rows, hasMore)
 	}
 	return v.Table(headers, rows)
 }
 
+// RenderListOf renders a list like RenderList, except that templates are
+// executed over items, the API objects the rows were built from, rather
+// than over the table rows.
+func (v *View) RenderListOf(headers []string, rows [][]string, items interface{}, hasMore bool) error {
+	if v.Format == FormatTemplate {
+		return v.JSON(items)
+	}
+	return v.RenderList(headers, rows, hasMore)
+}
+
 func (v *View) renderListAsJSON(headers []string, rows [][]string, hasMore bool) error {
+	headers, rows, err := v.selectColumns(headers, rows)
+	if err != nil {
+		return err
+	}
+
 	results := make([]map[string]string, 0, len(rows))
 	for _, row := range rows {
 		item := make(map[string]string)
@@ -243,12 +425,21 @@ func (v *View) renderListAsJSON(headers []string, rows [][]string, hasMore bool)
 }
 
 // RenderKeyValue renders a key-value pair.
-// For JSON format, outputs as a JSON object.
+// For JSON format, outputs as a JSON object; YAML, NDJSON and templates
+// likewise.
 // For other formats, outputs as "key: value" with bold key.
 func (v *View) RenderKeyValue(key, value string) {
-	if v.Format == FormatJSON {
+	switch v.Format {
+	case FormatJSON:
+		if v.Query != "" {
+			_ = v.JSON(map[string]string{key: value})
+			return
+		}
 		_, _ = fmt.Fprintf(v.Out, `{"%s": "%s"}`+"\n", key, value)
 		return
+	case FormatYAML, FormatNDJSON, FormatTemplate:
+		_ = v.JSON(map[string]string{key: value})
+		return
 	}
 	if v.NoColor {
 		_, _ = fmt.Fprintf(v.Out, "%s: %s\n", key, value)
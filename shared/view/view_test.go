@@ -10,7 +10,7 @@ import (
 func TestValidFormats(t *testing.T) {
 	formats := ValidFormats()
 
-	expected := []string{"table", "json", "plain"}
+	expected := []string{"table", "json", "plain", "csv", "tsv", "yaml", "ndjson", "template"}
 	if len(formats) != len(expected) {
 		t.Errorf("ValidFormats() returned %d formats, want %d", len(formats), len(expected))
 	}
@@ -38,8 +38,12 @@ func TestValidateFormat(t *testing.T) {
 		{"table", false},
 		{"json", false},
 		{"plain", false},
+		{"csv", false},
+		{"tsv", false},
+		{"yaml", false},
+		{"ndjson", false},
 		{"xml", true},
-		{"csv", true},
+		{"yml", true},
 		{"INVALID", true},
 	}
 
@@ -53,6 +57,48 @@ func TestValidateFormat(t *testing.T) {
 	}
 }
 
+func TestResolveFormat(t *testing.T) {
+	tests := []struct {
+		name      string
+		output    string
+		outputSet bool
+		template  string
+		query     string
+		want      string
+		wantErr   string
+	}{
+		{name: "no template", output: "table", want: "table"},
+		{name: "template selects format", output: "table", template: "{{.key}}", want: "template"},
+		{name: "explicit template output", output: "template", outputSet: true, template: "{{.key}}", want: "template"},
+		{name: "template output without template", output: "template", outputSet: true, wantErr: "--output template requires --template"},
+		{name: "template with other output", output: "json", outputSet: true, template: "{{.key}}", wantErr: "--template cannot be used with --output json"},
+		{name: "invalid template", output: "table", template: "{{.key", wantErr: "template"},
+		{name: "query selects json", output: "table", query: ".key", want: "json"},
+		{name: "query keeps csv", output: "csv", outputSet: true, query: ".key", want: "csv"},
+		{name: "query with template", output: "table", template: "{{.}}", query: ".key", want: "template"},
+		{name: "query with table output", output: "table", outputSet: true, query: ".key", wantErr: "--jq cannot be used with --output table"},
+		{name: "invalid query", output: "table", query: ".[", wantErr: "--jq"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveFormat(tt.output, tt.outputSet, tt.template, tt.query)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ResolveFormat() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveFormat() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestNew(t *testing.T) {
 	t.Run("default options", func(t *testing.T) {
 		v := New(FormatTable, false)
@@ -10,6 +10,8 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+
+	"github.com/open-cli-collective/atlassian-go/client"
 )
 
 // ListAttachmentsOptions contains options for listing attachments.
@@ -54,6 +56,24 @@ func (c *Client) ListAttachments(ctx context.Context, pageID string, opts *ListA
 	return &result, nil
 }
 
+// ListAllAttachments returns every attachment on a page matching opts,
+// following the cursor across pages. opts.Limit sets the page size and
+// opts.Cursor is ignored.
+func (c *Client) ListAllAttachments(ctx context.Context, pageID string, opts *ListAttachmentsOptions) ([]Attachment, error) {
+	pageOpts := ListAttachmentsOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Attachment, *client.Cursor, error) {
+		pageOpts.Cursor = cursor.Token
+		result, err := c.ListAttachments(ctx, pageID, &pageOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Results, client.NextToken(result.NextCursor()), nil
+	}), 0)
+}
+
 // GetAttachment returns a single attachment by ID.
 func (c *Client) GetAttachment(ctx context.Context, attachmentID string) (*Attachment, error) {
 	path := fmt.Sprintf("/api/v2/attachments/%s", attachmentID)
@@ -89,7 +109,9 @@ func (c *Client) DownloadAttachment(ctx context.Context, attachmentID string) (i
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("Authorization", c.GetAuthHeader())
+	if err := c.Authorize(req); err != nil {
+		return nil, err
+	}
 
 	resp, err := c.GetHTTPClient().Do(req)
 	if err != nil {
@@ -138,7 +160,9 @@ func (c *Client) UploadAttachment(ctx context.Context, pageID, filename string,
 		return nil, err
 	}
 
-	req.Header.Set("Authorization", c.GetAuthHeader())
+	if err := c.Authorize(req); err != nil {
+		return nil, err
+	}
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 	req.Header.Set("X-Atlassian-Token", "nocheck") // Required for XSRF protection
 
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
 	"github.com/open-cli-collective/atlassian-go/client"
 )
 
@@ -24,6 +25,20 @@ func NewClient(baseURL, email, apiToken string) *Client {
 	}
 }
 
+// NewClientWithAuth creates a new Confluence API client that authenticates
+// with authn, such as a bearer token or OAuth 2.0.
+func NewClientWithAuth(baseURL string, authn auth.Authenticator) *Client {
+	return NewClientWithOptions(baseURL, &client.Options{Auth: authn})
+}
+
+// NewClientWithOptions creates a new Confluence API client with the shared
+// client options, e.g. to record or replay requests. opts.Auth must be set.
+func NewClientWithOptions(baseURL string, opts *client.Options) *Client {
+	return &Client{
+		Client: client.New(baseURL, "", "", opts),
+	}
+}
+
 // GetHTTPClient returns the underlying HTTP client for custom requests.
 func (c *Client) GetHTTPClient() *http.Client {
 	return c.HTTPClient
@@ -36,7 +51,11 @@ func (c *Client) GetBaseURL() string {
 
 // GetAuthHeader returns the authorization header value.
 func (c *Client) GetAuthHeader() string {
-	return c.AuthHeader
+	req, _ := http.NewRequest(http.MethodGet, c.BaseURL, nil)
+	if err := c.Authorize(req); err != nil {
+		return ""
+	}
+	return req.Header.Get("Authorization")
 }
 
 // GetCurrentUser returns the currently authenticated user.
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+
+	"github.com/open-cli-collective/atlassian-go/client"
 )
 
 // ListPagesOptions contains options for listing pages.
@@ -63,6 +65,23 @@ func (c *Client) ListPages(ctx context.Context, spaceID string, opts *ListPagesO
 	return &result, nil
 }
 
+// ListAllPages returns every page in a space matching opts, following the
+// cursor across pages. opts.Limit sets the page size and opts.Cursor is ignored.
+func (c *Client) ListAllPages(ctx context.Context, spaceID string, opts *ListPagesOptions) ([]Page, error) {
+	pageOpts := ListPagesOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Page, *client.Cursor, error) {
+		pageOpts.Cursor = cursor.Token
+		result, err := c.ListPages(ctx, spaceID, &pageOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Results, client.NextToken(result.NextCursor()), nil
+	}), 0)
+}
+
 // GetPage returns a single page by ID.
 func (c *Client) GetPage(ctx context.Context, pageID string, opts *GetPageOptions) (*Page, error) {
 	params := url.Values{}
@@ -7,6 +7,8 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+
+	"github.com/open-cli-collective/atlassian-go/client"
 )
 
 // SearchOptions contains options for searching Confluence content.
@@ -18,6 +20,7 @@ type SearchOptions struct {
 	Title string // Title contains filter
 	Label string // Label filter
 	Limit int    // Max results (default 25, max 200)
+	Start int    // Offset of the first result
 }
 
 // SearchResult represents a single search result from the v1 API.
@@ -87,6 +90,9 @@ func (c *Client) Search(ctx context.Context, opts *SearchOptions) (*SearchRespon
 	} else {
 		params.Set("limit", "25")
 	}
+	if opts != nil && opts.Start > 0 {
+		params.Set("start", strconv.Itoa(opts.Start))
+	}
 
 	// Include excerpt for context
 	params.Set("excerpt", "highlight")
@@ -105,6 +111,23 @@ func (c *Client) Search(ctx context.Context, opts *SearchOptions) (*SearchRespon
 	return &result, nil
 }
 
+// SearchAll returns every result matching opts, paging through the v1 search
+// API by offset. opts.Limit sets the page size and opts.Start is ignored.
+func (c *Client) SearchAll(ctx context.Context, opts *SearchOptions) ([]SearchResult, error) {
+	pageOpts := SearchOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]SearchResult, *client.Cursor, error) {
+		pageOpts.Start = cursor.StartAt
+		result, err := c.Search(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Results, client.NextOffset(result.Start, len(result.Results), result.TotalSize, false), nil
+	}), 0)
+}
+
 // buildCQL constructs a CQL query from search options.
 func buildCQL(opts *SearchOptions) string {
 	var clauses []string
@@ -267,3 +267,27 @@ func TestBuildCQL_QuotesInValue(t *testing.T) {
 	// Go's %q escapes quotes properly
 	assert.Contains(t, cql, `text ~ "search \"quoted\" term"`)
 }
+
+func TestClient_SearchAll(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := r.URL.Query().Get("start")
+		starts = append(starts, start)
+
+		w.WriteHeader(http.StatusOK)
+		if start == "" {
+			_, _ = w.Write([]byte(`{"results": [{"content": {"id": "1"}}, {"content": {"id": "2"}}], "start": 0, "limit": 2, "size": 2, "totalSize": 3}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"results": [{"content": {"id": "3"}}], "start": 2, "limit": 2, "size": 1, "totalSize": 3}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	results, err := client.SearchAll(context.Background(), &SearchOptions{Text: "docs", Limit: 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "2"}, starts)
+	require.Len(t, results, 3)
+	assert.Equal(t, "3", results[2].Content.ID)
+}
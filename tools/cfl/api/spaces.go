@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+
+	"github.com/open-cli-collective/atlassian-go/client"
 )
 
 // ListSpacesOptions contains options for listing spaces.
@@ -54,6 +56,23 @@ func (c *Client) ListSpaces(ctx context.Context, opts *ListSpacesOptions) (*Pagi
 	return &result, nil
 }
 
+// ListAllSpaces returns every space matching opts, following the cursor
+// across pages. opts.Limit sets the page size and opts.Cursor is ignored.
+func (c *Client) ListAllSpaces(ctx context.Context, opts *ListSpacesOptions) ([]Space, error) {
+	pageOpts := ListSpacesOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Space, *client.Cursor, error) {
+		pageOpts.Cursor = cursor.Token
+		result, err := c.ListSpaces(ctx, &pageOpts)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Results, client.NextToken(result.NextCursor()), nil
+	}), 0)
+}
+
 // GetSpace returns a single space by ID.
 func (c *Client) GetSpace(ctx context.Context, spaceID string) (*Space, error) {
 	path := fmt.Sprintf("/api/v2/spaces/%s", spaceID)
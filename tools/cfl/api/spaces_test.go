@@ -229,3 +229,31 @@ func TestClient_ListSpaces_APIError(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestClient_ListAllSpaces(t *testing.T) {
+	var cursors []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "10", r.URL.Query().Get("limit"))
+		assert.Equal(t, "global", r.URL.Query().Get("type"))
+
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		w.WriteHeader(http.StatusOK)
+		if cursor == "" {
+			_, _ = w.Write([]byte(`{"results": [{"id": "1", "key": "DEV"}], "_links": {"next": "/wiki/api/v2/spaces?cursor=page2&limit=10"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"results": [{"id": "2", "key": "OPS"}], "_links": {}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "user@example.com", "token")
+	spaces, err := client.ListAllSpaces(context.Background(), &ListSpacesOptions{Limit: 10, Type: "global", Cursor: "ignored"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "page2"}, cursors)
+	require.Len(t, spaces, 2)
+	assert.Equal(t, "DEV", spaces[0].Key)
+	assert.Equal(t, "OPS", spaces[1].Key)
+}
@@ -1,7 +1,10 @@
 // Package api provides the Confluence Cloud REST API client.
 package api
 
-import "time"
+import (
+	"net/url"
+	"time"
+)
 
 // PaginatedResponse wraps paginated API responses.
 type PaginatedResponse[T any] struct {
@@ -22,6 +25,19 @@ func (p *PaginatedResponse[T]) HasMore() bool {
 	return p.Links.Next != ""
 }
 
+// NextCursor returns the cursor query parameter of the next link, or an
+// empty string if this is the last page.
+func (p *PaginatedResponse[T]) NextCursor() string {
+	if p.Links.Next == "" {
+		return ""
+	}
+	parsed, err := url.Parse(p.Links.Next)
+	if err != nil {
+		return ""
+	}
+	return parsed.Query().Get("cursor")
+}
+
 // Space represents a Confluence space.
 type Space struct {
 	ID          string            `json:"id"`
@@ -0,0 +1,54 @@
+// Package main is the entry point for the cfl (Confluence) CLI.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/open-cli-collective/atlassian-go/exitcode"
+
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/adfcmd"
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/attachment"
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/completion"
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/configcmd"
+	initcmd "github.com/open-cli-collective/confluence-cli/internal/cmd/init"
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/page"
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/search"
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/space"
+)
+
+func main() {
+	cmd, opts := root.NewCmd()
+
+	root.RegisterCommands(cmd, opts,
+		initcmd.Register,
+		configcmd.Register,
+		page.Register,
+		space.Register,
+		attachment.Register,
+		search.Register,
+		adfcmd.Register,
+		completion.Register,
+	)
+
+	// Ctrl-C cancels in-flight requests instead of killing the process
+	// mid-write
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := errors.Join(cmd.ExecuteContext(ctx), opts.Close())
+	interrupted := ctx.Err() != nil
+	stop()
+
+	if err != nil {
+		if interrupted && errors.Is(err, context.Canceled) {
+			fmt.Fprintln(os.Stderr, "Interrupted")
+			os.Exit(exitcode.Interrupted)
+		}
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		os.Exit(exitcode.GeneralError)
+	}
+}
@@ -0,0 +1,21 @@
+// Package adfcmd provides commands for working with Atlassian Document Format.
+package adfcmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
+)
+
+// Register adds the adf command to the root command.
+func Register(rootCmd *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "adf",
+		Short: "Work with Atlassian Document Format",
+		Long:  `Commands for working with Atlassian Document Format (ADF), the JSON format of cloud editor pages.`,
+	}
+
+	cmd.AddCommand(newValidateCmd(opts))
+
+	rootCmd.AddCommand(cmd)
+}
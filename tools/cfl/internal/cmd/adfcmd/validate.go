@@ -0,0 +1,88 @@
+package adfcmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/adf"
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
+)
+
+type validateOptions struct {
+	*root.Options
+	file string
+}
+
+func newValidateCmd(rootOpts *root.Options) *cobra.Command {
+	opts := &validateOptions{Options: rootOpts}
+
+	cmd := &cobra.Command{
+		Use:   "validate <file>",
+		Short: "Validate an ADF document",
+		Long: `Check an ADF JSON document against the ADF schema without sending it to Confluence.
+
+Each problem is reported with the JSON pointer of the offending node or
+attribute. Use - to read the document from stdin.`,
+		Example: `  # Validate a page body before creating the page
+  cfl adf validate body.json
+
+  # Validate from stdin
+  cat body.json | cfl adf validate -
+
+  # List problems as JSON
+  cfl adf validate body.json -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.file = args[0]
+			return runValidate(opts)
+		},
+	}
+
+	return cmd
+}
+
+func runValidate(opts *validateOptions) error {
+	var data []byte
+	var err error
+	if opts.file == "-" {
+		data, err = io.ReadAll(opts.Stdin)
+	} else {
+		data, err = os.ReadFile(opts.file)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", opts.file, err)
+	}
+
+	doc, err := adf.ParseJSON(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", opts.file, err)
+	}
+	problems := adf.Validate(doc)
+
+	v := opts.View()
+
+	if view.IsStructured(opts.Output) {
+		if problems == nil {
+			problems = []adf.Problem{}
+		}
+		if err := v.JSON(problems); err != nil {
+			return err
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("%s: %d problem(s) found", opts.file, len(problems))
+		}
+		return nil
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%s: %w", opts.file, &adf.ValidationError{Problems: problems})
+	}
+
+	v.Success("%s is valid ADF", opts.file)
+	return nil
+}
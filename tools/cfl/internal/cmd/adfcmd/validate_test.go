@@ -0,0 +1,61 @@
+package adfcmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/atlassian-go/adf"
+
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
+)
+
+const invalidDoc = `{"type":"doc","version":1,"content":[{"type":"panel","attrs":{"panelType":"danger"},"content":[{"type":"paragraph"}]}]}`
+
+func newTestOptions(output string, stdin string) (*validateOptions, *bytes.Buffer) {
+	stdout := &bytes.Buffer{}
+	return &validateOptions{Options: &root.Options{
+		Output:  output,
+		NoColor: true,
+		Stdin:   strings.NewReader(stdin),
+		Stdout:  stdout,
+		Stderr:  &bytes.Buffer{},
+	}}, stdout
+}
+
+func TestRunValidate_Valid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "body.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"type":"doc","version":1,"content":[]}`), 0o600))
+
+	opts, stdout := newTestOptions("table", "")
+	opts.file = path
+	require.NoError(t, runValidate(opts))
+	assert.Contains(t, stdout.String(), "is valid ADF")
+}
+
+func TestRunValidate_Problems(t *testing.T) {
+	opts, _ := newTestOptions("table", invalidDoc)
+	opts.file = "-"
+
+	err := runValidate(opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `/content/0/attrs/panelType: must be one of info, note, tip, warning, error, success, custom, got "danger"`)
+}
+
+func TestRunValidate_JSON(t *testing.T) {
+	opts, stdout := newTestOptions("json", invalidDoc)
+	opts.file = "-"
+
+	assert.EqualError(t, runValidate(opts), "-: 1 problem(s) found")
+
+	var problems []adf.Problem
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &problems))
+	require.Len(t, problems, 1)
+	assert.Equal(t, "/content/0/attrs/panelType", problems[0].Path)
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
 	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
 )
 
@@ -28,8 +30,8 @@ func newDeleteCmd(rootOpts *root.Options) *cobra.Command {
   # Delete without confirmation
   cfl attachment delete att123 --force`,
 		Args: cobra.ExactArgs(1),
-		RunE: func(_ *cobra.Command, args []string) error {
-			return runDeleteAttachment(args[0], opts)
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDeleteAttachment(cmd.Context(), args[0], opts)
 		},
 	}
 
@@ -38,13 +40,13 @@ func newDeleteCmd(rootOpts *root.Options) *cobra.Command {
 	return cmd
 }
 
-func runDeleteAttachment(attachmentID string, opts *deleteOptions) error {
+func runDeleteAttachment(ctx context.Context, attachmentID string, opts *deleteOptions) error {
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
 	}
 
-	attachment, err := client.GetAttachment(context.Background(), attachmentID)
+	attachment, err := client.GetAttachment(ctx, attachmentID)
 	if err != nil {
 		return fmt.Errorf("failed to get attachment: %w", err)
 	}
@@ -67,11 +69,11 @@ func runDeleteAttachment(attachmentID string, opts *deleteOptions) error {
 		}
 	}
 
-	if err := client.DeleteAttachment(context.Background(), attachmentID); err != nil {
+	if err := client.DeleteAttachment(ctx, attachmentID); err != nil {
 		return fmt.Errorf("failed to delete attachment: %w", err)
 	}
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(map[string]string{
 			"status":        "deleted",
 			"attachment_id": attachmentID,
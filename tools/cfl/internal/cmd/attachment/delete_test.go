@@ -2,6 +2,7 @@ package attachment
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -67,7 +68,7 @@ func TestRunDeleteAttachment_ForceDelete(t *testing.T) {
 		force:   true,
 	}
 
-	err := runDeleteAttachment("att123", opts)
+	err := runDeleteAttachment(context.Background(), "att123", opts)
 	require.NoError(t, err)
 }
 
@@ -89,7 +90,7 @@ func TestRunDeleteAttachment_ConfirmWithY(t *testing.T) {
 		force:   false,
 	}
 
-	err := runDeleteAttachment("att123", opts)
+	err := runDeleteAttachment(context.Background(), "att123", opts)
 	require.NoError(t, err)
 	assert.True(t, deleted, "attachment should have been deleted")
 }
@@ -112,7 +113,7 @@ func TestRunDeleteAttachment_ConfirmWithUpperY(t *testing.T) {
 		force:   false,
 	}
 
-	err := runDeleteAttachment("att123", opts)
+	err := runDeleteAttachment(context.Background(), "att123", opts)
 	require.NoError(t, err)
 	assert.True(t, deleted, "attachment should have been deleted")
 }
@@ -135,7 +136,7 @@ func TestRunDeleteAttachment_CancelWithN(t *testing.T) {
 		force:   false,
 	}
 
-	err := runDeleteAttachment("att123", opts)
+	err := runDeleteAttachment(context.Background(), "att123", opts)
 	require.NoError(t, err)
 	assert.False(t, deleted, "attachment should NOT have been deleted")
 }
@@ -158,7 +159,7 @@ func TestRunDeleteAttachment_CancelWithEmpty(t *testing.T) {
 		force:   false,
 	}
 
-	err := runDeleteAttachment("att123", opts)
+	err := runDeleteAttachment(context.Background(), "att123", opts)
 	require.NoError(t, err)
 	assert.False(t, deleted, "attachment should NOT have been deleted")
 }
@@ -181,7 +182,7 @@ func TestRunDeleteAttachment_CancelWithOther(t *testing.T) {
 		force:   false,
 	}
 
-	err := runDeleteAttachment("att123", opts)
+	err := runDeleteAttachment(context.Background(), "att123", opts)
 	require.NoError(t, err)
 	assert.False(t, deleted, "attachment should NOT have been deleted")
 }
@@ -205,7 +206,7 @@ func TestRunDeleteAttachment_GetAttachmentFails(t *testing.T) {
 		force:   true,
 	}
 
-	err := runDeleteAttachment("invalid", opts)
+	err := runDeleteAttachment(context.Background(), "invalid", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get attachment")
 }
@@ -228,7 +229,7 @@ func TestRunDeleteAttachment_DeleteFails(t *testing.T) {
 		force:   true,
 	}
 
-	err := runDeleteAttachment("att123", opts)
+	err := runDeleteAttachment(context.Background(), "att123", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to delete attachment")
 }
@@ -2,6 +2,7 @@ package attachment
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -148,7 +149,7 @@ func TestRunDownload_Success(t *testing.T) {
 		Options: rootOpts,
 	}
 
-	err := runDownload("att123", opts)
+	err := runDownload(context.Background(), "att123", opts)
 	require.NoError(t, err)
 
 	// Verify file was created
@@ -173,7 +174,7 @@ func TestRunDownload_CustomOutputFile(t *testing.T) {
 		outputFile: outputPath,
 	}
 
-	err := runDownload("att123", opts)
+	err := runDownload(context.Background(), "att123", opts)
 	require.NoError(t, err)
 
 	// Verify file was created with custom name
@@ -205,7 +206,7 @@ func TestRunDownload_FileExists_NoForce(t *testing.T) {
 		force:   false,
 	}
 
-	err = runDownload("att123", opts)
+	err = runDownload(context.Background(), "att123", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "file already exists")
 	assert.Contains(t, err.Error(), "--force")
@@ -238,7 +239,7 @@ func TestRunDownload_FileExists_WithForce(t *testing.T) {
 		force:   true,
 	}
 
-	err = runDownload("att123", opts)
+	err = runDownload(context.Background(), "att123", opts)
 	require.NoError(t, err)
 
 	// Verify file was overwritten
@@ -261,7 +262,7 @@ func TestRunDownload_AttachmentNotFound(t *testing.T) {
 		Options: rootOpts,
 	}
 
-	err := runDownload("nonexistent", opts)
+	err := runDownload(context.Background(), "nonexistent", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get attachment info")
 }
@@ -295,7 +296,7 @@ func TestRunDownload_DownloadFailed(t *testing.T) {
 		Options: rootOpts,
 	}
 
-	err := runDownload("att123", opts)
+	err := runDownload(context.Background(), "att123", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to download attachment")
 }
@@ -330,7 +331,7 @@ func TestRunDownload_InvalidFilename(t *testing.T) {
 				Options: rootOpts,
 			}
 
-			err := runDownload("att123", opts)
+			err := runDownload(context.Background(), "att123", opts)
 			require.Error(t, err)
 			assert.Contains(t, err.Error(), "invalid attachment filename")
 		})
@@ -369,7 +370,7 @@ func TestRunDownload_PathTraversalPrevented(t *testing.T) {
 		Options: rootOpts,
 	}
 
-	err := runDownload("att123", opts)
+	err := runDownload(context.Background(), "att123", opts)
 	require.NoError(t, err)
 
 	// File should be saved as just "passwd" (the base name), not a path traversal
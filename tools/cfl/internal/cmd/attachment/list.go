@@ -19,6 +19,7 @@ type listOptions struct {
 	pageID string
 	limit  int
 	unused bool
+	all    bool
 }
 
 func newListCmd(rootOpts *root.Options) *cobra.Command {
@@ -36,22 +37,26 @@ func newListCmd(rootOpts *root.Options) *cobra.Command {
   cfl attachment list --page 12345 --limit 50
 
   # List unused (orphaned) attachments not referenced in page content
-  cfl attachment list --page 12345 --unused`,
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return runList(opts)
+  cfl attachment list --page 12345 --unused
+
+  # List every attachment, following all pages
+  cfl attachment list --page 12345 --all`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runList(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().StringVarP(&opts.pageID, "page", "p", "", "Page ID (required)")
 	cmd.Flags().IntVarP(&opts.limit, "limit", "l", 25, "Maximum number of attachments to return")
 	cmd.Flags().BoolVar(&opts.unused, "unused", false, "Show only attachments not referenced in page content")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Fetch all pages of results (--limit sets the page size)")
 
 	_ = cmd.MarkFlagRequired("page")
 
 	return cmd
 }
 
-func runList(opts *listOptions) error {
+func runList(ctx context.Context, opts *listOptions) error {
 	if err := view.ValidateFormat(opts.Output); err != nil {
 		return err
 	}
@@ -65,7 +70,12 @@ func runList(opts *listOptions) error {
 		Limit: opts.limit,
 	}
 
-	result, err := client.ListAttachments(context.Background(), opts.pageID, apiOpts)
+	result := &api.PaginatedResponse[api.Attachment]{}
+	if opts.all {
+		result.Results, err = client.ListAllAttachments(ctx, opts.pageID, apiOpts)
+	} else {
+		result, err = client.ListAttachments(ctx, opts.pageID, apiOpts)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to list attachments: %w", err)
 	}
@@ -73,7 +83,7 @@ func runList(opts *listOptions) error {
 	attachments := result.Results
 
 	if opts.unused {
-		page, err := client.GetPage(context.Background(), opts.pageID, &api.GetPageOptions{
+		page, err := client.GetPage(ctx, opts.pageID, &api.GetPageOptions{
 			BodyFormat: "storage",
 		})
 		if err != nil {
@@ -97,7 +107,7 @@ func runList(opts *listOptions) error {
 		rows = append(rows, []string{att.ID, att.Title, att.MediaType, size})
 	}
 
-	if len(attachments) == 0 && opts.Output != "json" {
+	if len(attachments) == 0 && !view.IsMachineReadable(opts.Output) {
 		if opts.unused {
 			fmt.Println("No unused attachments found.")
 		} else {
@@ -106,9 +116,11 @@ func runList(opts *listOptions) error {
 		return nil
 	}
 
-	_ = v.RenderList(headers, rows, result.HasMore())
+	if err := v.RenderListOf(headers, rows, attachments, result.HasMore()); err != nil {
+		return err
+	}
 
-	if result.HasMore() && opts.Output != "json" {
+	if result.HasMore() && !view.IsMachineReadable(opts.Output) {
 		fmt.Fprintf(os.Stderr, "\n(showing first %d results, use --limit to see more)\n", len(attachments))
 	}
 
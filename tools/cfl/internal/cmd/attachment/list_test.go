@@ -2,6 +2,7 @@ package attachment
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -44,7 +45,7 @@ func TestRunList_Success(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -65,7 +66,7 @@ func TestRunList_Empty(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -86,7 +87,7 @@ func TestRunList_APIError(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to list attachments")
 }
@@ -113,7 +114,7 @@ func TestRunList_JSONOutput(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -127,7 +128,7 @@ func TestRunList_InvalidOutputFormat(t *testing.T) {
 		pageID:  "12345",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid output format")
 }
@@ -294,7 +295,7 @@ func TestRunList_UnusedFlag(t *testing.T) {
 		unused:  true,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 	assert.Equal(t, 2, requestCount) // Both attachments and page content fetched
 }
@@ -336,6 +337,6 @@ func TestRunList_UnusedFlag_NoUnused(t *testing.T) {
 		unused:  true,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
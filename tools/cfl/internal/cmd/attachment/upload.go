@@ -8,6 +8,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
 	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
 )
 
@@ -30,8 +32,8 @@ func newUploadCmd(rootOpts *root.Options) *cobra.Command {
 
   # Upload with a comment (-m for message/comment)
   cfl attachment upload --page 12345 --file image.png -m "Screenshot"`,
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return runUpload(opts)
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runUpload(cmd.Context(), opts)
 		},
 	}
 
@@ -45,7 +47,7 @@ func newUploadCmd(rootOpts *root.Options) *cobra.Command {
 	return cmd
 }
 
-func runUpload(opts *uploadOptions) error {
+func runUpload(ctx context.Context, opts *uploadOptions) error {
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
@@ -59,14 +61,14 @@ func runUpload(opts *uploadOptions) error {
 
 	filename := filepath.Base(opts.file)
 
-	attachment, err := client.UploadAttachment(context.Background(), opts.pageID, filename, file, opts.comment)
+	attachment, err := client.UploadAttachment(ctx, opts.pageID, filename, file, opts.comment)
 	if err != nil {
 		return fmt.Errorf("failed to upload attachment: %w", err)
 	}
 
 	v := opts.View()
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(attachment)
 	}
 
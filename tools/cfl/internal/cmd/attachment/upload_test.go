@@ -2,6 +2,7 @@ package attachment
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -57,7 +58,7 @@ func TestRunUpload_Success(t *testing.T) {
 		file:    testFile,
 	}
 
-	err = runUpload(opts)
+	err = runUpload(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -96,7 +97,7 @@ func TestRunUpload_WithComment(t *testing.T) {
 		comment: "My upload comment",
 	}
 
-	err = runUpload(opts)
+	err = runUpload(context.Background(), opts)
 	require.NoError(t, err)
 	assert.Equal(t, "My upload comment", receivedComment)
 }
@@ -112,7 +113,7 @@ func TestRunUpload_FileNotFound(t *testing.T) {
 		file:    "/nonexistent/file.txt",
 	}
 
-	err := runUpload(opts)
+	err := runUpload(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to open file")
 }
@@ -139,7 +140,7 @@ func TestRunUpload_APIError(t *testing.T) {
 		file:    testFile,
 	}
 
-	err = runUpload(opts)
+	err = runUpload(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to upload attachment")
 }
@@ -174,6 +175,6 @@ func TestRunUpload_JSONOutput(t *testing.T) {
 		file:    testFile,
 	}
 
-	err = runUpload(opts)
+	err = runUpload(context.Background(), opts)
 	require.NoError(t, err)
 }
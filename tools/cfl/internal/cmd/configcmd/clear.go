@@ -29,7 +29,10 @@ func newClearCmd(opts *root.Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "clear",
 		Short: "Clear stored configuration",
-		Long: `Remove the cfl configuration file.
+		Long: `Remove the cfl configuration file, including every profile, and the
+API tokens held in the credential store.
+
+To remove a single profile, use 'cfl config remove <profile>'.
 
 Note: Environment variables (CFL_*, ATLASSIAN_*) will still be used if set.`,
 		Example: `  # Clear configuration (with confirmation)
@@ -74,9 +77,9 @@ func runClear(opts *clearOptions) error {
 		}
 	}
 
-	// Remove the file
-	if err := os.Remove(configPath); err != nil {
-		return fmt.Errorf("failed to remove config file: %w", err)
+	// Remove the file and the stored token
+	if err := config.Clear(configPath); err != nil {
+		return err
 	}
 
 	fmt.Printf("Configuration file removed: %s\n", configPath)
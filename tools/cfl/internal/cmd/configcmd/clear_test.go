@@ -10,6 +10,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
+
 	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
 )
 
@@ -17,6 +19,7 @@ func TestRunClear_FileNotFound(t *testing.T) {
 	// Use a temp directory that doesn't have a config file
 	tempDir := t.TempDir()
 	t.Setenv("XDG_CONFIG_HOME", tempDir)
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
 
 	rootOpts := &root.Options{
 		Output:  "table",
@@ -39,6 +42,7 @@ func TestRunClear_WithForce(t *testing.T) {
 	// Create a temp config file
 	tempDir := t.TempDir()
 	t.Setenv("XDG_CONFIG_HOME", tempDir)
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
 
 	configDir := filepath.Join(tempDir, "cfl")
 	require.NoError(t, os.MkdirAll(configDir, 0755))
@@ -71,6 +75,7 @@ func TestRunClear_WithConfirmation(t *testing.T) {
 	// Create a temp config file
 	tempDir := t.TempDir()
 	t.Setenv("XDG_CONFIG_HOME", tempDir)
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
 
 	configDir := filepath.Join(tempDir, "cfl")
 	require.NoError(t, os.MkdirAll(configDir, 0755))
@@ -103,6 +108,7 @@ func TestRunClear_Cancelled(t *testing.T) {
 	// Create a temp config file
 	tempDir := t.TempDir()
 	t.Setenv("XDG_CONFIG_HOME", tempDir)
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
 
 	configDir := filepath.Join(tempDir, "cfl")
 	require.NoError(t, os.MkdirAll(configDir, 0755))
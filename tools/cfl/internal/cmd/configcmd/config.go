@@ -12,12 +12,16 @@ func Register(rootCmd *cobra.Command, opts *root.Options) {
 	configCmd := &cobra.Command{
 		Use:   "config",
 		Short: "Manage cfl configuration",
-		Long:  `Commands for viewing, testing, and managing cfl configuration.`,
+		Long:  `Commands for viewing, testing, and managing cfl configuration and profiles.`,
 	}
 
 	configCmd.AddCommand(newShowCmd(opts))
 	configCmd.AddCommand(newTestCmd(opts))
 	configCmd.AddCommand(newClearCmd(opts))
+	configCmd.AddCommand(newListCmd(opts))
+	configCmd.AddCommand(newUseCmd(opts))
+	configCmd.AddCommand(newAddCmd(opts))
+	configCmd.AddCommand(newRemoveCmd(opts))
 
 	rootCmd.AddCommand(configCmd)
 }
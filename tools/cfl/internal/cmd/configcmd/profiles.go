@@ -0,0 +1,258 @@
+package configcmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	sharedconfig "github.com/open-cli-collective/atlassian-go/config"
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
+	"github.com/open-cli-collective/confluence-cli/internal/config"
+)
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List config profiles",
+		Long: `List the configured profiles.
+
+The active profile is marked with '*'. It is chosen by --profile, then
+CFL_PROFILE, then ATLASSIAN_PROFILE, then the default set with 'cfl config use'.`,
+		Example: `  cfl config list
+  cfl config list -o json`,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runList(opts)
+		},
+	}
+}
+
+func runList(opts *root.Options) error {
+	if err := view.ValidateFormat(opts.Output); err != nil {
+		return err
+	}
+
+	v := opts.View()
+
+	f, err := config.LoadFile(config.DefaultConfigPath())
+	if err != nil {
+		return err
+	}
+
+	if len(f.Profiles) == 0 {
+		v.RenderText("No profiles configured. Run 'cfl init' or 'cfl config add' to create one.")
+		return nil
+	}
+
+	active := config.ActiveProfile(f, opts.Profile)
+	headers := []string{"ACTIVE", "NAME", "URL", "EMAIL", "DEFAULT SPACE"}
+	var rows [][]string
+
+	for _, name := range f.Names() {
+		cfg, _ := f.Get(name)
+		marker := ""
+		if name == active {
+			marker = "*"
+		}
+		rows = append(rows, []string{marker, name, cfg.URL, cfg.Email, cfg.DefaultSpace})
+	}
+
+	return v.RenderList(headers, rows, false)
+}
+
+func newUseCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <profile>",
+		Short: "Set the default config profile",
+		Long: `Make a profile the default for subsequent commands.
+
+CFL_PROFILE, ATLASSIAN_PROFILE and --profile still take precedence.`,
+		Example: `  cfl config use sandbox`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runUse(opts, args[0])
+		},
+	}
+}
+
+func runUse(opts *root.Options, name string) error {
+	configPath := config.DefaultConfigPath()
+
+	if err := config.UseProfile(configPath, name); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.Stdout, "Default profile set to %s\n", name)
+
+	f, err := config.LoadFile(configPath)
+	if err != nil {
+		return err
+	}
+	if active := config.ActiveProfile(f, opts.Profile); active != name {
+		fmt.Fprintf(opts.Stdout, "Note: profile %s is still selected by --profile or an environment variable\n", active)
+	}
+	return nil
+}
+
+type addOptions struct {
+	*root.Options
+	name            string
+	url             string
+	email           string
+	token           string
+	defaultSpace    string
+	credentialStore string
+	use             bool
+}
+
+func newAddCmd(opts *root.Options) *cobra.Command {
+	addOpts := &addOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "add <profile>",
+		Short: "Add a config profile",
+		Long: `Add a profile for another Confluence site or account.
+
+For interactive setup with connection verification, use
+'cfl init --profile <profile>' instead.`,
+		Example: `  # Add a sandbox site
+  cfl config add sandbox --url https://mycompany-sandbox.atlassian.net \
+    --email user@example.com --token YOUR_TOKEN
+
+  # Add and make it the default
+  cfl config add sandbox --url ... --email ... --token ... --use`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			addOpts.name = args[0]
+			return runAdd(addOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&addOpts.url, "url", "", "Confluence URL (required)")
+	cmd.Flags().StringVar(&addOpts.email, "email", "", "Your Atlassian account email (required)")
+	cmd.Flags().StringVar(&addOpts.token, "token", "", "API token (required)")
+	cmd.Flags().StringVar(&addOpts.defaultSpace, "default-space", "", "Default space key")
+	cmd.Flags().StringVar(&addOpts.credentialStore, "credential-store", "", "Where to keep the API token: keyring, file, or command:<helper>")
+	cmd.Flags().BoolVar(&addOpts.use, "use", false, "Make this the default profile")
+
+	_ = cmd.MarkFlagRequired("url")
+	_ = cmd.MarkFlagRequired("email")
+	_ = cmd.MarkFlagRequired("token")
+
+	return cmd
+}
+
+func runAdd(opts *addOptions) error {
+	configPath := config.DefaultConfigPath()
+
+	if err := sharedconfig.ValidateProfileName(opts.name); err != nil {
+		return err
+	}
+
+	f, err := config.LoadFile(configPath)
+	if err != nil {
+		return err
+	}
+	if _, exists := f.Get(opts.name); exists {
+		return fmt.Errorf("profile %q already exists (reconfigure it with 'cfl init --profile %s')", opts.name, opts.name)
+	}
+
+	cfg := &config.Config{
+		URL:          opts.url,
+		Email:        opts.email,
+		DefaultSpace: opts.defaultSpace,
+		Auth: sharedconfig.Auth{
+			APIToken:        opts.token,
+			CredentialStore: opts.credentialStore,
+		},
+	}
+	cfg.NormalizeURL()
+	if err := cfg.Validate(); err != nil {
+		return fmt.Errorf("invalid configuration: %w", err)
+	}
+
+	if err := cfg.SaveProfile(configPath, opts.name); err != nil {
+		return err
+	}
+	fmt.Fprintf(opts.Stdout, "Profile %s added (API token stored in %s)\n", opts.name, cfg.TokenStore)
+
+	if opts.use {
+		if err := config.UseProfile(configPath, opts.name); err != nil {
+			return err
+		}
+		fmt.Fprintf(opts.Stdout, "Default profile set to %s\n", opts.name)
+	}
+	return nil
+}
+
+type removeOptions struct {
+	*root.Options
+	name  string
+	force bool
+	stdin io.Reader // For testing
+}
+
+func newRemoveCmd(opts *root.Options) *cobra.Command {
+	removeOpts := &removeOptions{
+		Options: opts,
+		stdin:   os.Stdin,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove <profile>",
+		Aliases: []string{"rm"},
+		Short:   "Remove a config profile",
+		Long:    `Remove a profile and its stored API token.`,
+		Example: `  cfl config remove sandbox
+  cfl config remove sandbox --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			removeOpts.name = args[0]
+			return runRemove(removeOpts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&removeOpts.force, "force", "f", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runRemove(opts *removeOptions) error {
+	configPath := config.DefaultConfigPath()
+
+	f, err := config.LoadFile(configPath)
+	if err != nil {
+		return err
+	}
+	if _, exists := f.Get(opts.name); !exists {
+		return fmt.Errorf("profile %q not found", opts.name)
+	}
+
+	if !opts.force {
+		fmt.Fprintf(opts.Stdout, "This will remove profile %s and its API token.\n", opts.name)
+		fmt.Fprint(opts.Stdout, "Are you sure? [y/N]: ")
+
+		var response string
+		_, err := fmt.Fscanln(opts.stdin, &response)
+		if err != nil && err.Error() != "unexpected newline" {
+			return err
+		}
+
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			fmt.Fprintln(opts.Stdout, "Cancelled.")
+			return nil
+		}
+	}
+
+	if err := config.RemoveProfile(configPath, opts.name); err != nil {
+		return err
+	}
+	fmt.Fprintf(opts.Stdout, "Profile %s removed\n", opts.name)
+	return nil
+}
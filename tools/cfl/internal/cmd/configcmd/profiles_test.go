@@ -0,0 +1,68 @@
+package configcmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/atlassian-go/auth"
+
+	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
+	"github.com/open-cli-collective/confluence-cli/internal/config"
+)
+
+func TestProfileCommands(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+	t.Setenv("CFL_PROFILE", "")
+	t.Setenv("ATLASSIAN_PROFILE", "")
+
+	var stdout bytes.Buffer
+	rootOpts := &root.Options{
+		Output:  "table",
+		NoColor: true,
+		Stdout:  &stdout,
+		Stderr:  &bytes.Buffer{},
+	}
+
+	add := func(name string, use bool) error {
+		return runAdd(&addOptions{
+			Options: rootOpts,
+			name:    name,
+			url:     "https://" + name + ".atlassian.net",
+			email:   "me@example.com",
+			token:   name + "-token",
+			use:     use,
+		})
+	}
+
+	require.NoError(t, add("default", false))
+	require.NoError(t, add("sandbox", true))
+	assert.ErrorContains(t, add("sandbox", false), "already exists")
+	assert.ErrorContains(t, add("-bad", false), "invalid profile name")
+
+	cfg, err := config.Load(config.DefaultConfigPath())
+	require.NoError(t, err)
+	assert.Equal(t, "https://sandbox.atlassian.net/wiki", cfg.URL)
+	assert.Equal(t, "sandbox-token", cfg.APIToken)
+
+	stdout.Reset()
+	require.NoError(t, runList(rootOpts))
+	assert.Regexp(t, `\*\s+sandbox`, stdout.String())
+	assert.Contains(t, stdout.String(), "https://default.atlassian.net/wiki")
+
+	require.NoError(t, runUse(rootOpts, "default"))
+	assert.ErrorContains(t, runUse(rootOpts, "missing"), "not found")
+
+	stdout.Reset()
+	require.NoError(t, runRemove(&removeOptions{Options: rootOpts, name: "sandbox", stdin: strings.NewReader("n\n")}))
+	assert.Contains(t, stdout.String(), "Cancelled.")
+
+	require.NoError(t, runRemove(&removeOptions{Options: rootOpts, name: "sandbox", stdin: strings.NewReader("y\n")}))
+	f, err := config.LoadFile(config.DefaultConfigPath())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, f.Names())
+}
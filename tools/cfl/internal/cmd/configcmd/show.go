@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
 	sharedconfig "github.com/open-cli-collective/atlassian-go/config"
 
 	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
@@ -31,8 +32,13 @@ func runShow(opts *root.Options) error {
 	configPath := config.DefaultConfigPath()
 	v := opts.View()
 
-	// Load config file (if exists)
-	fileCfg, fileErr := config.Load(configPath)
+	// Load the selected profile (if it exists)
+	file, _ := config.LoadFile(configPath)
+	if file == nil {
+		file = &config.File{}
+	}
+	profile := config.ActiveProfile(file, opts.Profile)
+	fileCfg, fileErr := config.LoadProfile(configPath, profile)
 	if fileErr != nil {
 		fileCfg = &config.Config{}
 	}
@@ -42,28 +48,57 @@ func runShow(opts *root.Options) error {
 	envEmail := sharedconfig.GetEnvWithFallback("CFL_EMAIL", "ATLASSIAN_EMAIL")
 	envToken := sharedconfig.GetEnvWithFallback("CFL_API_TOKEN", "ATLASSIAN_API_TOKEN")
 	envSpace := os.Getenv("CFL_DEFAULT_SPACE")
+	envMethod := sharedconfig.GetEnvWithFallback("CFL_AUTH_METHOD", "ATLASSIAN_AUTH_METHOD")
 
 	// Determine effective values and sources
 	url, urlSource := getValueAndSource(envURL, fileCfg.URL, getEnvVarName("CFL_URL", "ATLASSIAN_URL"))
 	email, emailSource := getValueAndSource(envEmail, fileCfg.Email, getEnvVarName("CFL_EMAIL", "ATLASSIAN_EMAIL"))
 	token, tokenSource := getValueAndSource(envToken, fileCfg.APIToken, getEnvVarName("CFL_API_TOKEN", "ATLASSIAN_API_TOKEN"))
 	space, spaceSource := getValueAndSource(envSpace, fileCfg.DefaultSpace, "CFL_DEFAULT_SPACE")
+	method, methodSource := getValueAndSource(envMethod, fileCfg.AuthMethod, getEnvVarName("CFL_AUTH_METHOD", "ATLASSIAN_AUTH_METHOD"))
+	if envToken == "" && fileCfg.TokenStore != "" {
+		tokenSource = fileCfg.TokenStore
+	}
+	if method == "" {
+		method, methodSource = auth.MethodBasic, "default"
+	}
+	maskedToken := maskToken(token)
+	if method == auth.MethodOAuth && token != "" {
+		maskedToken = "(OAuth credentials)"
+	}
 
 	// Display
+	v.RenderKeyValue("Profile", formatValueWithSource(profile, getProfileSource(opts.Profile, file)))
 	v.RenderKeyValue("URL", formatValueWithSource(url, urlSource))
+	v.RenderKeyValue("Auth Method", formatValueWithSource(method, methodSource))
 	v.RenderKeyValue("Email", formatValueWithSource(email, emailSource))
-	v.RenderKeyValue("API Token", formatValueWithSource(maskToken(token), tokenSource))
+	v.RenderKeyValue("API Token", formatValueWithSource(maskedToken, tokenSource))
 	v.RenderKeyValue("Default Space", formatValueWithSource(space, spaceSource))
 
 	fmt.Println()
 	fmt.Printf("Config file: %s\n", configPath)
 	if fileErr != nil {
-		fmt.Printf("  (file not found or unreadable)\n")
+		fmt.Printf("  (file, or profile %s, not found or unreadable)\n", profile)
 	}
 
 	return nil
 }
 
+// getProfileSource returns where the active profile was selected.
+func getProfileSource(flag string, file *config.File) string {
+	switch {
+	case flag != "":
+		return "--profile"
+	case os.Getenv("CFL_PROFILE") != "":
+		return "CFL_PROFILE"
+	case os.Getenv(sharedconfig.EnvProfile) != "":
+		return sharedconfig.EnvProfile
+	case file.Default != "":
+		return "config"
+	}
+	return "default"
+}
+
 // getValueAndSource returns the effective value and its source.
 func getValueAndSource(envValue, fileValue, envVarName string) (string, string) {
 	if envValue != "" {
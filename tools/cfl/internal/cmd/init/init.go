@@ -2,6 +2,7 @@
 package init
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -10,30 +11,70 @@ import (
 	"github.com/charmbracelet/huh"
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
+
 	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
 	"github.com/open-cli-collective/confluence-cli/internal/config"
 )
 
+// oauthScopes are requested when logging in with an OAuth 2.0 app.
+var oauthScopes = []string{
+	"read:space:confluence",
+	"read:page:confluence",
+	"write:page:confluence",
+	"delete:page:confluence",
+	"read:attachment:confluence",
+	"write:attachment:confluence",
+	"read:label:confluence",
+	"write:label:confluence",
+	"read:content-details:confluence",
+	"search:confluence",
+	"read:confluence-user",
+	"offline_access",
+}
+
+type initOptions struct {
+	*root.Options
+	url               string
+	email             string
+	credentialStore   string
+	authMethod        string
+	oauthClientID     string
+	oauthClientSecret string
+	oauthRedirectURL  string
+	noVerify          bool
+}
+
 // Register adds the init command to the root command.
-func Register(rootCmd *cobra.Command, _ *root.Options) {
-	rootCmd.AddCommand(newInitCmd())
+func Register(rootCmd *cobra.Command, opts *root.Options) {
+	rootCmd.AddCommand(newInitCmd(opts))
 }
 
 // newInitCmd creates the init command.
-func newInitCmd() *cobra.Command {
-	var (
-		url      string
-		email    string
-		noVerify bool
-	)
+func newInitCmd(rootOpts *root.Options) *cobra.Command {
+	opts := &initOptions{Options: rootOpts}
 
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Initialize cfl configuration",
 		Long: `Initialize cfl with your Confluence Cloud credentials.
 
-This command will guide you through setting up your Confluence URL,
-email, and API token. The configuration will be saved to ~/.config/cfl/config.yml.
+This command will guide you through setting up your Confluence URL and
+credentials. The configuration will be saved to ~/.config/cfl/config.yml.
+
+Three ways to authenticate are supported:
+
+  basic    Atlassian account email and API token (default)
+  bearer   Personal access token, for Confluence Data Center
+  oauth    OAuth 2.0 app from developer.atlassian.com, logging in through
+           the browser; requests go through the api.atlassian.com gateway
+
+The API token is kept in the OS keychain when one is available, or in an
+encrypted file next to the config otherwise. Use --credential-store to pick
+a backend explicitly (keyring, file, or command:<helper>).
+
+With --profile, the settings are saved as a named profile, e.g. for a
+second Confluence site. Other profiles in the config are kept.
 
 To generate an API token:
   1. Go to https://id.atlassian.com/manage-profile/security/api-tokens
@@ -43,34 +84,61 @@ To generate an API token:
   cfl init
 
   # Pre-populate URL
-  cfl init --url https://mycompany.atlassian.net`,
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return runInit(url, email, noVerify)
+  cfl init --url https://mycompany.atlassian.net
+
+  # Keep the API token in an encrypted file instead of the keychain
+  cfl init --credential-store file
+
+  # Set up a separate profile for a sandbox site
+  cfl init --profile sandbox
+
+  # Use a Data Center personal access token
+  cfl init --url https://confluence.internal.corp.com --auth bearer
+
+  # Log in with an OAuth 2.0 app (register the redirect URL as its callback)
+  cfl init --auth oauth --oauth-client-id CLIENT_ID --oauth-client-secret SECRET`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runInit(cmd.Context(), opts)
 		},
 	}
 
-	cmd.Flags().StringVar(&url, "url", "", "Confluence URL (e.g., https://mycompany.atlassian.net)")
-	cmd.Flags().StringVar(&email, "email", "", "Your Atlassian account email")
-	cmd.Flags().BoolVar(&noVerify, "no-verify", false, "Skip connection verification")
+	cmd.Flags().StringVar(&opts.url, "url", "", "Confluence URL (e.g., https://mycompany.atlassian.net)")
+	cmd.Flags().StringVar(&opts.email, "email", "", "Your Atlassian account email")
+	cmd.Flags().StringVar(&opts.credentialStore, "credential-store", "", "Where to keep the API token: keyring, file, or command:<helper>")
+	cmd.Flags().StringVar(&opts.authMethod, "auth", "", "Authentication method: basic, bearer, or oauth")
+	cmd.Flags().StringVar(&opts.oauthClientID, "oauth-client-id", "", "OAuth 2.0 app client ID")
+	cmd.Flags().StringVar(&opts.oauthClientSecret, "oauth-client-secret", "", "OAuth 2.0 app client secret")
+	cmd.Flags().StringVar(&opts.oauthRedirectURL, "oauth-redirect-url", auth.DefaultRedirectURL, "OAuth 2.0 callback URL registered on the app")
+	cmd.Flags().BoolVar(&opts.noVerify, "no-verify", false, "Skip connection verification")
 
 	return cmd
 }
 
-func runInit(prefillURL, prefillEmail string, noVerify bool) error {
+func runInit(ctx context.Context, opts *initOptions) error {
 	configPath := config.DefaultConfigPath()
 
-	// Load existing config for pre-population
-	existingCfg, _ := config.Load(configPath)
+	if err := auth.ValidateMethod(opts.authMethod); err != nil {
+		return err
+	}
+
+	file, err := config.LoadFile(configPath)
+	if err != nil {
+		return err
+	}
+	profile := config.ActiveProfile(file, opts.Profile)
+
+	// Load existing profile for pre-population
+	existingCfg, _ := config.LoadProfile(configPath, profile)
 	if existingCfg == nil {
 		existingCfg = &config.Config{}
 	}
 
-	// Check if config already exists
-	if _, err := os.Stat(configPath); err == nil {
+	// Check if the profile already exists
+	if _, exists := file.Get(profile); exists {
 		var overwrite bool
 		err := huh.NewConfirm().
 			Title("Configuration already exists").
-			Description(fmt.Sprintf("Overwrite %s?", configPath)).
+			Description(fmt.Sprintf("Overwrite profile %s in %s?", profile, configPath)).
 			Value(&overwrite).
 			Run()
 		if err != nil {
@@ -86,26 +154,64 @@ func runInit(prefillURL, prefillEmail string, noVerify bool) error {
 
 	// Pre-fill from existing config, then override with CLI flags
 	// Priority: CLI flag > existing config value
-	if prefillURL != "" {
-		cfg.URL = prefillURL
+	if opts.url != "" {
+		cfg.URL = opts.url
 	} else if existingCfg.URL != "" {
 		cfg.URL = existingCfg.URL
 	}
 
-	if prefillEmail != "" {
-		cfg.Email = prefillEmail
+	if opts.email != "" {
+		cfg.Email = opts.email
 	} else if existingCfg.Email != "" {
 		cfg.Email = existingCfg.Email
 	}
 
-	if existingCfg.APIToken != "" {
+	if opts.authMethod != "" {
+		cfg.AuthMethod = opts.authMethod
+	} else if existingCfg.AuthMethod != "" {
+		cfg.AuthMethod = existingCfg.AuthMethod
+	} else {
+		cfg.AuthMethod = auth.MethodBasic
+	}
+
+	// An existing OAuth profile stores encoded credentials, not a token
+	var clientSecret string
+	if existingCfg.AuthMethod == auth.MethodOAuth {
+		if creds, err := auth.ParseOAuthCredentials(existingCfg.APIToken); err == nil {
+			clientSecret = creds.ClientSecret
+		}
+	} else if existingCfg.AuthMethod == cfg.AuthMethod || (existingCfg.AuthMethod == "" && cfg.AuthMethod == auth.MethodBasic) {
 		cfg.APIToken = existingCfg.APIToken
 	}
 
+	if opts.oauthClientID != "" {
+		cfg.OAuthClientID = opts.oauthClientID
+	} else {
+		cfg.OAuthClientID = existingCfg.OAuthClientID
+	}
+	if opts.oauthClientSecret != "" {
+		clientSecret = opts.oauthClientSecret
+	}
+
 	if existingCfg.DefaultSpace != "" {
 		cfg.DefaultSpace = existingCfg.DefaultSpace
 	}
 
+	if opts.credentialStore != "" {
+		cfg.CredentialStore = opts.credentialStore
+	} else {
+		cfg.CredentialStore = existingCfg.CredentialStore
+	}
+
+	required := func(name string) func(string) error {
+		return func(s string) error {
+			if s == "" {
+				return fmt.Errorf("%s is required", name)
+			}
+			return nil
+		}
+	}
+
 	// Build the form
 	form := huh.NewForm(
 		huh.NewGroup(
@@ -114,37 +220,58 @@ func runInit(prefillURL, prefillEmail string, noVerify bool) error {
 				Description("Your Confluence Cloud instance URL").
 				Placeholder("https://mycompany.atlassian.net").
 				Value(&cfg.URL).
-				Validate(func(s string) error {
-					if s == "" {
-						return fmt.Errorf("URL is required")
-					}
-					return nil
-				}),
+				Validate(required("URL")),
+
+			huh.NewSelect[string]().
+				Title("Authentication").
+				Options(
+					huh.NewOption("Email and API token", auth.MethodBasic),
+					huh.NewOption("Personal access token (Data Center)", auth.MethodBearer),
+					huh.NewOption("OAuth 2.0 app", auth.MethodOAuth),
+				).
+				Value(&cfg.AuthMethod),
+		),
 
+		huh.NewGroup(
 			huh.NewInput().
 				Title("Email").
 				Description("Your Atlassian account email").
 				Placeholder("you@example.com").
 				Value(&cfg.Email).
-				Validate(func(s string) error {
-					if s == "" {
-						return fmt.Errorf("email is required")
-					}
-					return nil
-				}),
+				Validate(required("email")),
 
 			huh.NewInput().
 				Title("API Token").
 				Description("Generate at: id.atlassian.com/manage-profile/security/api-tokens").
 				EchoMode(huh.EchoModePassword).
 				Value(&cfg.APIToken).
-				Validate(func(s string) error {
-					if s == "" {
-						return fmt.Errorf("API token is required")
-					}
-					return nil
-				}),
+				Validate(required("API token")),
+		).WithHideFunc(func() bool { return cfg.AuthMethod != auth.MethodBasic }),
 
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Personal Access Token").
+				Description("Create one from your Confluence profile: Personal Access Tokens").
+				EchoMode(huh.EchoModePassword).
+				Value(&cfg.APIToken).
+				Validate(required("personal access token")),
+		).WithHideFunc(func() bool { return cfg.AuthMethod != auth.MethodBearer }),
+
+		huh.NewGroup(
+			huh.NewInput().
+				Title("OAuth Client ID").
+				Description("From your app at developer.atlassian.com/console/myapps").
+				Value(&cfg.OAuthClientID).
+				Validate(required("client ID")),
+
+			huh.NewInput().
+				Title("OAuth Client Secret").
+				EchoMode(huh.EchoModePassword).
+				Value(&clientSecret).
+				Validate(required("client secret")),
+		).WithHideFunc(func() bool { return cfg.AuthMethod != auth.MethodOAuth }),
+
+		huh.NewGroup(
 			huh.NewInput().
 				Title("Default Space (optional)").
 				Description("Default space key for page operations").
@@ -160,15 +287,38 @@ func runInit(prefillURL, prefillEmail string, noVerify bool) error {
 	// Normalize URL
 	cfg.NormalizeURL()
 
+	var authn auth.Authenticator = &auth.Basic{Email: cfg.Email, APIToken: cfg.APIToken}
+	switch cfg.AuthMethod {
+	case auth.MethodBearer:
+		authn = &auth.Bearer{Token: cfg.APIToken}
+	case auth.MethodOAuth:
+		oauthCfg := &auth.OAuthConfig{
+			ClientID:     cfg.OAuthClientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  opts.oauthRedirectURL,
+			Scopes:       oauthScopes,
+		}
+		token, err := loginOAuth(ctx, oauthCfg, cfg)
+		if err != nil {
+			return err
+		}
+		authn = auth.NewOAuth(oauthCfg, token)
+	}
+
+	// Basic is the default, so leave it out of the file
+	if cfg.AuthMethod == auth.MethodBasic {
+		cfg.AuthMethod = ""
+	}
+
 	// Validate
 	if err := cfg.Validate(); err != nil {
 		return fmt.Errorf("invalid configuration: %w", err)
 	}
 
 	// Verify connection unless skipped
-	if !noVerify {
+	if !opts.noVerify {
 		fmt.Print("Verifying connection... ")
-		if err := verifyConnection(cfg); err != nil {
+		if err := verifyConnection(ctx, cfg, authn); err != nil {
 			fmt.Println("failed!")
 			return fmt.Errorf("connection verification failed: %w", err)
 		}
@@ -176,11 +326,12 @@ func runInit(prefillURL, prefillEmail string, noVerify bool) error {
 	}
 
 	// Save configuration
-	if err := cfg.Save(configPath); err != nil {
+	if err := cfg.SaveProfile(configPath, profile); err != nil {
 		return err
 	}
 
-	fmt.Printf("\nConfiguration saved to %s\n", configPath)
+	fmt.Printf("\nProfile %s saved to %s\n", profile, configPath)
+	fmt.Printf("Credentials stored in %s\n", cfg.TokenStore)
 	fmt.Println("\nYou're all set! Try running:")
 	fmt.Println("  cfl space list")
 	fmt.Println("  cfl page list --space <SPACE_KEY>")
@@ -188,15 +339,46 @@ func runInit(prefillURL, prefillEmail string, noVerify bool) error {
 	return nil
 }
 
-func verifyConnection(cfg *config.Config) error {
+// loginOAuth logs in through the browser and records the token and the
+// site's cloud ID in cfg.
+func loginOAuth(ctx context.Context, oauthCfg *auth.OAuthConfig, cfg *config.Config) (*auth.Token, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	token, err := oauthCfg.Login(ctx, os.Stdout, auth.OpenBrowser)
+	if err != nil {
+		return nil, fmt.Errorf("OAuth login failed: %w", err)
+	}
+
+	resources, err := oauthCfg.AccessibleResources(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	site, ok := auth.FindResource(resources, cfg.URL)
+	if !ok {
+		return nil, fmt.Errorf("the OAuth app was not granted access to %s", cfg.URL)
+	}
+	cfg.CloudID = site.ID
+
+	creds := &auth.OAuthCredentials{ClientSecret: oauthCfg.ClientSecret, Token: *token}
+	cfg.APIToken, err = creds.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func verifyConnection(ctx context.Context, cfg *config.Config, authn auth.Authenticator) error {
 	client := &http.Client{Timeout: 10 * time.Second}
 
-	req, err := http.NewRequest("GET", cfg.URL+"/api/v2/spaces?limit=1", nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", cfg.APIBaseURL()+"/api/v2/spaces?limit=1", nil)
 	if err != nil {
 		return err
 	}
 
-	req.SetBasicAuth(cfg.Email, cfg.APIToken)
+	if err := authn.Authorize(req); err != nil {
+		return err
+	}
 	req.Header.Set("Accept", "application/json")
 
 	resp, err := client.Do(req)
@@ -206,7 +388,10 @@ func verifyConnection(cfg *config.Config) error {
 	defer func() { _ = resp.Body.Close() }()
 
 	if resp.StatusCode == 401 {
-		return fmt.Errorf("authentication failed - check your email and API token")
+		if _, ok := authn.(*auth.Basic); ok {
+			return fmt.Errorf("authentication failed - check your email and API token")
+		}
+		return fmt.Errorf("authentication failed - check your credentials")
 	}
 	if resp.StatusCode == 403 {
 		return fmt.Errorf("access denied - check your permissions")
@@ -2,6 +2,7 @@ package init
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"os"
@@ -12,6 +13,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
+	sharedconfig "github.com/open-cli-collective/atlassian-go/config"
+
 	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
 	"github.com/open-cli-collective/confluence-cli/internal/config"
 )
@@ -35,12 +39,26 @@ func TestVerifyConnection_Success(t *testing.T) {
 	defer server.Close()
 
 	cfg := &config.Config{
-		URL:      server.URL,
-		Email:    "test@example.com",
-		APIToken: "test-token",
+		URL:   server.URL,
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "test-token"},
 	}
 
-	err := verifyConnection(cfg)
+	err := verifyConnection(context.Background(), cfg, &auth.Basic{Email: cfg.Email, APIToken: cfg.APIToken})
+	assert.NoError(t, err)
+}
+
+func TestVerifyConnection_Bearer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer my-pat", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"results": []}`))
+	}))
+	defer server.Close()
+
+	cfg := &config.Config{URL: server.URL, Auth: sharedconfig.Auth{AuthMethod: auth.MethodBearer, APIToken: "my-pat"}}
+
+	err := verifyConnection(context.Background(), cfg, &auth.Bearer{Token: cfg.APIToken})
 	assert.NoError(t, err)
 }
 
@@ -52,12 +70,12 @@ func TestVerifyConnection_Unauthorized(t *testing.T) {
 	defer server.Close()
 
 	cfg := &config.Config{
-		URL:      server.URL,
-		Email:    "bad@example.com",
-		APIToken: "wrong-token",
+		URL:   server.URL,
+		Email: "bad@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "wrong-token"},
 	}
 
-	err := verifyConnection(cfg)
+	err := verifyConnection(context.Background(), cfg, &auth.Basic{Email: cfg.Email, APIToken: cfg.APIToken})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "authentication failed")
 	assert.Contains(t, err.Error(), "email and API token")
@@ -71,12 +89,12 @@ func TestVerifyConnection_Forbidden(t *testing.T) {
 	defer server.Close()
 
 	cfg := &config.Config{
-		URL:      server.URL,
-		Email:    "test@example.com",
-		APIToken: "token-no-perms",
+		URL:   server.URL,
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "token-no-perms"},
 	}
 
-	err := verifyConnection(cfg)
+	err := verifyConnection(context.Background(), cfg, &auth.Basic{Email: cfg.Email, APIToken: cfg.APIToken})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "access denied")
 	assert.Contains(t, err.Error(), "permissions")
@@ -89,24 +107,24 @@ func TestVerifyConnection_ServerError(t *testing.T) {
 	defer server.Close()
 
 	cfg := &config.Config{
-		URL:      server.URL,
-		Email:    "test@example.com",
-		APIToken: "test-token",
+		URL:   server.URL,
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "test-token"},
 	}
 
-	err := verifyConnection(cfg)
+	err := verifyConnection(context.Background(), cfg, &auth.Basic{Email: cfg.Email, APIToken: cfg.APIToken})
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "unexpected status code: 500")
 }
 
 func TestVerifyConnection_NetworkError(t *testing.T) {
 	cfg := &config.Config{
-		URL:      "http://localhost:99999", // Non-existent server
-		Email:    "test@example.com",
-		APIToken: "test-token",
+		URL:   "http://localhost:99999", // Non-existent server
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "test-token"},
 	}
 
-	err := verifyConnection(cfg)
+	err := verifyConnection(context.Background(), cfg, &auth.Basic{Email: cfg.Email, APIToken: cfg.APIToken})
 	require.Error(t, err)
 	// Should fail to connect
 }
@@ -163,12 +181,12 @@ func TestVerifyConnection_StatusCodes(t *testing.T) {
 			defer server.Close()
 
 			cfg := &config.Config{
-				URL:      server.URL,
-				Email:    "test@example.com",
-				APIToken: "test-token",
+				URL:   server.URL,
+				Email: "test@example.com",
+				Auth:  sharedconfig.Auth{APIToken: "test-token"},
 			}
 
-			err := verifyConnection(cfg)
+			err := verifyConnection(context.Background(), cfg, &auth.Basic{Email: cfg.Email, APIToken: cfg.APIToken})
 			if tt.wantErr {
 				require.Error(t, err)
 				assert.Contains(t, err.Error(), tt.errContain)
@@ -183,11 +201,12 @@ func TestConfigFilePermissions(t *testing.T) {
 	// Create a temp directory
 	tmpDir := t.TempDir()
 	configPath := filepath.Join(tmpDir, "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
 
 	cfg := config.Config{
-		URL:      "https://test.atlassian.net",
-		Email:    "test@example.com",
-		APIToken: "secret-token",
+		URL:   "https://test.atlassian.net",
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "secret-token"},
 	}
 
 	// Save the config
@@ -208,11 +227,12 @@ func TestConfigFilePermissions_DirectoryCreation(t *testing.T) {
 	// Create a temp directory with nested path
 	tmpDir := t.TempDir()
 	configPath := filepath.Join(tmpDir, "nested", "deeply", "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
 
 	cfg := config.Config{
-		URL:      "https://test.atlassian.net",
-		Email:    "test@example.com",
-		APIToken: "secret-token",
+		URL:   "https://test.atlassian.net",
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "secret-token"},
 	}
 
 	// Save should create the directory structure
@@ -266,4 +286,8 @@ func TestInitCommand_Flags(t *testing.T) {
 	noVerifyFlag := initCmd.Flags().Lookup("no-verify")
 	require.NotNil(t, noVerifyFlag)
 	assert.Equal(t, "false", noVerifyFlag.DefValue)
+
+	for _, name := range []string{"auth", "oauth-client-id", "oauth-client-secret", "oauth-redirect-url"} {
+		assert.NotNil(t, initCmd.Flags().Lookup(name), "flag %s", name)
+	}
 }
@@ -2,6 +2,7 @@ package page
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -81,7 +82,7 @@ func TestRunCopy_Success(t *testing.T) {
 		space:   "TEST",
 	}
 
-	err := runCopy("12345", opts)
+	err := runCopy(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -135,7 +136,7 @@ func TestRunCopy_InfersSourceSpace(t *testing.T) {
 		space:   "", // Not specified - should infer from source
 	}
 
-	err := runCopy("12345", opts)
+	err := runCopy(context.Background(), "12345", opts)
 	require.NoError(t, err)
 	assert.Equal(t, 3, callCount) // GetPage + GetSpace + CopyPage
 }
@@ -157,7 +158,7 @@ func TestRunCopy_PageNotFound(t *testing.T) {
 		space:   "TEST",
 	}
 
-	err := runCopy("99999", opts)
+	err := runCopy(context.Background(), "99999", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to copy page")
 }
@@ -186,7 +187,7 @@ func TestRunCopy_JSONOutput(t *testing.T) {
 		space:   "TEST",
 	}
 
-	err := runCopy("12345", opts)
+	err := runCopy(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -202,7 +203,7 @@ func TestRunCopy_InvalidOutputFormat(t *testing.T) {
 		space:   "TEST",
 	}
 
-	err := runCopy("12345", opts)
+	err := runCopy(context.Background(), "12345", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid output format")
 }
@@ -227,7 +228,7 @@ func TestRunCopy_GetSourcePageFails(t *testing.T) {
 		space:   "", // Empty - will try to get source page
 	}
 
-	err := runCopy("invalid", opts)
+	err := runCopy(context.Background(), "invalid", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get source page")
 }
@@ -256,7 +257,7 @@ func TestRunCopy_WithNoAttachments(t *testing.T) {
 		noAttachments: true,
 	}
 
-	err := runCopy("12345", opts)
+	err := runCopy(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -284,7 +285,7 @@ func TestRunCopy_WithNoLabels(t *testing.T) {
 		noLabels: true,
 	}
 
-	err := runCopy("12345", opts)
+	err := runCopy(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -305,7 +306,7 @@ func TestRunCopy_PermissionDenied(t *testing.T) {
 		space:   "TEST",
 	}
 
-	err := runCopy("12345", opts)
+	err := runCopy(context.Background(), "12345", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to copy page")
 }
@@ -342,7 +343,7 @@ func TestRunCopy_GetSpaceFails(t *testing.T) {
 		space:   "", // Empty - will try to get space
 	}
 
-	err := runCopy("12345", opts)
+	err := runCopy(context.Background(), "12345", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get space")
 }
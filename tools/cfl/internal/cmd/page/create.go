@@ -11,6 +11,9 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/adf"
+	"github.com/open-cli-collective/atlassian-go/view"
+
 	"github.com/open-cli-collective/confluence-cli/api"
 	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
 	"github.com/open-cli-collective/confluence-cli/pkg/md"
@@ -74,7 +77,7 @@ Content format:
 				opts.markdown = &useMd
 			}
 			opts.legacy, _ = cmd.Flags().GetBool("legacy")
-			return runCreate(opts)
+			return runCreate(cmd.Context(), opts)
 		},
 	}
 
@@ -91,7 +94,7 @@ Content format:
 	return cmd
 }
 
-func runCreate(opts *createOptions) error {
+func runCreate(ctx context.Context, opts *createOptions) error {
 	// Validate file exists before making any network calls so we fail
 	// fast on bad input without needing config or API access.
 	if opts.file != "" {
@@ -119,7 +122,7 @@ func runCreate(opts *createOptions) error {
 		return err
 	}
 
-	space, err := client.GetSpaceByKey(context.Background(), spaceKey)
+	space, err := client.GetSpaceByKey(ctx, spaceKey)
 	if err != nil {
 		return fmt.Errorf("failed to find space '%s': %w", spaceKey, err)
 	}
@@ -157,6 +160,9 @@ func runCreate(opts *createOptions) error {
 			}
 			content = adfContent
 		}
+		if err := checkADF(content); err != nil {
+			return err
+		}
 		body = &api.Body{
 			AtlasDocFormat: &api.BodyRepresentation{
 				Representation: "atlas_doc_format",
@@ -176,14 +182,14 @@ func runCreate(opts *createOptions) error {
 		req.ParentID = opts.parent
 	}
 
-	page, err := client.CreatePage(context.Background(), req)
+	page, err := client.CreatePage(ctx, req)
 	if err != nil {
 		return fmt.Errorf("failed to create page: %w", err)
 	}
 
 	v := opts.View()
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(page)
 	}
 
@@ -194,6 +200,17 @@ func runCreate(opts *createOptions) error {
 	return nil
 }
 
+// checkADF validates ADF JSON before it is sent, so that a malformed
+// document is reported with the paths of its problems rather than as
+// Confluence's INVALID_INPUT.
+func checkADF(content string) error {
+	doc, err := adf.ParseJSON([]byte(content))
+	if err != nil {
+		return err
+	}
+	return adf.Check(doc)
+}
+
 // getContent reads content and returns (content, isMarkdown, error).
 // isMarkdown indicates whether the content should be converted from markdown.
 func getContent(opts *createOptions) (string, bool, error) {
@@ -2,6 +2,7 @@ package page
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -78,7 +79,7 @@ func TestRunCreate_Success(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -118,7 +119,7 @@ func TestRunCreate_HTMLFile_Legacy(t *testing.T) {
 		legacy:  true, // Use legacy mode for HTML files
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify HTML was not converted (should be passed as-is in storage format)
@@ -166,7 +167,7 @@ func TestRunCreate_NoMarkdownFlag_Legacy(t *testing.T) {
 		legacy:   true,   // Use legacy mode for storage format
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify content was not converted even though file has .md extension
@@ -194,7 +195,7 @@ func TestRunCreate_MissingSpace(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "space is required")
 }
@@ -223,7 +224,7 @@ func TestRunCreate_SpaceNotFound(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to find space")
 }
@@ -248,7 +249,7 @@ func TestRunCreate_CreateFailed(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to create page")
 }
@@ -288,7 +289,7 @@ func TestRunCreate_WithParent(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify parent ID was included in request
@@ -316,7 +317,7 @@ func TestRunCreate_JSONOutput(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -355,7 +356,7 @@ func TestRunCreate_MarkdownConversion_Legacy(t *testing.T) {
 		legacy:  true, // Use legacy mode to test storage format
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify markdown was converted to HTML storage format
@@ -403,7 +404,7 @@ func TestRunCreate_MarkdownToADF(t *testing.T) {
 		// Default: not legacy, uses ADF
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify ADF format was used (default)
@@ -432,7 +433,7 @@ func TestRunCreate_FileReadError(t *testing.T) {
 		file:    "/nonexistent/file.md",
 	}
 
-	err := runCreate(opts)
+	err := runCreate(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to read file")
 }
@@ -466,7 +467,7 @@ func TestRunCreate_Stdin_ADF(t *testing.T) {
 		title:   "Test Page",
 	}
 
-	err := runCreate(opts)
+	err := runCreate(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify ADF format was used
@@ -509,7 +510,7 @@ func TestRunCreate_Stdin_Legacy(t *testing.T) {
 		legacy:  true,
 	}
 
-	err := runCreate(opts)
+	err := runCreate(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify storage format was used
@@ -553,7 +554,7 @@ func TestRunCreate_Stdin_NoMarkdown_Legacy(t *testing.T) {
 		legacy:   true,
 	}
 
-	err := runCreate(opts)
+	err := runCreate(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify raw content passed through without conversion
@@ -605,7 +606,7 @@ func TestRunCreate_ComplexMarkdown_ADF(t *testing.T) {
 		title:   "Test Page",
 	}
 
-	err := runCreate(opts)
+	err := runCreate(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify ADF contains complex elements
@@ -634,7 +635,7 @@ func TestRunCreate_EmptyContentFromStdin(t *testing.T) {
 		title:   "Test Page",
 	}
 
-	err := runCreate(opts)
+	err := runCreate(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "page content cannot be empty")
 }
@@ -654,7 +655,7 @@ func TestRunCreate_WhitespaceOnlyFromStdin(t *testing.T) {
 		title:   "Test Page",
 	}
 
-	err := runCreate(opts)
+	err := runCreate(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "page content cannot be empty")
 }
@@ -679,7 +680,7 @@ func TestRunCreate_EmptyFile(t *testing.T) {
 		file:    emptyFile,
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "page content cannot be empty")
 }
@@ -704,7 +705,46 @@ func TestRunCreate_WhitespaceOnlyFile(t *testing.T) {
 		file:    whitespaceFile,
 	}
 
-	err = runCreate(opts)
+	err = runCreate(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "page content cannot be empty")
 }
+
+func TestRunCreate_InvalidADFNotSent(t *testing.T) {
+	tmpDir := t.TempDir()
+	jsonFile := filepath.Join(tmpDir, "content.json")
+	err := os.WriteFile(jsonFile, []byte(`{"type":"doc","version":1,"content":[{"type":"heading","content":[{"type":"text","text":"Title"}]}]}`), 0644)
+	require.NoError(t, err)
+
+	posted := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && strings.Contains(r.URL.Path, "/spaces"):
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"results": [{"id": "123456", "key": "DEV"}]}`))
+		case r.Method == "POST":
+			posted = true
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	rootOpts := newCreateTestRootOptions()
+	rootOpts.SetAPIClient(api.NewClient(server.URL, "test@example.com", "token"))
+
+	useMd := false
+	opts := &createOptions{
+		Options:  rootOpts,
+		space:    "DEV",
+		title:    "Test Page",
+		file:     jsonFile,
+		markdown: &useMd,
+	}
+
+	err = runCreate(context.Background(), opts)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "/content/0/attrs/level: required attribute level is missing")
+	assert.False(t, posted, "invalid ADF should not be sent")
+}
@@ -2,6 +2,7 @@ package page
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -59,7 +60,7 @@ func TestRunDelete_ConfirmYes(t *testing.T) {
 		force:   false,
 	}
 
-	err := runDelete("12345", opts)
+	err := runDelete(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -77,7 +78,7 @@ func TestRunDelete_ConfirmYesUppercase(t *testing.T) {
 		force:   false,
 	}
 
-	err := runDelete("12345", opts)
+	err := runDelete(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -95,7 +96,7 @@ func TestRunDelete_ConfirmNo(t *testing.T) {
 		force:   false,
 	}
 
-	err := runDelete("12345", opts)
+	err := runDelete(context.Background(), "12345", opts)
 	require.NoError(t, err) // Cancellation is not an error
 }
 
@@ -113,7 +114,7 @@ func TestRunDelete_ConfirmEmpty(t *testing.T) {
 		force:   false,
 	}
 
-	err := runDelete("12345", opts)
+	err := runDelete(context.Background(), "12345", opts)
 	require.NoError(t, err) // Empty input should cancel
 }
 
@@ -131,7 +132,7 @@ func TestRunDelete_ConfirmOther(t *testing.T) {
 		force:   false,
 	}
 
-	err := runDelete("12345", opts)
+	err := runDelete(context.Background(), "12345", opts)
 	require.NoError(t, err) // Any non-y/Y input should cancel
 }
 
@@ -148,7 +149,7 @@ func TestRunDelete_Force(t *testing.T) {
 		force:   true,
 	}
 
-	err := runDelete("12345", opts)
+	err := runDelete(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -168,7 +169,7 @@ func TestRunDelete_PageNotFound(t *testing.T) {
 		force:   true,
 	}
 
-	err := runDelete("99999", opts)
+	err := runDelete(context.Background(), "99999", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get page")
 }
@@ -186,7 +187,7 @@ func TestRunDelete_DeleteFailed(t *testing.T) {
 		force:   true,
 	}
 
-	err := runDelete("12345", opts)
+	err := runDelete(context.Background(), "12345", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to delete page")
 }
@@ -205,7 +206,7 @@ func TestRunDelete_JSONOutput(t *testing.T) {
 		force:   true,
 	}
 
-	err := runDelete("12345", opts)
+	err := runDelete(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -250,7 +251,7 @@ func TestRunDelete_ConfirmationInputs(t *testing.T) {
 				force:   false,
 			}
 
-			err := runDelete("12345", opts)
+			err := runDelete(context.Background(), "12345", opts)
 			require.NoError(t, err)
 			assert.Equal(t, tt.shouldProceed, deleteCalled, "delete should have been called: %v", tt.shouldProceed)
 		})
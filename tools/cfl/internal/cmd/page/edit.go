@@ -12,6 +12,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
 	"github.com/open-cli-collective/confluence-cli/api"
 	"github.com/open-cli-collective/confluence-cli/internal/cmd/root"
 	"github.com/open-cli-collective/confluence-cli/pkg/md"
@@ -77,7 +79,7 @@ Content format:
 				opts.markdown = &useMd
 			}
 			opts.legacy, _ = cmd.Flags().GetBool("legacy")
-			return runEdit(opts)
+			return runEdit(cmd.Context(), opts)
 		},
 	}
 
@@ -91,7 +93,7 @@ Content format:
 	return cmd
 }
 
-func runEdit(opts *editOptions) error {
+func runEdit(ctx context.Context, opts *editOptions) error {
 	// Validate file exists before making any network calls so we fail
 	// fast on bad input without needing config or API access.
 	if opts.file != "" {
@@ -110,7 +112,7 @@ func runEdit(opts *editOptions) error {
 		return err
 	}
 
-	existingPage, err := client.GetPage(context.Background(), opts.pageID, &api.GetPageOptions{
+	existingPage, err := client.GetPage(ctx, opts.pageID, &api.GetPageOptions{
 		BodyFormat: "storage",
 	})
 	if err != nil {
@@ -198,20 +200,20 @@ func runEdit(opts *editOptions) error {
 		req.Body = existingPage.Body
 	}
 
-	page, err := client.UpdatePage(context.Background(), opts.pageID, req)
+	page, err := client.UpdatePage(ctx, opts.pageID, req)
 	if err != nil {
 		return fmt.Errorf("failed to update page: %w", err)
 	}
 
 	if opts.parent != "" {
-		if err := client.MovePage(context.Background(), opts.pageID, opts.parent); err != nil {
+		if err := client.MovePage(ctx, opts.pageID, opts.parent); err != nil {
 			return fmt.Errorf("failed to move page to new parent: %w", err)
 		}
 	}
 
 	v := opts.View()
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(page)
 	}
 
@@ -241,7 +243,10 @@ func convertEditContent(content string, isMarkdown, legacy bool) (string, error)
 		if err != nil {
 			return "", fmt.Errorf("failed to convert markdown to ADF: %w", err)
 		}
-		return adfContent, nil
+		content = adfContent
+	}
+	if err := checkADF(content); err != nil {
+		return "", err
 	}
 	return content, nil
 }
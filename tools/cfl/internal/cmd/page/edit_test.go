@@ -2,6 +2,7 @@ package page
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -68,7 +69,7 @@ func TestRunEdit_Success(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -116,14 +117,14 @@ func TestRunEdit_TitleOnly(t *testing.T) {
 	// we need to provide a file to avoid the editor path.
 	tmpDir := t.TempDir()
 	mdFile := filepath.Join(tmpDir, "content.md")
-	err := os.WriteFile(mdFile, []byte("<p>Keep this</p>"), 0644)
+	err := os.WriteFile(mdFile, []byte(`{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Keep this"}]}]}`), 0644)
 	require.NoError(t, err)
 
 	useMd := false
 	opts.file = mdFile
 	opts.markdown = &useMd
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify title was changed
@@ -147,7 +148,7 @@ func TestRunEdit_PageNotFound(t *testing.T) {
 		title:   "New Title",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get page")
 }
@@ -188,7 +189,7 @@ func TestRunEdit_UpdateFailed(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to update page")
 }
@@ -241,7 +242,7 @@ func TestRunEdit_VersionIncrement(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify version was incremented from 7 to 8
@@ -294,7 +295,7 @@ func TestRunEdit_HTMLFile(t *testing.T) {
 
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify HTML was not converted (storage format in legacy mode)
@@ -351,7 +352,7 @@ func TestRunEdit_NoMarkdownFlag(t *testing.T) {
 		legacy:   true, // Use legacy mode for storage format
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify content was not converted (storage format in legacy mode)
@@ -407,7 +408,7 @@ func TestRunEdit_MarkdownToADF(t *testing.T) {
 		// Default: not legacy, uses ADF
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify ADF format was used (default)
@@ -462,7 +463,7 @@ func TestRunEdit_JSONOutput(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -489,7 +490,7 @@ func TestRunEdit_FileReadError(t *testing.T) {
 		file:    "/nonexistent/file.md",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to read file")
 }
@@ -532,7 +533,7 @@ func TestRunEdit_Stdin_ADF(t *testing.T) {
 		pageID:  "12345",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify ADF format was used
@@ -584,7 +585,7 @@ func TestRunEdit_Stdin_Legacy(t *testing.T) {
 		legacy:  true,
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify storage format was used
@@ -640,7 +641,7 @@ func TestRunEdit_TitleAndContent(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify both title and content were updated
@@ -705,7 +706,7 @@ func TestRunEdit_ComplexMarkdown_ADF(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify ADF contains complex elements
@@ -761,7 +762,7 @@ func TestRunEdit_MoveToParent(t *testing.T) {
 		parent:  "67890",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.NoError(t, err)
 	assert.True(t, moveCalled, "MovePage should have been called")
 }
@@ -813,7 +814,7 @@ func TestRunEdit_MoveAndRename(t *testing.T) {
 		parent:  "67890",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.NoError(t, err)
 	assert.True(t, moveCalled, "MovePage should have been called")
 	assert.Equal(t, "New Title", receivedTitle)
@@ -860,7 +861,7 @@ func TestRunEdit_MoveFailed(t *testing.T) {
 
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to move page to new parent")
 }
@@ -909,7 +910,7 @@ func TestRunEdit_MoveWithContent(t *testing.T) {
 		parent:  "67890",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.NoError(t, err)
 	assert.True(t, moveCalled, "MovePage should have been called")
 
@@ -945,7 +946,7 @@ func TestRunEdit_EmptyContentFromStdin(t *testing.T) {
 		pageID:  "12345",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "page content cannot be empty")
 }
@@ -976,7 +977,7 @@ func TestRunEdit_WhitespaceOnlyFromStdin(t *testing.T) {
 		pageID:  "12345",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "page content cannot be empty")
 }
@@ -1013,7 +1014,7 @@ func TestRunEdit_EmptyFile(t *testing.T) {
 		file:    emptyFile,
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "page content cannot be empty")
 }
@@ -1050,7 +1051,7 @@ func TestRunEdit_WhitespaceOnlyFile(t *testing.T) {
 		file:    whitespaceFile,
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "page content cannot be empty")
 }
@@ -1102,7 +1103,7 @@ func TestRunEdit_TitleOnlyUpdate_NoContentValidation(t *testing.T) {
 		file:    mdFile,
 	}
 
-	err = runEdit(opts)
+	err = runEdit(context.Background(), opts)
 	require.NoError(t, err)
 	assert.True(t, updateCalled, "Update should have been called")
 }
@@ -1152,7 +1153,7 @@ func TestRunEdit_MoveOnly_NoEditorOpened(t *testing.T) {
 		parent:  "67890",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.NoError(t, err)
 	assert.True(t, updateCalled, "UpdatePage should have been called")
 	assert.True(t, moveCalled, "MovePage should have been called")
@@ -1205,7 +1206,7 @@ func TestRunEdit_MoveWithTitleOnly_NoEditorOpened(t *testing.T) {
 		parent:  "67890",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.NoError(t, err)
 	assert.True(t, moveCalled, "MovePage should have been called")
 	assert.Equal(t, "New Title", receivedBody["title"])
@@ -1255,7 +1256,7 @@ func TestRunEdit_MoveOnly_BodyPreserved(t *testing.T) {
 		parent:  "67890",
 	}
 
-	err := runEdit(opts)
+	err := runEdit(context.Background(), opts)
 	require.NoError(t, err)
 
 	// Verify body was preserved from original page
@@ -18,6 +18,7 @@ type listOptions struct {
 	space  string
 	limit  int
 	status string
+	all    bool
 }
 
 func newListCmd(rootOpts *root.Options) *cobra.Command {
@@ -39,15 +40,19 @@ page content.`,
   cfl page list -s DEV -l 50
 
   # Output as JSON
-  cfl page list -s DEV -o json`,
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return runList(opts)
+  cfl page list -s DEV -o json
+
+  # List every page in the space
+  cfl page list -s DEV --all`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runList(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().StringVarP(&opts.space, "space", "s", "", "Space key or ID (required)")
 	cmd.Flags().IntVarP(&opts.limit, "limit", "l", 25, "Maximum number of pages to return")
 	cmd.Flags().StringVar(&opts.status, "status", "current", "Page status: current, archived, trashed")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Fetch all pages of results (--limit sets the page size)")
 
 	return cmd
 }
@@ -60,7 +65,7 @@ var validStatuses = map[string]bool{
 	"deleted":  true,
 }
 
-func runList(opts *listOptions) error {
+func runList(ctx context.Context, opts *listOptions) error {
 	if err := view.ValidateFormat(opts.Output); err != nil {
 		return err
 	}
@@ -76,7 +81,7 @@ func runList(opts *listOptions) error {
 	v := opts.View()
 
 	if opts.limit == 0 {
-		if opts.Output == "json" {
+		if view.IsStructured(opts.Output) {
 			return v.JSON([]interface{}{})
 		}
 		v.RenderText("No pages found.")
@@ -102,7 +107,7 @@ func runList(opts *listOptions) error {
 		return err
 	}
 
-	space, err := client.GetSpaceByKey(context.Background(), spaceKey)
+	space, err := client.GetSpaceByKey(ctx, spaceKey)
 	if err != nil {
 		return fmt.Errorf("failed to find space '%s': %w", spaceKey, err)
 	}
@@ -112,7 +117,12 @@ func runList(opts *listOptions) error {
 		Status: opts.status,
 	}
 
-	result, err := client.ListPages(context.Background(), space.ID, apiOpts)
+	result := &api.PaginatedResponse[api.Page]{}
+	if opts.all {
+		result.Results, err = client.ListAllPages(ctx, space.ID, apiOpts)
+	} else {
+		result, err = client.ListPages(ctx, space.ID, apiOpts)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to list pages: %w", err)
 	}
@@ -138,9 +148,11 @@ func runList(opts *listOptions) error {
 		})
 	}
 
-	_ = v.RenderList(headers, rows, result.HasMore())
+	if err := v.RenderListOf(headers, rows, result.Results, result.HasMore()); err != nil {
+		return err
+	}
 
-	if result.HasMore() && opts.Output != "json" {
+	if result.HasMore() && !view.IsMachineReadable(opts.Output) {
 		fmt.Fprintf(os.Stderr, "\n(showing first %d results, use --limit to see more)\n", len(result.Results))
 	}
 
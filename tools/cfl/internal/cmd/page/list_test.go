@@ -2,6 +2,7 @@ package page
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -65,7 +66,7 @@ func TestRunList_PageList_Success(t *testing.T) {
 		status:  "current",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -84,7 +85,7 @@ func TestRunList_PageList_EmptyResults(t *testing.T) {
 		status:  "current",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -108,7 +109,7 @@ func TestRunList_PageList_JSONOutput(t *testing.T) {
 		status:  "current",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -122,7 +123,7 @@ func TestRunList_PageList_InvalidOutputFormat(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid output format")
 }
@@ -137,7 +138,7 @@ func TestRunList_PageList_NegativeLimit(t *testing.T) {
 		status:  "current",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid limit")
 }
@@ -153,7 +154,7 @@ func TestRunList_PageList_ZeroLimit(t *testing.T) {
 	}
 
 	// Zero limit should return empty without making API call
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -175,7 +176,7 @@ func TestRunList_PageList_MissingSpace(t *testing.T) {
 		status:  "current",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "space is required")
 }
@@ -199,7 +200,7 @@ func TestRunList_PageList_SpaceNotFound(t *testing.T) {
 		status:  "current",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to find space")
 }
@@ -223,7 +224,7 @@ func TestRunList_PageList_NullVersion(t *testing.T) {
 		status:  "current",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -247,7 +248,7 @@ func TestRunList_PageList_HasMore(t *testing.T) {
 		status:  "current",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -271,7 +272,7 @@ func TestRunList_PageList_LongTitle(t *testing.T) {
 		status:  "current",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -301,7 +302,7 @@ func TestRunList_PageList_StatusFilter(t *testing.T) {
 		status:  "archived",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -315,7 +316,7 @@ func TestRunList_PageList_InvalidStatus(t *testing.T) {
 		status:  "draft",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid status")
 	assert.Contains(t, err.Error(), "draft")
@@ -347,6 +348,6 @@ func TestRunList_PageList_TrashedStatus(t *testing.T) {
 		status:  "trashed",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
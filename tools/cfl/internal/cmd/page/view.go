@@ -62,8 +62,8 @@ JSON output (--output json) always includes the full body.`,
   # Pipe markdown content to edit
   cfl page view 12345 --content-only | cfl page edit 12345 --legacy`,
 		Args: cobra.ExactArgs(1),
-		RunE: func(_ *cobra.Command, args []string) error {
-			return runView(args[0], opts)
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runView(cmd.Context(), args[0], opts)
 		},
 	}
 
@@ -76,14 +76,14 @@ JSON output (--output json) always includes the full body.`,
 	return cmd
 }
 
-func runView(pageID string, opts *viewOptions) error {
+func runView(ctx context.Context, pageID string, opts *viewOptions) error {
 	if err := view.ValidateFormat(opts.Output); err != nil {
 		return err
 	}
 
 	if opts.contentOnly {
-		if opts.Output == "json" {
-			return fmt.Errorf("--content-only is incompatible with --output json")
+		if view.IsMachineReadable(opts.Output) {
+			return fmt.Errorf("--content-only is incompatible with --output %s", opts.Output)
 		}
 		if opts.web {
 			return fmt.Errorf("--content-only is incompatible with --web")
@@ -102,7 +102,7 @@ func runView(pageID string, opts *viewOptions) error {
 
 	// --web only needs page links, not body content
 	if opts.web {
-		page, err := client.GetPage(context.Background(), pageID, nil)
+		page, err := client.GetPage(ctx, pageID, nil)
 		if err != nil {
 			return fmt.Errorf("failed to get page: %w", err)
 		}
@@ -110,7 +110,7 @@ func runView(pageID string, opts *viewOptions) error {
 		return openBrowser(url)
 	}
 
-	page, err := client.GetPage(context.Background(), pageID, &api.GetPageOptions{
+	page, err := client.GetPage(ctx, pageID, &api.GetPageOptions{
 		BodyFormat: "storage",
 	})
 	if err != nil {
@@ -122,15 +122,15 @@ func runView(pageID string, opts *viewOptions) error {
 	// Look up space key for display
 	spaceKey := ""
 	if page.SpaceID != "" {
-		space, err := client.GetSpace(context.Background(), page.SpaceID)
+		space, err := client.GetSpace(ctx, page.SpaceID)
 		if err == nil && space != nil {
 			spaceKey = space.Key
 		}
 		// Graceful fallback: if GetSpace fails, we just won't show the key
 	}
 
-	if opts.Output == "json" {
-		// Enrich JSON output with spaceKey
+	if view.IsMachineReadable(opts.Output) {
+		// Enrich structured output with spaceKey
 		enriched := enrichPageWithSpaceKey(page, spaceKey)
 		return v.JSON(enriched)
 	}
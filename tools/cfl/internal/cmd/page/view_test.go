@@ -2,6 +2,7 @@ package page
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -49,7 +50,7 @@ func TestRunView_Success(t *testing.T) {
 		Options: rootOpts,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -75,7 +76,7 @@ func TestRunView_RawFormat(t *testing.T) {
 		raw:     true,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -101,7 +102,7 @@ func TestRunView_JSONOutput(t *testing.T) {
 		Options: rootOpts,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -120,7 +121,7 @@ func TestRunView_PageNotFound(t *testing.T) {
 		Options: rootOpts,
 	}
 
-	err := runView("99999", opts)
+	err := runView(context.Background(), "99999", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to get page")
 }
@@ -145,7 +146,7 @@ func TestRunView_EmptyContent(t *testing.T) {
 		Options: rootOpts,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -157,7 +158,7 @@ func TestRunView_InvalidOutputFormat(t *testing.T) {
 		Options: rootOpts,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid output format")
 }
@@ -184,7 +185,7 @@ func TestRunView_ShowMacros(t *testing.T) {
 		showMacros: true,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
@@ -210,7 +211,7 @@ func TestRunView_ContentOnly(t *testing.T) {
 		contentOnly: true,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 	// Output should only contain markdown content, no Title:/ID:/Version: headers
 }
@@ -238,7 +239,7 @@ func TestRunView_ContentOnly_Raw(t *testing.T) {
 		raw:         true,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 	// Output should only contain raw XHTML, no Title:/ID:/Version: headers
 }
@@ -266,7 +267,7 @@ func TestRunView_ContentOnly_ShowMacros(t *testing.T) {
 		showMacros:  true,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 	// Output should contain markdown with [TOC] macro placeholder
 }
@@ -280,7 +281,7 @@ func TestRunView_ContentOnly_JSON_Error(t *testing.T) {
 		contentOnly: true,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "--content-only is incompatible with --output json")
 }
@@ -294,7 +295,7 @@ func TestRunView_ContentOnly_Web_Error(t *testing.T) {
 		web:         true,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "--content-only is incompatible with --web")
 }
@@ -320,7 +321,7 @@ func TestRunView_ContentOnly_EmptyBody(t *testing.T) {
 		contentOnly: true,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 	// Output should be "(No content)" without metadata headers
 }
@@ -363,7 +364,7 @@ func TestRunView_WithSpaceKey(t *testing.T) {
 		Options: rootOpts,
 	}
 
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 	assert.Equal(t, 2, callCount, "should call both GetPage and GetSpace")
 }
@@ -400,7 +401,7 @@ func TestRunView_SpaceLookupFails_Graceful(t *testing.T) {
 	}
 
 	// Should succeed even if space lookup fails
-	err := runView("12345", opts)
+	err := runView(context.Background(), "12345", opts)
 	require.NoError(t, err)
 }
 
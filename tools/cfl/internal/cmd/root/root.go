@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/client"
 	"github.com/open-cli-collective/atlassian-go/version"
 	"github.com/open-cli-collective/atlassian-go/view"
 
@@ -17,17 +20,28 @@ import (
 
 // Options contains global options for commands
 type Options struct {
-	Output  string
-	NoColor bool
-	Stdin   io.Reader
-	Stdout  io.Writer
-	Stderr  io.Writer
+	Output    string
+	NoColor   bool
+	Profile   string
+	Record    string
+	Replay    string
+	TraceFile string
+	Timeout   time.Duration
+	Template  string
+	Columns   []string
+	Query     string
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
 
 	// testClient is used for testing; if set, APIClient() returns this instead
 	testClient *api.Client
 
 	// cachedConfig stores loaded config for reuse
 	cachedConfig *config.Config
+
+	// traceFile is the opened --trace-file, shared by all clients
+	traceFile client.TraceFile
 }
 
 // View returns a configured View instance
@@ -35,6 +49,9 @@ func (o *Options) View() *view.View {
 	v := view.NewWithFormat(o.Output, o.NoColor)
 	v.Out = o.Stdout
 	v.Err = o.Stderr
+	v.Template = o.Template
+	v.Columns = o.Columns
+	v.Query = o.Query
 	return v
 }
 
@@ -50,7 +67,7 @@ func (o *Options) Config() (*config.Config, error) {
 		o.cachedConfig = &config.Config{}
 		return o.cachedConfig, nil
 	}
-	cfg, err := config.LoadWithEnv(config.DefaultConfigPath())
+	cfg, err := config.LoadWithEnv(config.DefaultConfigPath(), o.Profile)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w (run 'cfl init' to configure)", err)
 	}
@@ -75,7 +92,29 @@ func (o *Options) APIClient() (*api.Client, error) {
 	if err != nil {
 		return nil, err
 	}
-	return api.NewClient(cfg.URL, cfg.Email, cfg.APIToken), nil
+	authn, err := cfg.Authenticator(config.DefaultConfigPath())
+	if err != nil {
+		return nil, err
+	}
+	transport, err := client.CassetteTransport(o.Record, o.Replay)
+	if err != nil {
+		return nil, err
+	}
+	traceOut, err := o.traceFile.Open(o.TraceFile)
+	if err != nil {
+		return nil, err
+	}
+	return api.NewClientWithOptions(cfg.APIBaseURL(), &client.Options{
+		Auth:      authn,
+		Timeout:   o.Timeout,
+		Transport: transport,
+		TraceOut:  traceOut,
+	}), nil
+}
+
+// Close closes the --trace-file, if it was opened
+func (o *Options) Close() error {
+	return o.traceFile.Close()
 }
 
 // SetAPIClient sets a test client (for testing only)
@@ -100,6 +139,14 @@ It provides commands for managing pages, spaces, and attachments
 with a markdown-first approach for content editing.
 
 Get started by running: cfl init`,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			output, err := view.ResolveFormat(opts.Output, cmd.Flags().Changed("output"), opts.Template, opts.Query)
+			if err != nil {
+				return err
+			}
+			opts.Output = output
+			return nil
+		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
 		Version:       version.Version,
@@ -107,8 +154,20 @@ Get started by running: cfl init`,
 
 	// Global flags - bound to opts struct
 	cmd.PersistentFlags().StringP("config", "c", "", "config file (default: ~/.config/cfl/config.yml)")
-	cmd.PersistentFlags().StringVarP(&opts.Output, "output", "o", "table", "output format: table, json, plain")
+	cmd.PersistentFlags().StringVarP(&opts.Output, "output", "o", "table", "output format: "+strings.Join(view.ValidFormats(), ", "))
+	cmd.PersistentFlags().StringVar(&opts.Template, "template", "", "render output with a Go template over the fields shown by -o json")
+	cmd.PersistentFlags().StringVar(&opts.Query, "jq", "", "filter JSON output with a jq expression (implies -o json)")
+	cmd.PersistentFlags().StringSliceVar(&opts.Columns, "columns", nil, "comma-separated table columns to show, in order")
 	cmd.PersistentFlags().BoolVar(&opts.NoColor, "no-color", false, "disable colored output")
+	cmd.PersistentFlags().StringVar(&opts.Profile, "profile", "", "config profile to use (default: $CFL_PROFILE or the default profile)")
+	cmd.PersistentFlags().DurationVar(&opts.Timeout, "timeout", client.DefaultTimeout, "timeout for each API request")
+	cmd.PersistentFlags().StringVar(&opts.Record, "record", "", "record API requests and responses to a cassette file, with credentials redacted")
+	cmd.PersistentFlags().StringVar(&opts.Replay, "replay", "", "answer API requests from a recorded cassette file instead of the network")
+	cmd.PersistentFlags().StringVar(&opts.TraceFile, "trace-file", "", "append a JSON line per API request, with timing, headers and bodies, to a file")
+
+	_ = cmd.RegisterFlagCompletionFunc("output", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return view.ValidFormats(), cobra.ShellCompDirectiveNoFileComp
+	})
 
 	// Set version template
 	cmd.SetVersionTemplate("cfl version {{.Version}} (commit: " + version.Commit + ", built: " + version.BuildDate + ")\n")
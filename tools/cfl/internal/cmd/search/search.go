@@ -28,6 +28,7 @@ type searchOptions struct {
 
 	// Pagination
 	limit int
+	all   bool
 }
 
 // validTypes are the content types accepted by Confluence search.
@@ -76,13 +77,16 @@ convenient flags for common filters, or provide raw CQL for advanced queries.`,
   cfl search --cql "type=page AND space=DEV AND lastModified > now('-7d')"
 
   # Output as JSON for scripting
-  cfl search "config" -o json`,
+  cfl search "config" -o json
+
+  # Fetch every match, not just the first page
+  cfl search --space DEV --type page --all`,
 		Args: cobra.MaximumNArgs(1),
-		RunE: func(_ *cobra.Command, args []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.query = args[0]
 			}
-			return runSearch(opts)
+			return runSearch(cmd.Context(), opts)
 		},
 	}
 
@@ -95,11 +99,12 @@ convenient flags for common filters, or provide raw CQL for advanced queries.`,
 
 	// Pagination
 	cmd.Flags().IntVarP(&opts.limit, "limit", "l", 25, "Maximum number of results")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Fetch all pages of results (--limit sets the page size)")
 
 	return cmd
 }
 
-func runSearch(opts *searchOptions) error {
+func runSearch(ctx context.Context, opts *searchOptions) error {
 	// Validate output format
 	if err := view.ValidateFormat(opts.Output); err != nil {
 		return err
@@ -125,7 +130,7 @@ func runSearch(opts *searchOptions) error {
 
 	// Handle limit 0 - return empty
 	if opts.limit == 0 {
-		if opts.Output == "json" {
+		if view.IsStructured(opts.Output) {
 			return v.JSON([]interface{}{})
 		}
 		v.RenderText("No results.")
@@ -160,7 +165,12 @@ func runSearch(opts *searchOptions) error {
 		Limit: opts.limit,
 	}
 
-	result, err := client.Search(context.Background(), apiOpts)
+	result := &api.SearchResponse{}
+	if opts.all {
+		result.Results, err = client.SearchAll(ctx, apiOpts)
+	} else {
+		result, err = client.Search(ctx, apiOpts)
+	}
 	if err != nil {
 		return fmt.Errorf("search failed: %w", err)
 	}
@@ -184,9 +194,11 @@ func runSearch(opts *searchOptions) error {
 		})
 	}
 
-	_ = v.RenderList(headers, rows, result.HasMore())
+	if err := v.RenderListOf(headers, rows, result.Results, result.HasMore()); err != nil {
+		return err
+	}
 
-	if result.HasMore() && opts.Output != "json" {
+	if result.HasMore() && !view.IsMachineReadable(opts.Output) {
 		_, _ = fmt.Fprintf(opts.Stderr, "\n(showing %d of %d results, use --limit to see more)\n",
 			len(result.Results), result.TotalSize)
 	}
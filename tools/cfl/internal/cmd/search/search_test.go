@@ -2,6 +2,7 @@ package search
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -64,7 +65,7 @@ func TestRunSearch_Success(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -87,7 +88,7 @@ func TestRunSearch_EmptyResults(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -116,7 +117,7 @@ func TestRunSearch_JSONOutput(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -145,7 +146,7 @@ func TestRunSearch_PlainOutput(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -159,7 +160,7 @@ func TestRunSearch_InvalidOutputFormat(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid output format")
 }
@@ -174,7 +175,7 @@ func TestRunSearch_InvalidType(t *testing.T) {
 		contentType: "invalid",
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid type")
 	assert.Contains(t, err.Error(), "invalid")
@@ -199,7 +200,7 @@ func TestRunSearch_ValidTypes(t *testing.T) {
 				limit:       25,
 			}
 
-			err := runSearch(opts)
+			err := runSearch(context.Background(), opts)
 			require.NoError(t, err)
 		})
 	}
@@ -213,7 +214,7 @@ func TestRunSearch_NoQuery(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "search requires a query")
 }
@@ -227,7 +228,7 @@ func TestRunSearch_NegativeLimit(t *testing.T) {
 		limit:   -1,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid limit")
 }
@@ -242,7 +243,7 @@ func TestRunSearch_ZeroLimit(t *testing.T) {
 	}
 
 	// Zero limit should return empty without making API call
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -267,7 +268,7 @@ func TestRunSearch_WithSpaceFilter(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -292,7 +293,7 @@ func TestRunSearch_WithTypeFilter(t *testing.T) {
 		limit:       25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -316,7 +317,7 @@ func TestRunSearch_WithTitleFilter(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -340,7 +341,7 @@ func TestRunSearch_WithLabelFilter(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -365,7 +366,7 @@ func TestRunSearch_WithRawCQL(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -395,7 +396,7 @@ func TestRunSearch_CombinedFilters(t *testing.T) {
 		limit:       25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -416,7 +417,7 @@ func TestRunSearch_APIError(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "search failed")
 }
@@ -445,7 +446,7 @@ func TestRunSearch_HasMore(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -474,7 +475,7 @@ func TestRunSearch_LongTitle(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -493,7 +494,7 @@ func TestRunSearch_SpaceOnlyFilter(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -517,7 +518,7 @@ func TestRunSearch_LimitParameter(t *testing.T) {
 		limit:   50,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -598,7 +599,7 @@ func TestRunSearch_DisplaysSpaceKey(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runSearch(opts)
+	err := runSearch(context.Background(), opts)
 	require.NoError(t, err)
 	// The output should contain the space key "DEV" extracted from displayUrl
 }
@@ -3,7 +3,6 @@ package space
 import (
 	"context"
 	"fmt"
-	"net/url"
 
 	"github.com/spf13/cobra"
 
@@ -18,6 +17,7 @@ type listOptions struct {
 	limit     int
 	spaceType string
 	cursor    string
+	all       bool
 }
 
 func newListCmd(rootOpts *root.Options) *cobra.Command {
@@ -38,20 +38,24 @@ func newListCmd(rootOpts *root.Options) *cobra.Command {
   cfl space list -o json
 
   # Paginate through results
-  cfl space list --cursor "eyJpZCI6MTIzfQ=="`,
-		RunE: func(_ *cobra.Command, _ []string) error {
-			return runList(opts)
+  cfl space list --cursor "eyJpZCI6MTIzfQ=="
+
+  # Fetch every space, following all pages
+  cfl space list --all`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return runList(cmd.Context(), opts)
 		},
 	}
 
 	cmd.Flags().IntVarP(&opts.limit, "limit", "l", 25, "Maximum number of spaces to return")
 	cmd.Flags().StringVarP(&opts.spaceType, "type", "t", "", "Filter by space type (global, personal)")
 	cmd.Flags().StringVar(&opts.cursor, "cursor", "", "Pagination cursor for next page")
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Fetch all pages of results (--limit sets the page size)")
 
 	return cmd
 }
 
-func runList(opts *listOptions) error {
+func runList(ctx context.Context, opts *listOptions) error {
 	if err := view.ValidateFormat(opts.Output); err != nil {
 		return err
 	}
@@ -63,7 +67,7 @@ func runList(opts *listOptions) error {
 	v := opts.View()
 
 	if opts.limit == 0 {
-		if opts.Output == "json" {
+		if view.IsStructured(opts.Output) {
 			return v.JSON([]interface{}{})
 		}
 		v.RenderText("No spaces found.")
@@ -81,7 +85,12 @@ func runList(opts *listOptions) error {
 		Cursor: opts.cursor,
 	}
 
-	result, err := client.ListSpaces(context.Background(), apiOpts)
+	result := &api.PaginatedResponse[api.Space]{}
+	if opts.all {
+		result.Results, err = client.ListAllSpaces(ctx, apiOpts)
+	} else {
+		result, err = client.ListSpaces(ctx, apiOpts)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to list spaces: %w", err)
 	}
@@ -107,10 +116,12 @@ func runList(opts *listOptions) error {
 		})
 	}
 
-	_ = v.RenderList(headers, rows, result.HasMore())
+	if err := v.RenderListOf(headers, rows, result.Results, result.HasMore()); err != nil {
+		return err
+	}
 
-	if result.HasMore() && opts.Output != "json" {
-		nextCursor := extractCursor(result.Links.Next)
+	if result.HasMore() && !view.IsMachineReadable(opts.Output) {
+		nextCursor := result.NextCursor()
 		if nextCursor != "" {
 			_, _ = fmt.Fprintf(opts.Stderr, "\nNext page: cfl space list --cursor %q\n", nextCursor)
 		} else {
@@ -120,15 +131,3 @@ func runList(opts *listOptions) error {
 
 	return nil
 }
-
-// extractCursor parses the cursor query parameter from a next link URL.
-func extractCursor(nextLink string) string {
-	if nextLink == "" {
-		return ""
-	}
-	parsed, err := url.Parse(nextLink)
-	if err != nil {
-		return ""
-	}
-	return parsed.Query().Get("cursor")
-}
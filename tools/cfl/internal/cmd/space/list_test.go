@@ -2,6 +2,7 @@ package space
 
 import (
 	"bytes"
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -58,7 +59,7 @@ func TestRunList_Success(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -78,7 +79,7 @@ func TestRunList_EmptyResults(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -103,7 +104,7 @@ func TestRunList_JSONOutput(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -116,7 +117,7 @@ func TestRunList_InvalidOutputFormat(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid output format")
 }
@@ -129,7 +130,7 @@ func TestRunList_NegativeLimit(t *testing.T) {
 		limit:   -1,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "invalid limit")
 }
@@ -143,7 +144,7 @@ func TestRunList_ZeroLimit(t *testing.T) {
 	}
 
 	// Zero limit should return empty without making API call
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -157,7 +158,7 @@ func TestRunList_ZeroLimitJSON(t *testing.T) {
 	}
 
 	// Zero limit should return empty JSON array without making API call
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -184,7 +185,7 @@ func TestRunList_WithTypeFilter(t *testing.T) {
 		spaceType: "global",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -206,7 +207,7 @@ func TestRunList_WithLimitParameter(t *testing.T) {
 		limit:   50,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -226,7 +227,7 @@ func TestRunList_APIError(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to list spaces")
 }
@@ -252,7 +253,7 @@ func TestRunList_HasMore(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -276,7 +277,7 @@ func TestRunList_NullDescription(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -303,7 +304,7 @@ func TestRunList_WithCursor(t *testing.T) {
 		cursor:  "abc123",
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 }
 
@@ -330,13 +331,13 @@ func TestRunList_DisplaysNextCursor(t *testing.T) {
 		limit:   25,
 	}
 
-	err := runList(opts)
+	err := runList(context.Background(), opts)
 	require.NoError(t, err)
 	assert.Contains(t, stderr.String(), "nextPageCursor123")
 	assert.Contains(t, stderr.String(), "--cursor")
 }
 
-func TestExtractCursor(t *testing.T) {
+func TestNextCursor(t *testing.T) {
 	tests := []struct {
 		name     string
 		nextLink string
@@ -366,7 +367,8 @@ func TestExtractCursor(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := extractCursor(tt.nextLink)
+			result := &api.PaginatedResponse[api.Space]{Links: api.Links{Next: tt.nextLink}}
+			got := result.NextCursor()
 			assert.Equal(t, tt.want, got)
 		})
 	}
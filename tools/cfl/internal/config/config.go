@@ -8,17 +8,22 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
 	sharedconfig "github.com/open-cli-collective/atlassian-go/config"
 	"gopkg.in/yaml.v3"
 )
 
-// Config holds the cfl configuration.
+// credentialService is the service name API tokens are stored under.
+const credentialService = "cfl"
+
+// Config holds the settings of one cfl profile.
 type Config struct {
 	URL          string `yaml:"url"`
 	Email        string `yaml:"email"`
-	APIToken     string `yaml:"api_token"`
 	DefaultSpace string `yaml:"default_space,omitempty"`
 	OutputFormat string `yaml:"output_format,omitempty"`
+
+	sharedconfig.Auth `yaml:",inline"`
 }
 
 // Validate checks that all required fields are present and valid.
@@ -26,12 +31,18 @@ func (c *Config) Validate() error {
 	if c.URL == "" {
 		return errors.New("url is required")
 	}
-	if c.Email == "" {
+	if err := auth.ValidateMethod(c.AuthMethod); err != nil {
+		return err
+	}
+	if c.Email == "" && c.method() == auth.MethodBasic {
 		return errors.New("email is required")
 	}
 	if c.APIToken == "" {
 		return errors.New("api_token is required")
 	}
+	if c.method() == auth.MethodOAuth && (c.CloudID == "" || c.OAuthClientID == "") {
+		return errors.New("cloud_id and oauth_client_id are required for OAuth")
+	}
 
 	// Validate URL scheme
 	if !strings.HasPrefix(c.URL, "https://") {
@@ -65,6 +76,70 @@ func (c *Config) LoadFromEnv() {
 	if space := os.Getenv("CFL_DEFAULT_SPACE"); space != "" {
 		c.DefaultSpace = space
 	}
+	if method := sharedconfig.GetEnvWithFallback("CFL_AUTH_METHOD", "ATLASSIAN_AUTH_METHOD"); method != "" {
+		c.AuthMethod = method
+	}
+}
+
+// method returns the authentication method, defaulting to basic.
+func (c *Config) method() string {
+	if c.AuthMethod == "" {
+		return auth.MethodBasic
+	}
+	return c.AuthMethod
+}
+
+// APIBaseURL returns the URL API requests are sent to: the site URL, or
+// the api.atlassian.com gateway for OAuth.
+func (c *Config) APIBaseURL() string {
+	if c.method() == auth.MethodOAuth {
+		return auth.GatewayBaseURL("confluence", c.CloudID) + "/wiki"
+	}
+	return c.URL
+}
+
+// Authenticator returns the authenticator for the configured auth method.
+// OAuth tokens are refreshed as needed and written back to the credential
+// store of the config at path.
+func (c *Config) Authenticator(path string) (auth.Authenticator, error) {
+	switch method := c.method(); method {
+	case auth.MethodBasic:
+		return &auth.Basic{Email: c.Email, APIToken: c.APIToken}, nil
+	case auth.MethodBearer:
+		return &auth.Bearer{Token: c.APIToken}, nil
+	case auth.MethodOAuth:
+		creds, err := auth.ParseOAuthCredentials(c.APIToken)
+		if err != nil {
+			return nil, err
+		}
+		authn := auth.NewOAuth(&auth.OAuthConfig{
+			ClientID:     c.OAuthClientID,
+			ClientSecret: creds.ClientSecret,
+		}, &creds.Token)
+		authn.OnRefresh = func(token *auth.Token) error {
+			creds.Token = *token
+			secret, err := creds.Encode()
+			if err != nil {
+				return err
+			}
+			return c.SaveToken(path, secret)
+		}
+		return authn, nil
+	default:
+		return nil, auth.ValidateMethod(method)
+	}
+}
+
+// SaveToken replaces the secret in the credential store of the config at
+// path, leaving the config file untouched.
+func (c *Config) SaveToken(path, secret string) error {
+	store, err := credentials(path).Set(c.credential(), secret)
+	if err != nil {
+		return err
+	}
+	c.APIToken = secret
+	c.TokenStore = store
+	return nil
 }
 
 // DefaultConfigPath returns the default configuration file path.
@@ -83,15 +158,84 @@ func DefaultConfigPath() string {
 	return filepath.Join(home, ".config", "cfl", "config.yml")
 }
 
-// Save writes the configuration to the specified path.
-func (c *Config) Save(path string) error {
+// credentials keeps the API tokens of the profiles in the config at path.
+func credentials(path string) sharedconfig.Credentials {
+	return sharedconfig.Credentials{Service: credentialService, ConfigPath: path}
+}
+
+// credential returns where the API token is stored.
+func (c *Config) credential() sharedconfig.Credential {
+	return sharedconfig.Credential{Backend: c.CredentialStore, Account: auth.Account(c.URL, c.Email)}
+}
+
+// File is the config file: named profiles, one of which is the default.
+type File = sharedconfig.Profiles[Config]
+
+// legacyFile is the config file as written before profiles existed, with a
+// single set of settings at the top level.
+type legacyFile struct {
+	File   `yaml:",inline"`
+	Config `yaml:",inline"`
+}
+
+// ActiveProfile returns the name of the profile to use from f.
+// Precedence: --profile → CFL_PROFILE → ATLASSIAN_PROFILE → default profile in config → "default"
+func ActiveProfile(f *File, flag string) string {
+	return sharedconfig.SelectProfile(flag, "CFL_PROFILE", f.Default)
+}
+
+// LoadFile reads every profile from path, without API tokens.
+// A missing file yields no profiles.
+func LoadFile(path string) (*File, error) {
+	f, err := readFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &File{}, nil
+	}
+	return f, err
+}
+
+// readFile reads the config file without consulting the credential store.
+func readFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var raw legacyFile
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	f := raw.File
+	if len(f.Profiles) == 0 && raw.Config != (Config{}) {
+		cfg := raw.Config
+		f.Set(sharedconfig.DefaultProfile, &cfg)
+	}
+
+	return &f, nil
+}
+
+// writeFile writes the config file to path, leaving out API tokens. Tokens
+// not yet in the credential store, such as plaintext tokens of any profile
+// left by an older version, are moved there first; a token that cannot be
+// stored stays in the file rather than being lost.
+func writeFile(path string, f *File) error {
 	// Create directory if it doesn't exist
 	dir := filepath.Dir(path)
 	if err := os.MkdirAll(dir, 0755); err != nil {
 		return fmt.Errorf("failed to create config directory: %w", err)
 	}
 
-	data, err := yaml.Marshal(c)
+	onDisk := File{Default: f.Default}
+	for name, cfg := range f.Profiles {
+		c := *cfg
+		if cfg.storeToken(path) == nil {
+			c.APIToken = ""
+		}
+		onDisk.Set(name, &c)
+	}
+
+	data, err := yaml.Marshal(&onDisk)
 	if err != nil {
 		return fmt.Errorf("failed to marshal config: %w", err)
 	}
@@ -104,27 +248,182 @@ func (c *Config) Save(path string) error {
 	return nil
 }
 
-// Load reads the configuration from the specified path.
+// storeToken moves the API token into the credential store, unless it was
+// loaded from or saved to the store already.
+func (c *Config) storeToken(path string) error {
+	if c.APIToken == "" || c.TokenStore != "" {
+		return nil
+	}
+	store, err := credentials(path).Set(c.credential(), c.APIToken)
+	if err != nil {
+		return err
+	}
+	c.TokenStore = store
+	return nil
+}
+
+// Save writes the configuration as the selected profile (see ActiveProfile)
+// to the specified path and the API token to the credential store.
+func (c *Config) Save(path string) error {
+	return c.SaveProfile(path, "")
+}
+
+// SaveProfile writes the configuration as the named profile, or the selected
+// profile if name is empty, creating it if needed.
+func (c *Config) SaveProfile(path, name string) error {
+	f, err := LoadFile(path)
+	if err != nil {
+		return err
+	}
+
+	name = ActiveProfile(f, name)
+	if err := sharedconfig.ValidateProfileName(name); err != nil {
+		return err
+	}
+
+	if c.APIToken != "" {
+		store, err := credentials(path).Set(c.credential(), c.APIToken)
+		if err != nil {
+			return err
+		}
+		c.TokenStore = store
+	}
+
+	f.Set(name, c)
+	return writeFile(path, f)
+}
+
+// Load reads the selected profile (see ActiveProfile) from the specified path.
 func Load(path string) (*Config, error) {
-	data, err := os.ReadFile(path)
+	return LoadProfile(path, "")
+}
+
+// LoadProfile reads the named profile, or the selected profile if name is
+// empty, from path and its API token from the credential store. A plaintext
+// api_token left by an older version is moved into the credential store; if
+// that fails it is used as is.
+func LoadProfile(path, name string) (*Config, error) {
+	f, cfg, err := readProfile(path, name)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+		return nil, err
 	}
+	if err := cfg.loadToken(path, f); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
 
-	var cfg Config
-	if err := yaml.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("failed to parse config file: %w", err)
+// readProfile reads the named profile, or the selected profile if name is
+// empty, from the config file at path, without its API token.
+func readProfile(path, name string) (*File, *Config, error) {
+	f, err := readFile(path)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return &cfg, nil
+	name = ActiveProfile(f, name)
+	cfg, ok := f.Get(name)
+	if !ok {
+		return nil, nil, fmt.Errorf("profile %q not found in %s", name, path)
+	}
+	return f, cfg, nil
 }
 
-// LoadWithEnv loads configuration from file and overrides with environment variables.
-func LoadWithEnv(path string) (*Config, error) {
-	cfg, err := Load(path)
+// loadToken reads the API token of c from the credential store. A plaintext
+// token in the config file f is moved into the store instead, along with
+// those of the other profiles.
+func (c *Config) loadToken(path string, f *File) error {
+	if c.APIToken != "" {
+		// Rewriting the file moves the plaintext tokens into the store
+		_ = writeFile(path, f)
+		return nil
+	}
+
+	token, store, err := credentials(path).Get(c.credential())
 	if err != nil {
+		return err
+	}
+	c.APIToken = token
+	c.TokenStore = store
+	return nil
+}
+
+// UseProfile makes the named profile the default in the config at path.
+func UseProfile(path, name string) error {
+	f, err := readFile(path)
+	if err != nil {
+		return err
+	}
+	if err := f.Use(name); err != nil {
+		return err
+	}
+	return writeFile(path, f)
+}
+
+// RemoveProfile removes the named profile from the config at path, along
+// with its API token unless another profile uses the same account.
+func RemoveProfile(path, name string) error {
+	f, err := readFile(path)
+	if err != nil {
+		return err
+	}
+	cfg, ok := f.Get(name)
+	if !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+	f.Remove(name)
+
+	if err := deleteToken(f, cfg, path); err != nil {
+		return err
+	}
+	return writeFile(path, f)
+}
+
+// deleteToken removes the API token of cfg from the credential store, unless
+// a profile remaining in f shares it.
+func deleteToken(f *File, cfg *Config, path string) error {
+	remaining := make([]sharedconfig.Credential, 0, len(f.Profiles))
+	for _, other := range f.Profiles {
+		remaining = append(remaining, other.credential())
+	}
+	return credentials(path).Delete(cfg.credential(), remaining)
+}
+
+// Clear removes the configuration file at path, including every profile,
+// and their stored API tokens.
+func Clear(path string) error {
+	// An unreadable config cannot name its tokens, but should still be removable
+	if f, err := readFile(path); err == nil {
+		for _, name := range f.Names() {
+			cfg, _ := f.Get(name)
+			f.Remove(name)
+			if err := deleteToken(f, cfg, path); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := os.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove config file: %w", err)
+	}
+
+	return nil
+}
+
+// LoadWithEnv loads the named profile, or the selected profile if name is
+// empty, and overrides it with environment variables. The credential store
+// is not read when CFL_API_TOKEN or ATLASSIAN_API_TOKEN is set.
+func LoadWithEnv(path, profile string) (*Config, error) {
+	f, cfg, err := readProfile(path, profile)
+	if errors.Is(err, os.ErrNotExist) {
 		// If file doesn't exist, start with empty config
 		cfg = &Config{}
+	} else if err != nil {
+		return nil, err
+	} else if sharedconfig.GetEnvWithFallback("CFL_API_TOKEN", "ATLASSIAN_API_TOKEN") == "" {
+		if err := cfg.loadToken(path, f); err != nil {
+			return nil, err
+		}
 	}
 
 	cfg.LoadFromEnv()
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
 	sharedconfig "github.com/open-cli-collective/atlassian-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,17 +22,17 @@ func TestConfig_Validate(t *testing.T) {
 		{
 			name: "valid config",
 			config: Config{
-				URL:      "https://example.atlassian.net",
-				Email:    "user@example.com",
-				APIToken: "token123",
+				URL:   "https://example.atlassian.net",
+				Email: "user@example.com",
+				Auth:  sharedconfig.Auth{APIToken: "token123"},
 			},
 			wantErr: false,
 		},
 		{
 			name: "missing URL",
 			config: Config{
-				Email:    "user@example.com",
-				APIToken: "token123",
+				Email: "user@example.com",
+				Auth:  sharedconfig.Auth{APIToken: "token123"},
 			},
 			wantErr: true,
 			errMsg:  "url is required",
@@ -39,8 +40,8 @@ func TestConfig_Validate(t *testing.T) {
 		{
 			name: "missing email",
 			config: Config{
-				URL:      "https://example.atlassian.net",
-				APIToken: "token123",
+				URL:  "https://example.atlassian.net",
+				Auth: sharedconfig.Auth{APIToken: "token123"},
 			},
 			wantErr: true,
 			errMsg:  "email is required",
@@ -57,9 +58,9 @@ func TestConfig_Validate(t *testing.T) {
 		{
 			name: "invalid URL scheme",
 			config: Config{
-				URL:      "ftp://example.atlassian.net",
-				Email:    "user@example.com",
-				APIToken: "token123",
+				URL:   "ftp://example.atlassian.net",
+				Email: "user@example.com",
+				Auth:  sharedconfig.Auth{APIToken: "token123"},
 			},
 			wantErr: true,
 			errMsg:  "url must use https",
@@ -181,13 +182,14 @@ func TestConfig_Save_and_Load(t *testing.T) {
 	// Create a temp directory for the test
 	tmpDir := t.TempDir()
 	configPath := filepath.Join(tmpDir, "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
 
 	original := Config{
 		URL:          "https://test.atlassian.net",
 		Email:        "test@example.com",
-		APIToken:     "test-token",
 		DefaultSpace: "TEST",
 		OutputFormat: "json",
+		Auth:         sharedconfig.Auth{APIToken: "test-token"},
 	}
 
 	// Save
@@ -205,6 +207,213 @@ func TestConfig_Save_and_Load(t *testing.T) {
 	assert.Equal(t, original.OutputFormat, loaded.OutputFormat)
 }
 
+func TestConfig_Save_TokenNotInConfigFile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+
+	cfg := Config{URL: "https://test.atlassian.net/wiki", Email: "test@example.com", Auth: sharedconfig.Auth{APIToken: "secret-token"}}
+	require.NoError(t, cfg.Save(configPath))
+	assert.Equal(t, auth.BackendFile, cfg.TokenStore)
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), "api_token")
+}
+
+func TestLoad_MigratesPlaintextToken(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+
+	legacy := "url: https://test.atlassian.net/wiki\nemail: test@example.com\napi_token: legacy-token\n"
+	require.NoError(t, os.WriteFile(configPath, []byte(legacy), 0600))
+
+	loaded, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-token", loaded.APIToken)
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "legacy-token")
+
+	reloaded, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-token", reloaded.APIToken)
+	assert.Equal(t, auth.BackendFile, reloaded.TokenStore)
+}
+
+func TestLoad_MigratesPlaintextTokensOfEveryProfile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+
+	legacy := `profiles:
+  default:
+    url: https://test.atlassian.net/wiki
+    email: test@example.com
+    api_token: default-token
+  sandbox:
+    url: https://sandbox.atlassian.net/wiki
+    email: test@example.com
+    api_token: sandbox-token
+`
+	require.NoError(t, os.WriteFile(configPath, []byte(legacy), 0600))
+
+	loaded, err := LoadProfile(configPath, "default")
+	require.NoError(t, err)
+	assert.Equal(t, "default-token", loaded.APIToken)
+
+	// Moving one profile's token must not drop the other's
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "default-token")
+	assert.NotContains(t, string(data), "sandbox-token")
+
+	for name, want := range map[string]string{"default": "default-token", "sandbox": "sandbox-token"} {
+		loaded, err := LoadProfile(configPath, name)
+		require.NoError(t, err)
+		assert.Equal(t, want, loaded.APIToken, name)
+		assert.Equal(t, auth.BackendFile, loaded.TokenStore, name)
+	}
+}
+
+func TestClear(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+
+	cfg := Config{URL: "https://test.atlassian.net/wiki", Email: "test@example.com", Auth: sharedconfig.Auth{APIToken: "secret-token"}}
+	require.NoError(t, cfg.Save(configPath))
+	require.NoError(t, Clear(configPath))
+
+	_, err := os.Stat(configPath)
+	assert.True(t, os.IsNotExist(err))
+
+	// Re-creating the config must not resurrect the old token
+	cfg.APIToken = ""
+	require.NoError(t, cfg.Save(configPath))
+	loaded, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Empty(t, loaded.APIToken)
+}
+
+func TestProfiles_SaveAndLoad(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+	t.Setenv("CFL_PROFILE", "")
+	t.Setenv("ATLASSIAN_PROFILE", "")
+
+	prod := Config{URL: "https://prod.atlassian.net/wiki", Email: "me@example.com", Auth: sharedconfig.Auth{APIToken: "prod-token"}}
+	sandbox := Config{URL: "https://sandbox.atlassian.net/wiki", Email: "me@example.com", Auth: sharedconfig.Auth{APIToken: "sandbox-token"}}
+	require.NoError(t, prod.Save(configPath))
+	require.NoError(t, sandbox.SaveProfile(configPath, "sandbox"))
+
+	f, err := LoadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default", "sandbox"}, f.Names())
+
+	loaded, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, prod.URL, loaded.URL)
+	assert.Equal(t, "prod-token", loaded.APIToken)
+
+	loaded, err = LoadProfile(configPath, "sandbox")
+	require.NoError(t, err)
+	assert.Equal(t, sandbox.URL, loaded.URL)
+	assert.Equal(t, "sandbox-token", loaded.APIToken)
+
+	_, err = LoadProfile(configPath, "missing")
+	assert.ErrorContains(t, err, `profile "missing" not found`)
+}
+
+func TestProfiles_Selection(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+	t.Setenv("CFL_PROFILE", "")
+	t.Setenv("ATLASSIAN_PROFILE", "")
+
+	for _, name := range []string{"default", "a", "b", "c"} {
+		cfg := Config{URL: "https://" + name + ".atlassian.net/wiki", Email: "me@example.com"}
+		require.NoError(t, cfg.SaveProfile(configPath, name))
+	}
+	require.NoError(t, UseProfile(configPath, "a"))
+
+	urlOf := func(flag string) string {
+		cfg, err := LoadWithEnv(configPath, flag)
+		require.NoError(t, err)
+		return cfg.URL
+	}
+
+	assert.Equal(t, "https://a.atlassian.net/wiki", urlOf(""))
+
+	t.Setenv("ATLASSIAN_PROFILE", "b")
+	assert.Equal(t, "https://b.atlassian.net/wiki", urlOf(""))
+
+	t.Setenv("CFL_PROFILE", "c")
+	assert.Equal(t, "https://c.atlassian.net/wiki", urlOf(""))
+	assert.Equal(t, "https://default.atlassian.net/wiki", urlOf("default"))
+
+	// Environment values still override the selected profile
+	t.Setenv("CFL_URL", "https://env.atlassian.net/wiki")
+	assert.Equal(t, "https://env.atlassian.net/wiki", urlOf("b"))
+
+	_, err := LoadWithEnv(configPath, "typo")
+	assert.ErrorContains(t, err, `profile "typo" not found`)
+
+	// Without a config file the environment alone configures cfl
+	cfg, err := LoadWithEnv(filepath.Join(t.TempDir(), "missing.yml"), "")
+	require.NoError(t, err)
+	assert.Equal(t, "https://env.atlassian.net/wiki", cfg.URL)
+}
+
+func TestProfiles_LegacyConfigBecomesDefaultProfile(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+	t.Setenv("CFL_PROFILE", "")
+	t.Setenv("ATLASSIAN_PROFILE", "")
+
+	legacy := "url: https://test.atlassian.net/wiki\nemail: test@example.com\ndefault_space: DEV\n"
+	require.NoError(t, os.WriteFile(configPath, []byte(legacy), 0600))
+
+	other := Config{URL: "https://other.atlassian.net/wiki", Email: "test@example.com"}
+	require.NoError(t, other.SaveProfile(configPath, "other"))
+
+	f, err := LoadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default", "other"}, f.Names())
+
+	loaded, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "DEV", loaded.DefaultSpace)
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "profiles:")
+}
+
+func TestProfiles_Remove(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+	t.Setenv("CFL_PROFILE", "")
+	t.Setenv("ATLASSIAN_PROFILE", "")
+
+	shared := Config{URL: "https://test.atlassian.net/wiki", Email: "me@example.com", Auth: sharedconfig.Auth{APIToken: "shared-token"}}
+	require.NoError(t, shared.Save(configPath))
+	require.NoError(t, shared.SaveProfile(configPath, "copy"))
+	require.NoError(t, UseProfile(configPath, "copy"))
+
+	// The token is kept while another profile uses the same account
+	require.NoError(t, RemoveProfile(configPath, "copy"))
+	loaded, err := Load(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "shared-token", loaded.APIToken)
+
+	f, err := LoadFile(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, "default", f.DefaultName())
+
+	require.NoError(t, RemoveProfile(configPath, "default"))
+	assert.ErrorContains(t, RemoveProfile(configPath, "default"), "not found")
+}
+
 func TestLoad_FileNotFound(t *testing.T) {
 	_, err := Load("/nonexistent/path/config.yml")
 	require.Error(t, err)
@@ -300,3 +509,81 @@ func TestGetEnvWithFallback(t *testing.T) {
 		assert.Equal(t, "", sharedconfig.GetEnvWithFallback("TEST_PRIMARY", "TEST_FALLBACK"))
 	})
 }
+
+func TestConfig_Authenticator(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+
+	basic := &Config{URL: "https://test.atlassian.net/wiki", Email: "me@example.com", Auth: sharedconfig.Auth{APIToken: "token"}}
+	authn, err := basic.Authenticator(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, &auth.Basic{Email: "me@example.com", APIToken: "token"}, authn)
+	assert.Equal(t, basic.URL, basic.APIBaseURL())
+
+	bearer := &Config{URL: "https://wiki.example.com/wiki", Auth: sharedconfig.Auth{AuthMethod: auth.MethodBearer, APIToken: "pat"}}
+	require.NoError(t, bearer.Validate(), "bearer profiles need no email")
+	authn, err = bearer.Authenticator(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, &auth.Bearer{Token: "pat"}, authn)
+
+	creds := &auth.OAuthCredentials{
+		ClientSecret: "secret",
+		Token:        auth.Token{AccessToken: "access", RefreshToken: "refresh"},
+	}
+	secret, err := creds.Encode()
+	require.NoError(t, err)
+	oauthCfg := &Config{
+		URL: "https://test.atlassian.net/wiki",
+		Auth: sharedconfig.Auth{
+			AuthMethod:    auth.MethodOAuth,
+			CloudID:       "cloud-1",
+			OAuthClientID: "client",
+			APIToken:      secret,
+		},
+	}
+	require.NoError(t, oauthCfg.Validate())
+	assert.Equal(t, "https://api.atlassian.com/ex/confluence/cloud-1/wiki", oauthCfg.APIBaseURL())
+	require.NoError(t, oauthCfg.Save(configPath))
+
+	authn, err = oauthCfg.Authenticator(configPath)
+	require.NoError(t, err)
+	oauth, ok := authn.(*auth.OAuth)
+	require.True(t, ok)
+	assert.Equal(t, "secret", oauth.Config.ClientSecret)
+
+	// A refreshed token replaces the stored one
+	require.NoError(t, oauth.OnRefresh(&auth.Token{AccessToken: "new", RefreshToken: "refresh-2"}))
+	loaded, err := Load(configPath)
+	require.NoError(t, err)
+	stored, err := auth.ParseOAuthCredentials(loaded.APIToken)
+	require.NoError(t, err)
+	assert.Equal(t, "refresh-2", stored.RefreshToken)
+
+	oauthCfg.CloudID = ""
+	assert.ErrorContains(t, oauthCfg.Validate(), "cloud_id")
+	assert.ErrorContains(t, (&Config{URL: "https://x", Auth: sharedconfig.Auth{AuthMethod: "kerberos", APIToken: "t"}}).Validate(), "unknown auth method")
+}
+
+func TestLoadWithEnv_CredentialStoreError(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+	t.Setenv("CFL_PROFILE", "")
+	t.Setenv("ATLASSIAN_PROFILE", "")
+	t.Setenv("CFL_API_TOKEN", "")
+	t.Setenv("ATLASSIAN_API_TOKEN", "")
+
+	t.Setenv(auth.EnvCredentialPassphrase, "secret")
+	cfg := Config{URL: "https://example.atlassian.net/wiki", Email: "me@example.com", Auth: sharedconfig.Auth{APIToken: "token"}}
+	require.NoError(t, cfg.Save(configPath))
+	t.Setenv(auth.EnvCredentialPassphrase, "")
+
+	_, err := LoadWithEnv(configPath, "")
+	assert.ErrorContains(t, err, auth.EnvCredentialPassphrase)
+
+	// A token in the environment is used without reading the store
+	t.Setenv("CFL_API_TOKEN", "env-token")
+	loaded, err := LoadWithEnv(configPath, "")
+	require.NoError(t, err)
+	assert.Equal(t, cfg.URL, loaded.URL)
+	assert.Equal(t, "env-token", loaded.APIToken)
+}
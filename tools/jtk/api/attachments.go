@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -57,13 +58,13 @@ func (f FlexibleID) String() string {
 }
 
 // GetIssueAttachments returns all attachments for an issue
-func (c *Client) GetIssueAttachments(issueKey string) ([]Attachment, error) {
+func (c *Client) GetIssueAttachments(ctx context.Context, issueKey string) ([]Attachment, error) {
 	if issueKey == "" {
 		return nil, fmt.Errorf("issue key is required")
 	}
 
 	urlStr := fmt.Sprintf("%s/issue/%s?fields=attachment", c.BaseURL, issueKey)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -81,13 +82,13 @@ func (c *Client) GetIssueAttachments(issueKey string) ([]Attachment, error) {
 }
 
 // GetAttachment returns metadata for a specific attachment
-func (c *Client) GetAttachment(attachmentID string) (*Attachment, error) {
+func (c *Client) GetAttachment(ctx context.Context, attachmentID string) (*Attachment, error) {
 	if attachmentID == "" {
 		return nil, fmt.Errorf("attachment ID is required")
 	}
 
 	urlStr := fmt.Sprintf("%s/attachment/%s", c.BaseURL, attachmentID)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -101,7 +102,7 @@ func (c *Client) GetAttachment(attachmentID string) (*Attachment, error) {
 }
 
 // AddAttachment uploads a file as an attachment to an issue
-func (c *Client) AddAttachment(issueKey, filePath string) ([]Attachment, error) {
+func (c *Client) AddAttachment(ctx context.Context, issueKey, filePath string) ([]Attachment, error) {
 	if issueKey == "" {
 		return nil, fmt.Errorf("issue key is required")
 	}
@@ -140,21 +141,20 @@ func (c *Client) AddAttachment(issueKey, filePath string) ([]Attachment, error)
 
 	urlStr := fmt.Sprintf("%s/issue/%s/attachments", c.BaseURL, issueKey)
 
-	req, err := http.NewRequest(http.MethodPost, urlStr, pr)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, urlStr, pr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", c.GetAuthHeader())
+	if err := c.Authorize(req); err != nil {
+		_ = pr.CloseWithError(err)
+		return nil, err
+	}
 	req.Header.Set("Content-Type", writer.FormDataContentType())
 	req.Header.Set("Accept", "application/json")
 	// Required header for attachment uploads
 	req.Header.Set("X-Atlassian-Token", "no-check")
 
-	if c.Verbose {
-		fmt.Printf("→ POST %s\n", urlStr)
-	}
-
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("request failed: %w", err)
@@ -171,10 +171,6 @@ func (c *Client) AddAttachment(issueKey, filePath string) ([]Attachment, error)
 		return nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	if c.Verbose {
-		fmt.Printf("← %d %s\n", resp.StatusCode, http.StatusText(resp.StatusCode))
-	}
-
 	if resp.StatusCode >= 400 {
 		return nil, sharederrors.ParseAPIError(resp.StatusCode, respBody)
 	}
@@ -188,18 +184,18 @@ func (c *Client) AddAttachment(issueKey, filePath string) ([]Attachment, error)
 }
 
 // DeleteAttachment deletes an attachment by ID
-func (c *Client) DeleteAttachment(attachmentID string) error {
+func (c *Client) DeleteAttachment(ctx context.Context, attachmentID string) error {
 	if attachmentID == "" {
 		return fmt.Errorf("attachment ID is required")
 	}
 
 	urlStr := fmt.Sprintf("%s/attachment/%s", c.BaseURL, attachmentID)
-	_, err := c.delete(urlStr)
+	_, err := c.delete(ctx, urlStr)
 	return err
 }
 
 // DownloadAttachment downloads an attachment to the specified output path
-func (c *Client) DownloadAttachment(attachment *Attachment, outputPath string) error {
+func (c *Client) DownloadAttachment(ctx context.Context, attachment *Attachment, outputPath string) error {
 	if attachment == nil {
 		return fmt.Errorf("attachment is required")
 	}
@@ -208,15 +204,13 @@ func (c *Client) DownloadAttachment(attachment *Attachment, outputPath string) e
 	}
 
 	// Create the request
-	req, err := http.NewRequest(http.MethodGet, attachment.Content, nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, attachment.Content, nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Set("Authorization", c.GetAuthHeader())
-
-	if c.Verbose {
-		fmt.Printf("→ GET %s\n", attachment.Content)
+	if err := c.Authorize(req); err != nil {
+		return err
 	}
 
 	resp, err := c.HTTPClient.Do(req)
@@ -225,10 +219,6 @@ func (c *Client) DownloadAttachment(attachment *Attachment, outputPath string) e
 	}
 	defer resp.Body.Close()
 
-	if c.Verbose {
-		fmt.Printf("← %d %s\n", resp.StatusCode, http.StatusText(resp.StatusCode))
-	}
-
 	if resp.StatusCode >= 400 {
 		body, _ := io.ReadAll(resp.Body)
 		return sharederrors.ParseAPIError(resp.StatusCode, body)
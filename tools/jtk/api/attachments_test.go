@@ -1,11 +1,15 @@
 package api
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -91,7 +95,7 @@ func TestGetIssueAttachments(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	attachments, err := client.GetIssueAttachments("PROJ-123")
+	attachments, err := client.GetIssueAttachments(context.Background(), "PROJ-123")
 	require.NoError(t, err)
 	require.Len(t, attachments, 1)
 
@@ -109,7 +113,7 @@ func TestGetIssueAttachments_EmptyIssueKey(t *testing.T) {
 		APIToken: "token",
 	})
 
-	_, err := client.GetIssueAttachments("")
+	_, err := client.GetIssueAttachments(context.Background(), "")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "issue key is required")
 }
@@ -135,7 +139,7 @@ func TestGetAttachment(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	att, err := client.GetAttachment("10001")
+	att, err := client.GetAttachment(context.Background(), "10001")
 	require.NoError(t, err)
 	assert.Equal(t, "10001", att.ID.String())
 	assert.Equal(t, "document.pdf", att.Filename)
@@ -149,7 +153,7 @@ func TestGetAttachment_EmptyID(t *testing.T) {
 		APIToken: "token",
 	})
 
-	_, err := client.GetAttachment("")
+	_, err := client.GetAttachment(context.Background(), "")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "attachment ID is required")
 }
@@ -169,7 +173,7 @@ func TestDeleteAttachment(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	err = client.DeleteAttachment("10001")
+	err = client.DeleteAttachment(context.Background(), "10001")
 	assert.NoError(t, err)
 }
 
@@ -180,7 +184,7 @@ func TestDeleteAttachment_EmptyID(t *testing.T) {
 		APIToken: "token",
 	})
 
-	err := client.DeleteAttachment("")
+	err := client.DeleteAttachment(context.Background(), "")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "attachment ID is required")
 }
@@ -208,7 +212,7 @@ func TestDownloadAttachment(t *testing.T) {
 		Content:  server.URL + "/attachment/content",
 	}
 
-	err = client.DownloadAttachment(att, outPath)
+	err = client.DownloadAttachment(context.Background(), att, outPath)
 	require.NoError(t, err)
 
 	downloaded, err := os.ReadFile(outPath)
@@ -238,7 +242,7 @@ func TestDownloadAttachment_ToDirectory(t *testing.T) {
 		Content:  server.URL + "/attachment/content",
 	}
 
-	err = client.DownloadAttachment(att, tmpDir)
+	err = client.DownloadAttachment(context.Background(), att, tmpDir)
 	require.NoError(t, err)
 
 	// Should use original filename
@@ -247,6 +251,66 @@ func TestDownloadAttachment_ToDirectory(t *testing.T) {
 	assert.Equal(t, content, downloaded)
 }
 
+func TestAttachments_TracedNotPrinted(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			_, _ = w.Write([]byte(`[{"id":"10001","filename":"upload.txt"}]`))
+			return
+		}
+		_, _ = w.Write([]byte("content"))
+	}))
+	defer server.Close()
+
+	var verbose, trace bytes.Buffer
+	client, err := New(ClientConfig{
+		URL:        server.URL,
+		Email:      "test@example.com",
+		APIToken:   "token",
+		Verbose:    true,
+		VerboseOut: &verbose,
+		TraceOut:   &trace,
+	})
+	require.NoError(t, err)
+
+	tmpDir := t.TempDir()
+	upload := filepath.Join(tmpDir, "upload.txt")
+	require.NoError(t, os.WriteFile(upload, []byte("hello"), 0o600))
+
+	// Stdout must stay clean for JSON output
+	stdout := os.Stdout
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	os.Stdout = w
+
+	_, err = client.AddAttachment(context.Background(), "PROJ-1", upload)
+	require.NoError(t, err)
+	err = client.DownloadAttachment(context.Background(), &Attachment{Filename: "d.txt", Content: server.URL + "/content"}, tmpDir)
+	require.NoError(t, err)
+
+	os.Stdout = stdout
+	require.NoError(t, w.Close())
+	printed, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Empty(t, string(printed))
+
+	assert.Contains(t, verbose.String(), "→ POST "+server.URL+"/rest/api/3/issue/PROJ-1/attachments")
+	assert.Contains(t, verbose.String(), "→ GET "+server.URL+"/content")
+
+	lines := strings.Split(strings.TrimSpace(trace.String()), "\n")
+	require.Len(t, lines, 2)
+	var event struct {
+		Method      string `json:"method"`
+		Status      int    `json:"status"`
+		RequestBody struct {
+			Text string `json:"text"`
+		} `json:"request_body"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &event))
+	assert.Equal(t, http.MethodPost, event.Method)
+	assert.Equal(t, http.StatusOK, event.Status)
+	assert.Contains(t, event.RequestBody.Text, "hello")
+}
+
 func TestDownloadAttachment_NilAttachment(t *testing.T) {
 	client, _ := New(ClientConfig{
 		URL:      "http://unused",
@@ -254,7 +318,7 @@ func TestDownloadAttachment_NilAttachment(t *testing.T) {
 		APIToken: "token",
 	})
 
-	err := client.DownloadAttachment(nil, "/tmp/test.txt")
+	err := client.DownloadAttachment(context.Background(), nil, "/tmp/test.txt")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "attachment is required")
 }
@@ -267,7 +331,7 @@ func TestDownloadAttachment_NoContentURL(t *testing.T) {
 	})
 
 	att := &Attachment{Filename: "test.txt"}
-	err := client.DownloadAttachment(att, "/tmp/test.txt")
+	err := client.DownloadAttachment(context.Background(), att, "/tmp/test.txt")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "no content URL")
 }
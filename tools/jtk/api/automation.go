@@ -1,47 +1,46 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
+
+	"github.com/open-cli-collective/atlassian-go/client"
 )
 
 // ListAutomationRules returns summaries of all automation rules.
-func (c *Client) ListAutomationRules() ([]AutomationRuleSummary, error) {
-	base, err := c.AutomationBaseURL()
+func (c *Client) ListAutomationRules(ctx context.Context) ([]AutomationRuleSummary, error) {
+	base, err := c.AutomationBaseURL(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	var all []AutomationRuleSummary
-	urlStr := fmt.Sprintf("%s/rule/summary", base)
+	first := fmt.Sprintf("%s/rule/summary", base)
+
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]AutomationRuleSummary, *client.Cursor, error) {
+		urlStr := first
+		if cursor.URL != "" {
+			urlStr = cursor.URL
+		}
 
-	for urlStr != "" {
-		body, err := c.get(urlStr)
+		body, err := c.get(ctx, urlStr)
 		if err != nil {
-			return nil, fmt.Errorf("failed to list automation rules: %w", err)
+			return nil, nil, fmt.Errorf("failed to list automation rules: %w", err)
 		}
 
 		var resp AutomationRuleSummaryResponse
 		if err := json.Unmarshal(body, &resp); err != nil {
-			return nil, fmt.Errorf("failed to parse automation rules response: %w", err)
+			return nil, nil, fmt.Errorf("failed to parse automation rules response: %w", err)
 		}
 
-		all = append(all, resp.Items()...)
-
-		if next := resp.NextURL(); next != "" {
-			urlStr = next
-		} else {
-			urlStr = ""
-		}
-	}
-
-	return all, nil
+		return resp.Items(), client.NextLink(resp.NextURL()), nil
+	}), 0)
 }
 
 // ListAutomationRulesFiltered returns rule summaries filtered by state.
-func (c *Client) ListAutomationRulesFiltered(state string) ([]AutomationRuleSummary, error) {
-	rules, err := c.ListAutomationRules()
+func (c *Client) ListAutomationRulesFiltered(ctx context.Context, state string) ([]AutomationRuleSummary, error) {
+	rules, err := c.ListAutomationRules(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -60,14 +59,14 @@ func (c *Client) ListAutomationRulesFiltered(state string) ([]AutomationRuleSumm
 }
 
 // GetAutomationRule returns the full rule definition including components.
-func (c *Client) GetAutomationRule(ruleID string) (*AutomationRule, error) {
-	base, err := c.AutomationBaseURL()
+func (c *Client) GetAutomationRule(ctx context.Context, ruleID string) (*AutomationRule, error) {
+	base, err := c.AutomationBaseURL(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	urlStr := fmt.Sprintf("%s/rule/%s", base, url.PathEscape(ruleID))
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get automation rule %s: %w", ruleID, err)
 	}
@@ -98,14 +97,14 @@ func (c *Client) GetAutomationRule(ruleID string) (*AutomationRule, error) {
 
 // GetAutomationRuleRaw returns the full rule definition as raw JSON bytes.
 // This is used for the export command to preserve exact JSON for round-tripping.
-func (c *Client) GetAutomationRuleRaw(ruleID string) ([]byte, error) {
-	base, err := c.AutomationBaseURL()
+func (c *Client) GetAutomationRuleRaw(ctx context.Context, ruleID string) ([]byte, error) {
+	base, err := c.AutomationBaseURL(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	urlStr := fmt.Sprintf("%s/rule/%s", base, url.PathEscape(ruleID))
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get automation rule %s: %w", ruleID, err)
 	}
@@ -116,14 +115,14 @@ func (c *Client) GetAutomationRuleRaw(ruleID string) ([]byte, error) {
 // UpdateAutomationRule replaces a rule definition with the provided raw JSON.
 // The caller should have obtained the JSON via GetAutomationRuleRaw or export,
 // modified it, and passed it back here.
-func (c *Client) UpdateAutomationRule(ruleID string, ruleJSON json.RawMessage) error {
-	base, err := c.AutomationBaseURL()
+func (c *Client) UpdateAutomationRule(ctx context.Context, ruleID string, ruleJSON json.RawMessage) error {
+	base, err := c.AutomationBaseURL(ctx)
 	if err != nil {
 		return err
 	}
 
 	urlStr := fmt.Sprintf("%s/rule/%s", base, url.PathEscape(ruleID))
-	_, err = c.put(urlStr, ruleJSON)
+	_, err = c.put(ctx, urlStr, ruleJSON)
 	if err != nil {
 		return fmt.Errorf("failed to update automation rule %s: %w", ruleID, err)
 	}
@@ -134,14 +133,14 @@ func (c *Client) UpdateAutomationRule(ruleID string, ruleJSON json.RawMessage) e
 // CreateAutomationRule creates a new automation rule from raw JSON.
 // The JSON should be in the same shape as the GET response. The API
 // auto-generates new IDs; any existing 'id' or 'ruleKey' fields are ignored.
-func (c *Client) CreateAutomationRule(ruleJSON json.RawMessage) (json.RawMessage, error) {
-	base, err := c.AutomationBaseURL()
+func (c *Client) CreateAutomationRule(ctx context.Context, ruleJSON json.RawMessage) (json.RawMessage, error) {
+	base, err := c.AutomationBaseURL(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	urlStr := fmt.Sprintf("%s/rule", base)
-	body, err := c.post(urlStr, ruleJSON)
+	body, err := c.post(ctx, urlStr, ruleJSON)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create automation rule: %w", err)
 	}
@@ -150,8 +149,8 @@ func (c *Client) CreateAutomationRule(ruleJSON json.RawMessage) (json.RawMessage
 }
 
 // SetAutomationRuleState enables or disables an automation rule.
-func (c *Client) SetAutomationRuleState(ruleID string, enabled bool) error {
-	base, err := c.AutomationBaseURL()
+func (c *Client) SetAutomationRuleState(ctx context.Context, ruleID string, enabled bool) error {
+	base, err := c.AutomationBaseURL(ctx)
 	if err != nil {
 		return err
 	}
@@ -162,7 +161,7 @@ func (c *Client) SetAutomationRuleState(ruleID string, enabled bool) error {
 	}
 
 	urlStr := fmt.Sprintf("%s/rule/%s/state", base, url.PathEscape(ruleID))
-	_, err = c.put(urlStr, AutomationStateUpdate{RuleState: state})
+	_, err = c.put(ctx, urlStr, AutomationStateUpdate{RuleState: state})
 	if err != nil {
 		return fmt.Errorf("failed to set automation rule %s state to %s: %w", ruleID, state, err)
 	}
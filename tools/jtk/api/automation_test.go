@@ -1,13 +1,17 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/atlassian-go/client"
 )
 
 func newTestClientWithServer(t *testing.T, handler http.HandlerFunc) (*Client, *httptest.Server) {
@@ -34,12 +38,12 @@ func TestGetCloudID(t *testing.T) {
 		}))
 		defer server.Close()
 
-		cloudID, err := client.GetCloudID()
+		cloudID, err := client.GetCloudID(context.Background())
 		require.NoError(t, err)
 		assert.Equal(t, "abc-123-def", cloudID)
 
 		// Second call should return cached value without hitting server
-		cloudID2, err := client.GetCloudID()
+		cloudID2, err := client.GetCloudID(context.Background())
 		require.NoError(t, err)
 		assert.Equal(t, "abc-123-def", cloudID2)
 	})
@@ -51,7 +55,7 @@ func TestGetCloudID(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := client.GetCloudID()
+		_, err := client.GetCloudID(context.Background())
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "empty cloud ID")
 	})
@@ -63,7 +67,7 @@ func TestGetCloudID(t *testing.T) {
 		}))
 		defer server.Close()
 
-		_, err := client.GetCloudID()
+		_, err := client.GetCloudID(context.Background())
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to fetch cloud ID")
 	})
@@ -80,7 +84,7 @@ func TestAutomationBaseURL(t *testing.T) {
 	}))
 	defer server.Close()
 
-	baseURL, err := client.AutomationBaseURL()
+	baseURL, err := client.AutomationBaseURL(context.Background())
 	require.NoError(t, err)
 	assert.Equal(t, server.URL+"/gateway/api/automation/public/jira/my-cloud-id/rest/v1", baseURL)
 }
@@ -107,7 +111,7 @@ func TestListAutomationRules(t *testing.T) {
 	}))
 	defer server.Close()
 
-	rules, err := client.ListAutomationRules()
+	rules, err := client.ListAutomationRules(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, rules, 2)
 	assert.Equal(t, "Rule One", rules[0].Name)
@@ -138,7 +142,7 @@ func TestListAutomationRulesFiltered(t *testing.T) {
 	defer server.Close()
 
 	t.Run("filter ENABLED", func(t *testing.T) {
-		rules, err := client.ListAutomationRulesFiltered("ENABLED")
+		rules, err := client.ListAutomationRulesFiltered(context.Background(), "ENABLED")
 		require.NoError(t, err)
 		assert.Len(t, rules, 2)
 		for _, r := range rules {
@@ -167,14 +171,14 @@ func TestListAutomationRulesFiltered(t *testing.T) {
 		}))
 		defer server2.Close()
 
-		rules, err := client2.ListAutomationRulesFiltered("DISABLED")
+		rules, err := client2.ListAutomationRulesFiltered(context.Background(), "DISABLED")
 		require.NoError(t, err)
 		assert.Len(t, rules, 1)
 		assert.Equal(t, "Disabled Rule", rules[0].Name)
 	})
 
 	t.Run("no filter", func(t *testing.T) {
-		rules, err := client.ListAutomationRulesFiltered("")
+		rules, err := client.ListAutomationRulesFiltered(context.Background(), "")
 		require.NoError(t, err)
 		assert.Len(t, rules, 3)
 	})
@@ -210,7 +214,7 @@ func TestGetAutomationRule(t *testing.T) {
 	}))
 	defer server.Close()
 
-	rule, err := client.GetAutomationRule("42")
+	rule, err := client.GetAutomationRule(context.Background(), "42")
 	require.NoError(t, err)
 	assert.Equal(t, "My Automation Rule", rule.Name)
 	assert.Equal(t, "ENABLED", rule.State)
@@ -236,7 +240,7 @@ func TestGetAutomationRuleRaw(t *testing.T) {
 	}))
 	defer server.Close()
 
-	raw, err := client.GetAutomationRuleRaw("42")
+	raw, err := client.GetAutomationRuleRaw(context.Background(), "42")
 	require.NoError(t, err)
 	assert.Equal(t, expectedJSON, string(raw))
 }
@@ -263,7 +267,7 @@ func TestUpdateAutomationRule(t *testing.T) {
 	defer server.Close()
 
 	ruleJSON := json.RawMessage(`{"name":"Updated Rule","state":"ENABLED"}`)
-	err := client.UpdateAutomationRule("42", ruleJSON)
+	err := client.UpdateAutomationRule(context.Background(), "42", ruleJSON)
 	require.NoError(t, err)
 	assert.JSONEq(t, `{"name":"Updated Rule","state":"ENABLED"}`, string(receivedBody))
 }
@@ -285,7 +289,7 @@ func TestSetAutomationRuleState(t *testing.T) {
 		}))
 		defer server.Close()
 
-		err := client.SetAutomationRuleState("42", true)
+		err := client.SetAutomationRuleState(context.Background(), "42", true)
 		require.NoError(t, err)
 		assert.Equal(t, "ENABLED", receivedBody.RuleState)
 	})
@@ -306,7 +310,7 @@ func TestSetAutomationRuleState(t *testing.T) {
 		}))
 		defer server.Close()
 
-		err := client.SetAutomationRuleState("42", false)
+		err := client.SetAutomationRuleState(context.Background(), "42", false)
 		require.NoError(t, err)
 		assert.Equal(t, "DISABLED", receivedBody.RuleState)
 	})
@@ -331,7 +335,7 @@ func TestCreateAutomationRule(t *testing.T) {
 	defer server.Close()
 
 	ruleJSON := json.RawMessage(`{"name":"New Rule","state":"DISABLED"}`)
-	resp, err := client.CreateAutomationRule(ruleJSON)
+	resp, err := client.CreateAutomationRule(context.Background(), ruleJSON)
 	require.NoError(t, err)
 	assert.Equal(t, http.MethodPost, receivedMethod)
 	assert.JSONEq(t, `{"name":"New Rule","state":"DISABLED"}`, string(receivedBody))
@@ -478,7 +482,7 @@ func TestGetAutomationRuleLegacyFallback(t *testing.T) {
 		}))
 		defer server.Close()
 
-		rule, err := client.GetAutomationRule("42")
+		rule, err := client.GetAutomationRule(context.Background(), "42")
 		require.NoError(t, err)
 		assert.Equal(t, "Legacy Rule", rule.Name)
 		assert.Equal(t, "ENABLED", rule.State)
@@ -497,7 +501,7 @@ func TestGetAutomationRuleLegacyFallback(t *testing.T) {
 		}))
 		defer server.Close()
 
-		rule, err := client.GetAutomationRule("rk-99")
+		rule, err := client.GetAutomationRule(context.Background(), "rk-99")
 		require.NoError(t, err)
 		assert.Equal(t, "rk-99", rule.UUID)
 		assert.Equal(t, "rk-99", rule.RuleKey)
@@ -516,7 +520,7 @@ func TestGetAutomationRuleLegacyFallback(t *testing.T) {
 		}))
 		defer server.Close()
 
-		rule, err := client.GetAutomationRule("rk-envelope")
+		rule, err := client.GetAutomationRule(context.Background(), "rk-envelope")
 		require.NoError(t, err)
 		assert.Equal(t, "rk-envelope", rule.UUID)
 		assert.Equal(t, "Envelope RuleKey", rule.Name)
@@ -536,7 +540,7 @@ func TestListAutomationRulesLegacyShape(t *testing.T) {
 	}))
 	defer server.Close()
 
-	rules, err := client.ListAutomationRules()
+	rules, err := client.ListAutomationRules(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, rules, 2)
 	assert.Equal(t, "Old Rule 1", rules[0].Name)
@@ -574,7 +578,7 @@ func TestListAutomationRulesPagination(t *testing.T) {
 	}))
 	defer server.Close()
 
-	rules, err := client.ListAutomationRules()
+	rules, err := client.ListAutomationRules(context.Background())
 	require.NoError(t, err)
 	assert.Len(t, rules, 3)
 	assert.Equal(t, "Rule 1", rules[0].Name)
@@ -582,3 +586,42 @@ func TestListAutomationRulesPagination(t *testing.T) {
 	assert.Equal(t, "Rule 3", rules[2].Name)
 	assert.Equal(t, 2, page) // Verify two pages were fetched
 }
+
+// newReplayClient returns a client answering from a cassette recorded with
+// --record, so that tests run against real response shapes.
+func newReplayClient(t *testing.T, cassette string) (*Client, *client.Replayer) {
+	t.Helper()
+	replayer, err := client.NewReplayer(filepath.Join("testdata", cassette))
+	require.NoError(t, err)
+	c, err := New(ClientConfig{
+		URL:       "https://example.atlassian.net",
+		Email:     "user@example.com",
+		APIToken:  "token",
+		Transport: replayer,
+	})
+	require.NoError(t, err)
+	return c, replayer
+}
+
+func TestAutomationLegacyCassette(t *testing.T) {
+	c, replayer := newReplayClient(t, "automation_legacy.json")
+
+	rules, err := c.ListAutomationRules(context.Background())
+	require.NoError(t, err)
+	require.Len(t, rules, 3)
+	assert.Equal(t, "101", rules[0].Identifier())
+	assert.Equal(t, "PROJ", rules[0].Projects[0].ProjectKey)
+	assert.Equal(t, "0f1e2d3c-aaaa-bbbb-cccc-1234567890ab", rules[1].Identifier())
+	assert.Equal(t, "Sync fix versions", rules[2].Name)
+
+	rule, err := c.GetAutomationRule(context.Background(), rules[1].Identifier())
+	require.NoError(t, err)
+	assert.Equal(t, "0f1e2d3c-aaaa-bbbb-cccc-1234567890ab", rule.UUID)
+	assert.Equal(t, "DISABLED", rule.State)
+	require.NotNil(t, rule.Trigger)
+	assert.Equal(t, "jira.issue.event.trigger:created", rule.Trigger.Type)
+	require.Len(t, rule.Components, 1)
+	assert.Equal(t, "jira.issue.assign", rule.Components[0].Type)
+
+	assert.Zero(t, replayer.Remaining())
+}
@@ -1,13 +1,17 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
+
+	"github.com/open-cli-collective/atlassian-go/client"
 )
 
 // ListBoards returns boards, optionally filtered by project
-func (c *Client) ListBoards(projectKeyOrID string, startAt, maxResults int) (*BoardsResponse, error) {
+func (c *Client) ListBoards(ctx context.Context, projectKeyOrID string, startAt, maxResults int) (*BoardsResponse, error) {
 	params := map[string]string{}
 
 	if projectKeyOrID != "" {
@@ -21,7 +25,7 @@ func (c *Client) ListBoards(projectKeyOrID string, startAt, maxResults int) (*Bo
 	}
 
 	urlStr := buildURL(fmt.Sprintf("%s/board", c.AgileURL), params)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -34,10 +38,21 @@ func (c *Client) ListBoards(projectKeyOrID string, startAt, maxResults int) (*Bo
 	return &result, nil
 }
 
+// ListAllBoards returns every board, optionally filtered by project, following pagination
+func (c *Client) ListAllBoards(ctx context.Context, projectKeyOrID string) ([]Board, error) {
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Board, *client.Cursor, error) {
+		result, err := c.ListBoards(ctx, projectKeyOrID, cursor.StartAt, 50)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Values, client.NextOffset(cursor.StartAt, len(result.Values), result.Total, result.IsLast), nil
+	}), 0)
+}
+
 // GetBoard retrieves a board by ID
-func (c *Client) GetBoard(boardID int) (*Board, error) {
+func (c *Client) GetBoard(ctx context.Context, boardID int) (*Board, error) {
 	urlStr := fmt.Sprintf("%s/board/%d", c.AgileURL, boardID)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -49,3 +64,96 @@ func (c *Client) GetBoard(boardID int) (*Board, error) {
 
 	return &board, nil
 }
+
+// GetBoardConfiguration retrieves the configuration of a board
+func (c *Client) GetBoardConfiguration(ctx context.Context, boardID int) (*BoardConfiguration, error) {
+	urlStr := fmt.Sprintf("%s/board/%d/configuration", c.AgileURL, boardID)
+	body, err := c.get(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var config BoardConfiguration
+	if err := json.Unmarshal(body, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse board configuration: %w", err)
+	}
+
+	return &config, nil
+}
+
+// GetBoardBacklog returns the issues in a board's backlog, in rank order
+func (c *Client) GetBoardBacklog(ctx context.Context, boardID int, startAt, maxResults int) (*SearchResult, error) {
+	params := map[string]string{}
+
+	if startAt > 0 {
+		params["startAt"] = strconv.Itoa(startAt)
+	}
+	if maxResults > 0 {
+		params["maxResults"] = strconv.Itoa(maxResults)
+	}
+
+	urlStr := buildURL(fmt.Sprintf("%s/board/%d/backlog", c.AgileURL, boardID), params)
+	body, err := c.get(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SearchResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse backlog: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetAllBoardBacklog returns every issue in a board's backlog, following
+// pagination
+func (c *Client) GetAllBoardBacklog(ctx context.Context, boardID int) ([]Issue, error) {
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Issue, *client.Cursor, error) {
+		result, err := c.GetBoardBacklog(ctx, boardID, cursor.StartAt, 50)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Issues, client.NextOffset(cursor.StartAt, len(result.Issues), result.Total, false), nil
+	}), 0)
+}
+
+// GetBoardIssues returns issues selected by a board's filter, in rank
+// order. fields limits the fields returned; empty returns all.
+func (c *Client) GetBoardIssues(ctx context.Context, boardID int, fields []string, startAt, maxResults int) (*SearchResult, error) {
+	params := map[string]string{
+		"fields": strings.Join(fields, ","),
+	}
+
+	if startAt > 0 {
+		params["startAt"] = strconv.Itoa(startAt)
+	}
+	if maxResults > 0 {
+		params["maxResults"] = strconv.Itoa(maxResults)
+	}
+
+	urlStr := buildURL(fmt.Sprintf("%s/board/%d/issue", c.AgileURL, boardID), params)
+	body, err := c.get(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SearchResult
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse board issues: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetAllBoardIssues returns up to limit issues selected by a board's
+// filter, following pagination. A limit of zero returns every issue.
+func (c *Client) GetAllBoardIssues(ctx context.Context, boardID int, fields []string, limit int) ([]Issue, error) {
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Issue, *client.Cursor, error) {
+		result, err := c.GetBoardIssues(ctx, boardID, fields, cursor.StartAt, 50)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Issues, client.NextOffset(cursor.StartAt, len(result.Issues), result.Total, false), nil
+	}), limit)
+}
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/open-cli-collective/atlassian-go/client"
+)
+
+// GetChangelog returns a page of an issue's changelog, oldest first
+func (c *Client) GetChangelog(ctx context.Context, issueKey string, startAt, maxResults int) (*ChangelogResponse, error) {
+	if issueKey == "" {
+		return nil, ErrIssueKeyRequired
+	}
+
+	params := map[string]string{}
+	if startAt > 0 {
+		params["startAt"] = strconv.Itoa(startAt)
+	}
+	if maxResults > 0 {
+		params["maxResults"] = strconv.Itoa(maxResults)
+	}
+
+	urlStr := buildURL(fmt.Sprintf("%s/issue/%s/changelog", c.BaseURL, url.PathEscape(issueKey)), params)
+	body, err := c.get(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var result ChangelogResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse changelog: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetAllChangelog returns an issue's whole changelog, oldest first,
+// following pagination
+func (c *Client) GetAllChangelog(ctx context.Context, issueKey string) ([]ChangeHistory, error) {
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]ChangeHistory, *client.Cursor, error) {
+		result, err := c.GetChangelog(ctx, issueKey, cursor.StartAt, 100)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Values, client.NextOffset(cursor.StartAt, len(result.Values), result.Total, result.IsLast), nil
+	}), 0)
+}
+
+// CreatedTime returns when the change was made
+func (h *ChangeHistory) CreatedTime() (time.Time, error) {
+	return time.Parse(TimeFormat, h.Created)
+}
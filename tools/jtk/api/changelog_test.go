@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetAllChangelog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/changelog", r.URL.Path)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt": 0, "total": 2, "values": [{"id": "1", "created": "2026-10-01T09:30:00.000+0000", "author": {"displayName": "Jane"},
+				"items": [{"field": "status", "fieldtype": "jira", "fieldId": "status", "from": "1", "fromString": "To Do", "to": "3", "toString": "In Progress"}]}]}`)
+		case "1":
+			fmt.Fprint(w, `{"startAt": 1, "total": 2, "isLast": true, "values": [{"id": "2", "created": "2026-10-02T10:00:00.000+0000",
+				"items": [{"field": "Story Points", "fieldtype": "custom", "fieldId": "customfield_10016", "from": null, "to": "5"}]}]}`)
+		default:
+			t.Errorf("unexpected startAt %s", r.URL.Query().Get("startAt"))
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	histories, err := client.GetAllChangelog(context.Background(), "PROJ-1")
+	require.NoError(t, err)
+	require.Len(t, histories, 2)
+
+	assert.Equal(t, "Jane", histories[0].Author.DisplayName)
+	assert.Equal(t, ChangeItem{Field: "status", FieldType: "jira", FieldID: "status", From: "1", FromString: "To Do", To: "3", ToString: "In Progress"}, histories[0].Items[0])
+	assert.Equal(t, "", histories[1].Items[0].From)
+
+	created, err := histories[1].CreatedTime()
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 2, 10, 0, 0, 0, time.UTC), created.UTC())
+
+	_, err = client.GetChangelog(context.Background(), "", 0, 0)
+	assert.ErrorIs(t, err, ErrIssueKeyRequired)
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	neturl "net/url"
 	"sync"
+	"time"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
 	"github.com/open-cli-collective/atlassian-go/client"
 	"github.com/open-cli-collective/atlassian-go/errors"
 	"github.com/open-cli-collective/atlassian-go/url"
@@ -23,6 +26,9 @@ type Client struct {
 	cloudID   string
 	cloudOnce sync.Once
 	cloudErr  error
+
+	// oauth is set when requests go through the api.atlassian.com gateway
+	oauth bool
 }
 
 // ClientConfig contains configuration for creating a new client
@@ -31,35 +37,88 @@ type ClientConfig struct {
 	Email    string
 	APIToken string
 	Verbose  bool
+
+	// VerboseOut receives the --verbose request log. Defaults to os.Stderr.
+	VerboseOut io.Writer
+
+	// Timeout for each HTTP request. Defaults to client.DefaultTimeout.
+	Timeout time.Duration
+
+	// Auth overrides Basic authentication with Email and APIToken, e.g. with
+	// a bearer token or OAuth 2.0. With *auth.OAuth, requests are sent
+	// through the api.atlassian.com gateway.
+	Auth auth.Authenticator
+
+	// CloudID identifies the site on the gateway. If empty it is looked up
+	// from the site URL.
+	CloudID string
+
+	// Transport sends requests, e.g. a client.Recorder or client.Replayer.
+	// Defaults to http.DefaultTransport.
+	Transport http.RoundTripper
+
+	// TraceOut, if set, receives a JSON line per request with timing,
+	// headers and bodies (see client.TraceEvent).
+	TraceOut io.Writer
 }
 
 // New creates a new Jira API client from config
 func New(cfg ClientConfig) (*Client, error) {
+	return NewWithContext(context.Background(), cfg)
+}
+
+// NewWithContext creates a new Jira API client from config. ctx covers the
+// cloud ID lookup made when OAuth 2.0 is used without a CloudID.
+func NewWithContext(ctx context.Context, cfg ClientConfig) (*Client, error) {
 	if cfg.URL == "" {
 		return nil, errors.ErrNotFound // Use generic error for now; specific errors defined below
 	}
-	if cfg.Email == "" {
+	if cfg.Auth == nil && cfg.Email == "" {
 		return nil, ErrEmailRequired
 	}
-	if cfg.APIToken == "" {
+	if cfg.Auth == nil && cfg.APIToken == "" {
 		return nil, ErrAPITokenRequired
 	}
 
 	// Normalize URL: ensure https and no trailing slash
 	baseURL := url.NormalizeURL(cfg.URL)
 
-	// Create shared client with verbose option
-	var opts *client.Options
-	if cfg.Verbose {
-		opts = &client.Options{Verbose: true}
+	// Create shared client with verbose and auth options
+	opts := &client.Options{
+		Timeout:    cfg.Timeout,
+		Verbose:    cfg.Verbose,
+		VerboseOut: cfg.VerboseOut,
+		Auth:       cfg.Auth,
+		Transport:  cfg.Transport,
+		TraceOut:   cfg.TraceOut,
 	}
 
-	return &Client{
+	c := &Client{
 		Client:   client.New(baseURL, cfg.Email, cfg.APIToken, opts),
 		URL:      baseURL,
 		BaseURL:  baseURL + "/rest/api/3",
 		AgileURL: baseURL + "/rest/agile/1.0",
-	}, nil
+	}
+
+	// OAuth 2.0 tokens are only accepted by the gateway, which addresses
+	// the site by cloud ID
+	if _, ok := cfg.Auth.(*auth.OAuth); ok {
+		if cfg.CloudID != "" {
+			c.cloudOnce.Do(func() { c.cloudID = cfg.CloudID })
+		}
+		cloudID, err := c.GetCloudID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		gatewayURL := auth.GatewayBaseURL("jira", cloudID)
+		c.Client.BaseURL = gatewayURL
+		c.BaseURL = gatewayURL + "/rest/api/3"
+		c.AgileURL = gatewayURL + "/rest/agile/1.0"
+		c.oauth = true
+	}
+
+	return c, nil
 }
 
 // Validation errors
@@ -70,23 +129,29 @@ var (
 )
 
 // get performs a GET request to the specified URL
-func (c *Client) get(urlStr string) ([]byte, error) {
-	return c.Get(context.Background(), urlStr)
+func (c *Client) get(ctx context.Context, urlStr string) ([]byte, error) {
+	return c.Get(ctx, urlStr)
 }
 
 // post performs a POST request to the specified URL
-func (c *Client) post(urlStr string, body interface{}) ([]byte, error) {
-	return c.Post(context.Background(), urlStr, body)
+func (c *Client) post(ctx context.Context, urlStr string, body interface{}) ([]byte, error) {
+	return c.Post(ctx, urlStr, body)
+}
+
+// postIdempotent performs a POST request that is safe to retry, such as a
+// read-only search
+func (c *Client) postIdempotent(ctx context.Context, urlStr string, body interface{}) ([]byte, error) {
+	return c.Post(client.WithIdempotent(ctx), urlStr, body)
 }
 
 // put performs a PUT request to the specified URL
-func (c *Client) put(urlStr string, body interface{}) ([]byte, error) {
-	return c.Put(context.Background(), urlStr, body)
+func (c *Client) put(ctx context.Context, urlStr string, body interface{}) ([]byte, error) {
+	return c.Put(ctx, urlStr, body)
 }
 
 // delete performs a DELETE request to the specified URL
-func (c *Client) delete(urlStr string) ([]byte, error) {
-	return c.Delete(context.Background(), urlStr)
+func (c *Client) delete(ctx context.Context, urlStr string) ([]byte, error) {
+	return c.Delete(ctx, urlStr)
 }
 
 // buildURL builds a URL with query parameters
@@ -118,7 +183,11 @@ func (c *Client) GetHTTPClient() *http.Client {
 
 // GetAuthHeader returns the authorization header value.
 func (c *Client) GetAuthHeader() string {
-	return c.AuthHeader
+	req, _ := http.NewRequest(http.MethodGet, c.URL, nil)
+	if err := c.Authorize(req); err != nil {
+		return ""
+	}
+	return req.Header.Get("Authorization")
 }
 
 // tenantInfo is the response from /_edge/tenant_info
@@ -127,10 +196,11 @@ type tenantInfo struct {
 }
 
 // GetCloudID returns the Atlassian cloud ID for this site, fetching it on first call.
-func (c *Client) GetCloudID() (string, error) {
+// The tenant_info endpoint is public, so this works before the gateway is set up.
+func (c *Client) GetCloudID(ctx context.Context) (string, error) {
 	c.cloudOnce.Do(func() {
 		urlStr := fmt.Sprintf("%s/_edge/tenant_info", c.URL)
-		body, err := c.get(urlStr)
+		body, err := c.get(ctx, urlStr)
 		if err != nil {
 			c.cloudErr = fmt.Errorf("failed to fetch cloud ID from %s: %w", urlStr, err)
 			return
@@ -154,10 +224,13 @@ func (c *Client) GetCloudID() (string, error) {
 }
 
 // AutomationBaseURL returns the base URL for the Jira Automation REST API.
-func (c *Client) AutomationBaseURL() (string, error) {
-	cloudID, err := c.GetCloudID()
+func (c *Client) AutomationBaseURL(ctx context.Context) (string, error) {
+	cloudID, err := c.GetCloudID(ctx)
 	if err != nil {
 		return "", err
 	}
+	if c.oauth {
+		return fmt.Sprintf("%s/automation/public/jira/%s/rest/v1", auth.GatewayURL, cloudID), nil
+	}
 	return fmt.Sprintf("%s/gateway/api/automation/public/jira/%s/rest/v1", c.URL, cloudID), nil
 }
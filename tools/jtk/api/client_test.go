@@ -6,9 +6,12 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/atlassian-go/auth"
 )
 
 func TestNew(t *testing.T) {
@@ -108,6 +111,68 @@ func TestNew(t *testing.T) {
 	}
 }
 
+func TestNew_OAuthUsesGateway(t *testing.T) {
+	authn := auth.NewOAuth(&auth.OAuthConfig{}, &auth.Token{AccessToken: "access"})
+
+	client, err := New(ClientConfig{
+		URL:     "https://example.atlassian.net",
+		Auth:    authn,
+		CloudID: "cloud-123",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://example.atlassian.net", client.URL)
+	assert.Equal(t, "https://api.atlassian.com/ex/jira/cloud-123/rest/api/3", client.BaseURL)
+	assert.Equal(t, "https://api.atlassian.com/ex/jira/cloud-123/rest/agile/1.0", client.AgileURL)
+	assert.Equal(t, "https://example.atlassian.net/browse/PROJ-1", client.IssueURL("PROJ-1"))
+	assert.Equal(t, "Bearer access", client.GetAuthHeader())
+
+	automationURL, err := client.AutomationBaseURL(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.atlassian.com/automation/public/jira/cloud-123/rest/v1", automationURL)
+}
+
+func TestNew_OAuthLooksUpCloudID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/_edge/tenant_info", r.URL.Path)
+		_, _ = w.Write([]byte(`{"cloudId": "cloud-456"}`))
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{
+		URL:  server.URL,
+		Auth: auth.NewOAuth(&auth.OAuthConfig{}, &auth.Token{AccessToken: "access"}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.atlassian.com/ex/jira/cloud-456/rest/api/3", client.BaseURL)
+}
+
+func TestNewWithContext_CancelsCloudIDLookup(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := NewWithContext(ctx, ClientConfig{
+		URL:  server.URL,
+		Auth: auth.NewOAuth(&auth.OAuthConfig{}, &auth.Token{AccessToken: "access"}),
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestNew_BearerNeedsNoEmail(t *testing.T) {
+	client, err := New(ClientConfig{
+		URL:  "https://jira.example.com",
+		Auth: &auth.Bearer{Token: "pat"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://jira.example.com/rest/api/3", client.BaseURL)
+	assert.Equal(t, "Bearer pat", client.GetAuthHeader())
+}
+
 func TestClient_get(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -161,7 +226,7 @@ func TestClient_get(t *testing.T) {
 			})
 			require.NoError(t, err)
 
-			body, err := client.get(server.URL + "/test")
+			body, err := client.get(context.Background(), server.URL+"/test")
 
 			if tt.wantErr {
 				assert.Error(t, err)
@@ -198,7 +263,7 @@ func TestClient_post_withBody(t *testing.T) {
 		},
 	}
 
-	_, err = client.post(server.URL+"/test", requestBody)
+	_, err = client.post(context.Background(), server.URL+"/test", requestBody)
 	require.NoError(t, err)
 
 	assert.Equal(t, "Test issue", receivedBody["summary"])
@@ -309,3 +374,40 @@ func TestClient_VerboseMode(t *testing.T) {
 	_, err = client.Get(context.Background(), "/test")
 	require.NoError(t, err)
 }
+
+func TestClient_ContextAndTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client, err := New(ClientConfig{
+		URL:      server.URL,
+		Email:    "user@example.com",
+		APIToken: "token",
+		Timeout:  50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 50*time.Millisecond, client.HTTPClient.Timeout)
+
+	t.Run("cancelled context stops the request", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := client.GetIssue(ctx, "PROJ-1")
+		require.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("timeout applies to each request", func(t *testing.T) {
+		client.Retry = nil
+		start := time.Now()
+		_, err := client.GetIssue(context.Background(), "PROJ-1")
+		require.Error(t, err)
+		assert.Less(t, time.Since(start), 5*time.Second)
+	})
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FieldColumn is a --columns entry that shows an issue field, such as a
+// custom field, rather than one of a command's built-in columns.
+type FieldColumn struct {
+	Name    string // the column as given, used as its header
+	FieldID string
+}
+
+// ResolveFieldColumns resolves the columns that are not among headers
+// (compared case-insensitively) to issue fields, by ID or name. Fields are
+// only fetched if there are such columns.
+func (c *Client) ResolveFieldColumns(ctx context.Context, columns, headers []string) ([]FieldColumn, error) {
+	var names []string
+	for _, col := range columns {
+		col = strings.TrimSpace(col)
+		builtin := false
+		for _, h := range headers {
+			if strings.EqualFold(col, h) {
+				builtin = true
+				break
+			}
+		}
+		if !builtin {
+			names = append(names, col)
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	fields, err := c.GetFields(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]FieldColumn, 0, len(names))
+	for _, name := range names {
+		id, err := ResolveFieldID(fields, name)
+		if err != nil {
+			return nil, fmt.Errorf("unknown column %q: not a column or field name (available columns: %s)", name, strings.Join(headers, ", "))
+		}
+		result = append(result, FieldColumn{Name: name, FieldID: id})
+	}
+	return result, nil
+}
+
+// FieldColumnIDs returns the field IDs of columns, to request them in a
+// search.
+func FieldColumnIDs(columns []FieldColumn) []string {
+	ids := make([]string, len(columns))
+	for i, col := range columns {
+		ids[i] = col.FieldID
+	}
+	return ids
+}
+
+// FieldColumnValues returns the display text of each column's field for an
+// issue.
+func FieldColumnValues(issue Issue, columns []FieldColumn) []string {
+	if len(columns) == 0 {
+		return nil
+	}
+
+	// Round-trip the fields to reach typed and custom fields alike by ID
+	var fields map[string]interface{}
+	if data, err := json.Marshal(issue.Fields); err == nil {
+		_ = json.Unmarshal(data, &fields)
+	}
+
+	values := make([]string, len(columns))
+	for i, col := range columns {
+		values[i] = FieldValueText(fields[col.FieldID])
+	}
+	return values
+}
+
+// FieldValueText formats a decoded field value for display: the name of an
+// option, user or other object, list elements joined with commas, and the
+// plain text of rich text (ADF) fields.
+func FieldValueText(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case []interface{}:
+		parts := make([]string, 0, len(v))
+		for _, item := range v {
+			parts = append(parts, FieldValueText(item))
+		}
+		return strings.Join(parts, ", ")
+	case map[string]interface{}:
+		if v["type"] == "doc" {
+			data, _ := json.Marshal(v)
+			var doc ADFDocument
+			if err := json.Unmarshal(data, &doc); err == nil {
+				return strings.TrimSpace(doc.ToPlainText())
+			}
+		}
+		for _, key := range []string{"displayName", "name", "value", "key"} {
+			if s, ok := v[key].(string); ok {
+				return s
+			}
+		}
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldValueText(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{"nil", nil, ""},
+		{"string", "text", "text"},
+		{"number", 3.5, "3.5"},
+		{"whole number", float64(8), "8"},
+		{"option", map[string]interface{}{"value": "High", "id": "1"}, "High"},
+		{"user", map[string]interface{}{"displayName": "Jane Doe", "accountId": "abc"}, "Jane Doe"},
+		{"multi-select", []interface{}{map[string]interface{}{"value": "A"}, map[string]interface{}{"value": "B"}}, "A, B"},
+		{"unknown object", map[string]interface{}{"id": "1"}, `{"id":"1"}`},
+		{"adf", map[string]interface{}{
+			"type":    "doc",
+			"version": 1,
+			"content": []interface{}{map[string]interface{}{
+				"type":    "paragraph",
+				"content": []interface{}{map[string]interface{}{"type": "text", "text": "Rich text"}},
+			}},
+		}, "Rich text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FieldValueText(tt.value))
+		})
+	}
+}
+
+func TestFieldColumnValues(t *testing.T) {
+	issue := Issue{
+		Key: "PROJ-1",
+		Fields: IssueFields{
+			Priority:     &Priority{Name: "High"},
+			CustomFields: map[string]interface{}{"customfield_10016": float64(5)},
+		},
+	}
+	columns := []FieldColumn{
+		{Name: "Story Points", FieldID: "customfield_10016"},
+		{Name: "Priority", FieldID: "priority"},
+		{Name: "Missing", FieldID: "customfield_99999"},
+	}
+
+	assert.Equal(t, []string{"5", "High", ""}, FieldColumnValues(issue, columns))
+	assert.Equal(t, []string{"customfield_10016", "priority", "customfield_99999"}, FieldColumnIDs(columns))
+}
@@ -1,14 +1,17 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
+
+	"github.com/open-cli-collective/atlassian-go/client"
 )
 
 // GetComments returns comments for an issue
-func (c *Client) GetComments(issueKey string, startAt, maxResults int) (*CommentsResponse, error) {
+func (c *Client) GetComments(ctx context.Context, issueKey string, startAt, maxResults int) (*CommentsResponse, error) {
 	if issueKey == "" {
 		return nil, ErrIssueKeyRequired
 	}
@@ -22,7 +25,7 @@ func (c *Client) GetComments(issueKey string, startAt, maxResults int) (*Comment
 	}
 
 	urlStr := buildURL(fmt.Sprintf("%s/issue/%s/comment", c.BaseURL, url.PathEscape(issueKey)), params)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -35,8 +38,19 @@ func (c *Client) GetComments(issueKey string, startAt, maxResults int) (*Comment
 	return &result, nil
 }
 
+// GetAllComments returns every comment on an issue, following pagination
+func (c *Client) GetAllComments(ctx context.Context, issueKey string) ([]Comment, error) {
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Comment, *client.Cursor, error) {
+		result, err := c.GetComments(ctx, issueKey, cursor.StartAt, 100)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Comments, client.NextOffset(cursor.StartAt, len(result.Comments), result.Total, false), nil
+	}), 0)
+}
+
 // AddComment adds a comment to an issue
-func (c *Client) AddComment(issueKey, commentBody string) (*Comment, error) {
+func (c *Client) AddComment(ctx context.Context, issueKey, commentBody string) (*Comment, error) {
 	if issueKey == "" {
 		return nil, ErrIssueKeyRequired
 	}
@@ -46,7 +60,7 @@ func (c *Client) AddComment(issueKey, commentBody string) (*Comment, error) {
 		Body: NewADFDocument(commentBody),
 	}
 
-	body, err := c.post(urlStr, req)
+	body, err := c.post(ctx, urlStr, req)
 	if err != nil {
 		return nil, err
 	}
@@ -60,7 +74,7 @@ func (c *Client) AddComment(issueKey, commentBody string) (*Comment, error) {
 }
 
 // DeleteComment deletes a comment from an issue
-func (c *Client) DeleteComment(issueKey, commentID string) error {
+func (c *Client) DeleteComment(ctx context.Context, issueKey, commentID string) error {
 	if issueKey == "" {
 		return ErrIssueKeyRequired
 	}
@@ -69,6 +83,6 @@ func (c *Client) DeleteComment(issueKey, commentID string) error {
 	}
 
 	urlStr := fmt.Sprintf("%s/issue/%s/comment/%s", c.BaseURL, url.PathEscape(issueKey), url.PathEscape(commentID))
-	_, err := c.delete(urlStr)
+	_, err := c.delete(ctx, urlStr)
 	return err
 }
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/open-cli-collective/atlassian-go/client"
+)
+
+// CreateMetaIssueType is an issue type that can be created in a project
+type CreateMetaIssueType struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Subtask bool   `json:"subtask"`
+}
+
+// CreateMetaField describes a field that can be set when creating an issue
+// of a given type
+type CreateMetaField struct {
+	FieldID         string        `json:"fieldId"`
+	Key             string        `json:"key,omitempty"`
+	Name            string        `json:"name"`
+	Required        bool          `json:"required"`
+	HasDefaultValue bool          `json:"hasDefaultValue"`
+	Schema          FieldSchema   `json:"schema"`
+	AllowedValues   []FieldOption `json:"allowedValues,omitempty"`
+}
+
+type createMetaIssueTypesPage struct {
+	StartAt    int                   `json:"startAt"`
+	Total      int                   `json:"total"`
+	IssueTypes []CreateMetaIssueType `json:"issueTypes"`
+}
+
+type createMetaFieldsPage struct {
+	StartAt int               `json:"startAt"`
+	Total   int               `json:"total"`
+	Fields  []CreateMetaField `json:"fields"`
+}
+
+// GetCreateMetaIssueTypes returns the issue types the current user can
+// create in a project
+func (c *Client) GetCreateMetaIssueTypes(ctx context.Context, projectKey string) ([]CreateMetaIssueType, error) {
+	if projectKey == "" {
+		return nil, ErrProjectKeyRequired
+	}
+
+	base := fmt.Sprintf("%s/issue/createmeta/%s/issuetypes", c.BaseURL, url.PathEscape(projectKey))
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]CreateMetaIssueType, *client.Cursor, error) {
+		body, err := c.get(ctx, buildURL(base, map[string]string{"startAt": strconv.Itoa(cursor.StartAt), "maxResults": "200"}))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var page createMetaIssueTypesPage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse create metadata: %w", err)
+		}
+		return page.IssueTypes, client.NextOffset(cursor.StartAt, len(page.IssueTypes), page.Total, false), nil
+	}), 0)
+}
+
+// GetCreateMetaFields returns the fields that can be set when creating an
+// issue of the given type in a project
+func (c *Client) GetCreateMetaFields(ctx context.Context, projectKey, issueTypeID string) ([]CreateMetaField, error) {
+	if projectKey == "" {
+		return nil, ErrProjectKeyRequired
+	}
+	if issueTypeID == "" {
+		return nil, fmt.Errorf("issue type ID is required")
+	}
+
+	base := fmt.Sprintf("%s/issue/createmeta/%s/issuetypes/%s", c.BaseURL, url.PathEscape(projectKey), url.PathEscape(issueTypeID))
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]CreateMetaField, *client.Cursor, error) {
+		body, err := c.get(ctx, buildURL(base, map[string]string{"startAt": strconv.Itoa(cursor.StartAt), "maxResults": "200"}))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var page createMetaFieldsPage
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, nil, fmt.Errorf("failed to parse create metadata: %w", err)
+		}
+		return page.Fields, client.NextOffset(cursor.StartAt, len(page.Fields), page.Total, false), nil
+	}), 0)
+}
+
+// FindCreateMetaIssueType finds an issue type by name (case-insensitive)
+// or ID
+func FindCreateMetaIssueType(types []CreateMetaIssueType, nameOrID string) *CreateMetaIssueType {
+	for i := range types {
+		if types[i].ID == nameOrID || strings.EqualFold(types[i].Name, nameOrID) {
+			return &types[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateMeta(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/issue/createmeta/PROJ/issuetypes":
+			w.Write([]byte(`{"startAt": 0, "total": 2, "issueTypes": [{"id": "1", "name": "Task"}, {"id": "2", "name": "Sub-task", "subtask": true}]}`))
+		case "/rest/api/3/issue/createmeta/PROJ/issuetypes/1":
+			w.Write([]byte(`{"startAt": 0, "total": 2, "fields": [
+				{"fieldId": "summary", "name": "Summary", "required": true},
+				{"fieldId": "priority", "name": "Priority", "hasDefaultValue": true, "allowedValues": [{"id": "3", "name": "Medium"}]}
+			]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	types, err := client.GetCreateMetaIssueTypes(context.Background(), "PROJ")
+	require.NoError(t, err)
+	require.Len(t, types, 2)
+	assert.True(t, types[1].Subtask)
+	assert.Equal(t, "1", FindCreateMetaIssueType(types, "task").ID)
+	assert.Equal(t, "Sub-task", FindCreateMetaIssueType(types, "2").Name)
+	assert.Nil(t, FindCreateMetaIssueType(types, "Bug"))
+
+	fields, err := client.GetCreateMetaFields(context.Background(), "PROJ", "1")
+	require.NoError(t, err)
+	require.Len(t, fields, 2)
+	assert.True(t, fields[0].Required)
+	assert.Equal(t, "Medium", fields[1].AllowedValues[0].Name)
+
+	_, err = client.GetCreateMetaIssueTypes(context.Background(), "")
+	assert.ErrorIs(t, err, ErrProjectKeyRequired)
+}
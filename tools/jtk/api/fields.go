@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -8,9 +9,9 @@ import (
 )
 
 // GetFields returns all field definitions
-func (c *Client) GetFields() ([]Field, error) {
+func (c *Client) GetFields(ctx context.Context) ([]Field, error) {
 	urlStr := fmt.Sprintf("%s/field", c.BaseURL)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -24,8 +25,8 @@ func (c *Client) GetFields() ([]Field, error) {
 }
 
 // GetCustomFields returns only custom field definitions
-func (c *Client) GetCustomFields() ([]Field, error) {
-	fields, err := c.GetFields()
+func (c *Client) GetCustomFields(ctx context.Context) ([]Field, error) {
+	fields, err := c.GetFields(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -81,18 +82,25 @@ func ResolveFieldID(fields []Field, nameOrID string) (string, error) {
 //   - option fields: wraps value as {"value": "..."}
 //   - array fields: wraps value as [{"value": "..."}] or []string{...}
 //   - user fields: wraps value as {"accountId": "..."}
+//   - priority, resolution, component and version fields: wraps value as {"name": "..."}
+//   - the parent field: wraps value as {"key": "..."}
 //   - number fields: converts string to float64
-//   - textarea custom fields: converts to ADF document
+//   - description, environment and textarea custom fields: converts to ADF document
 func FormatFieldValue(field *Field, value string) interface{} {
 	if field == nil {
 		return value
 	}
 
-	// Check for textarea custom fields that require ADF format
-	if field.Schema.Custom == "com.atlassian.jira.plugin.system.customfieldtypes:textarea" {
+	// Check for rich text fields that require ADF format
+	if field.Schema.Custom == "com.atlassian.jira.plugin.system.customfieldtypes:textarea" ||
+		field.Schema.System == "description" || field.Schema.System == "environment" {
 		return NewADFDocument(value)
 	}
 
+	if field.ID == "parent" {
+		return map[string]string{"key": value}
+	}
+
 	// Handle different field types
 	switch field.Schema.Type {
 	case "option":
@@ -103,11 +111,16 @@ func FormatFieldValue(field *Field, value string) interface{} {
 		if field.Schema.Items == "option" {
 			return []map[string]string{{"value": value}}
 		}
+		if field.Schema.Items == "component" || field.Schema.Items == "version" {
+			return []map[string]string{{"name": value}}
+		}
 		// Other arrays (like labels) are just string arrays
 		return []string{value}
 	case "user":
 		// User fields need {"accountId": "..."} format
 		return map[string]string{"accountId": value}
+	case "priority", "resolution", "version":
+		return map[string]string{"name": value}
 	case "number":
 		// Number fields need to be sent as JSON numbers, not strings
 		if n, err := strconv.ParseFloat(value, 64); err == nil {
@@ -119,6 +132,35 @@ func FormatFieldValue(field *Field, value string) interface{} {
 	}
 }
 
+// FormatFieldValues formats one or more values for a field. Values of an
+// array field are combined into one array; other fields take a single value.
+func FormatFieldValues(field *Field, values []string) (interface{}, error) {
+	if len(values) == 0 {
+		return nil, fmt.Errorf("no value given")
+	}
+	if field == nil || field.Schema.Type != "array" {
+		if len(values) > 1 {
+			return nil, fmt.Errorf("only one value is allowed, got %d", len(values))
+		}
+		return FormatFieldValue(field, values[0]), nil
+	}
+
+	var strs []string
+	var maps []map[string]string
+	for _, value := range values {
+		switch formatted := FormatFieldValue(field, value).(type) {
+		case []string:
+			strs = append(strs, formatted...)
+		case []map[string]string:
+			maps = append(maps, formatted...)
+		}
+	}
+	if maps != nil {
+		return maps, nil
+	}
+	return strs, nil
+}
+
 // FieldOptionsResponse represents the response from field options endpoint
 type FieldOptionsResponse struct {
 	Options []FieldOptionValue `json:"values"`
@@ -134,18 +176,18 @@ type FieldOptionValue struct {
 }
 
 // GetFieldOptions returns allowed values for a custom field
-func (c *Client) GetFieldOptions(fieldID string) ([]FieldOptionValue, error) {
+func (c *Client) GetFieldOptions(ctx context.Context, fieldID string) ([]FieldOptionValue, error) {
 	if fieldID == "" {
 		return nil, fmt.Errorf("field ID is required")
 	}
 
 	// Use the field context options endpoint for custom fields
 	urlStr := fmt.Sprintf("%s/field/%s/context/defaultValue", c.BaseURL, fieldID)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		// If the default endpoint fails, try the options endpoint directly
 		urlStr = fmt.Sprintf("%s/field/%s/option", c.BaseURL, fieldID)
-		body, err = c.get(urlStr)
+		body, err = c.get(ctx, urlStr)
 		if err != nil {
 			return nil, err
 		}
@@ -165,8 +207,8 @@ func (c *Client) GetFieldOptions(fieldID string) ([]FieldOptionValue, error) {
 }
 
 // GetFieldOptionsFromEditMeta returns allowed values for a field from issue edit metadata
-func (c *Client) GetFieldOptionsFromEditMeta(issueKey, fieldID string) ([]FieldOptionValue, error) {
-	meta, err := c.GetIssueEditMeta(issueKey)
+func (c *Client) GetFieldOptionsFromEditMeta(ctx context.Context, issueKey, fieldID string) ([]FieldOptionValue, error) {
+	meta, err := c.GetIssueEditMeta(ctx, issueKey)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -277,6 +278,24 @@ func TestFormatFieldValue(t *testing.T) {
 			value: "not-a-number",
 			want:  "not-a-number",
 		},
+		{
+			name:  "priority field - wraps in name map",
+			field: &Field{ID: "priority", Name: "Priority", Schema: FieldSchema{Type: "priority", System: "priority"}},
+			value: "High",
+			want:  map[string]string{"name": "High"},
+		},
+		{
+			name:  "array of components - wraps in array of name maps",
+			field: &Field{ID: "components", Name: "Components", Schema: FieldSchema{Type: "array", Items: "component"}},
+			value: "Backend",
+			want:  []map[string]string{{"name": "Backend"}},
+		},
+		{
+			name:  "parent field - wraps in key map",
+			field: &Field{ID: "parent", Name: "Parent", Schema: FieldSchema{Type: "issuelink"}},
+			value: "PROJ-1",
+			want:  map[string]string{"key": "PROJ-1"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -346,7 +365,7 @@ func TestClient_GetFieldOptionsFromEditMeta(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("priority field with name values", func(t *testing.T) {
-		options, err := client.GetFieldOptionsFromEditMeta("PROJ-123", "priority")
+		options, err := client.GetFieldOptionsFromEditMeta(context.Background(), "PROJ-123", "priority")
 		require.NoError(t, err)
 		assert.Len(t, options, 5)
 		assert.Equal(t, "1", options[0].ID)
@@ -354,7 +373,7 @@ func TestClient_GetFieldOptionsFromEditMeta(t *testing.T) {
 	})
 
 	t.Run("custom field with value format", func(t *testing.T) {
-		options, err := client.GetFieldOptionsFromEditMeta("PROJ-123", "customfield_10001")
+		options, err := client.GetFieldOptionsFromEditMeta(context.Background(), "PROJ-123", "customfield_10001")
 		require.NoError(t, err)
 		assert.Len(t, options, 3)
 		assert.Equal(t, "Feature", options[0].Value)
@@ -363,8 +382,31 @@ func TestClient_GetFieldOptionsFromEditMeta(t *testing.T) {
 	})
 
 	t.Run("field not found", func(t *testing.T) {
-		_, err := client.GetFieldOptionsFromEditMeta("PROJ-123", "nonexistent")
+		_, err := client.GetFieldOptionsFromEditMeta(context.Background(), "PROJ-123", "nonexistent")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "not found")
 	})
 }
+
+func TestFormatFieldValues(t *testing.T) {
+	labels := &Field{ID: "labels", Schema: FieldSchema{Type: "array", Items: "string"}}
+	got, err := FormatFieldValues(labels, []string{"a", "b"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, got)
+
+	components := &Field{ID: "components", Schema: FieldSchema{Type: "array", Items: "component"}}
+	got, err = FormatFieldValues(components, []string{"API", "UI"})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]string{{"name": "API"}, {"name": "UI"}}, got)
+
+	priority := &Field{ID: "priority", Schema: FieldSchema{Type: "priority"}}
+	got, err = FormatFieldValues(priority, []string{"High"})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"name": "High"}, got)
+
+	_, err = FormatFieldValues(priority, []string{"High", "Low"})
+	assert.ErrorContains(t, err, "only one value is allowed")
+
+	_, err = FormatFieldValues(labels, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// GetFilter retrieves a saved filter by ID
+func (c *Client) GetFilter(ctx context.Context, filterID string) (*Filter, error) {
+	if filterID == "" {
+		return nil, fmt.Errorf("filter ID is required")
+	}
+
+	urlStr := fmt.Sprintf("%s/filter/%s", c.BaseURL, url.PathEscape(filterID))
+	body, err := c.get(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var filter Filter
+	if err := json.Unmarshal(body, &filter); err != nil {
+		return nil, fmt.Errorf("failed to parse filter: %w", err)
+	}
+
+	return &filter, nil
+}
@@ -1,19 +1,23 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"sort"
+	"strings"
 )
 
 // GetIssue retrieves an issue by key
-func (c *Client) GetIssue(issueKey string) (*Issue, error) {
+func (c *Client) GetIssue(ctx context.Context, issueKey string) (*Issue, error) {
 	if issueKey == "" {
 		return nil, ErrIssueKeyRequired
 	}
 
 	urlStr := fmt.Sprintf("%s/issue/%s", c.BaseURL, url.PathEscape(issueKey))
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -27,9 +31,9 @@ func (c *Client) GetIssue(issueKey string) (*Issue, error) {
 }
 
 // CreateIssue creates a new issue
-func (c *Client) CreateIssue(req *CreateIssueRequest) (*Issue, error) {
+func (c *Client) CreateIssue(ctx context.Context, req *CreateIssueRequest) (*Issue, error) {
 	urlStr := fmt.Sprintf("%s/issue", c.BaseURL)
-	body, err := c.post(urlStr, req)
+	body, err := c.post(ctx, urlStr, req)
 	if err != nil {
 		return nil, err
 	}
@@ -43,29 +47,29 @@ func (c *Client) CreateIssue(req *CreateIssueRequest) (*Issue, error) {
 }
 
 // UpdateIssue updates an existing issue
-func (c *Client) UpdateIssue(issueKey string, req *UpdateIssueRequest) error {
+func (c *Client) UpdateIssue(ctx context.Context, issueKey string, req *UpdateIssueRequest) error {
 	if issueKey == "" {
 		return ErrIssueKeyRequired
 	}
 
 	urlStr := fmt.Sprintf("%s/issue/%s", c.BaseURL, url.PathEscape(issueKey))
-	_, err := c.put(urlStr, req)
+	_, err := c.put(ctx, urlStr, req)
 	return err
 }
 
 // DeleteIssue deletes an issue
-func (c *Client) DeleteIssue(issueKey string) error {
+func (c *Client) DeleteIssue(ctx context.Context, issueKey string) error {
 	if issueKey == "" {
 		return ErrIssueKeyRequired
 	}
 
 	urlStr := fmt.Sprintf("%s/issue/%s", c.BaseURL, url.PathEscape(issueKey))
-	_, err := c.delete(urlStr)
+	_, err := c.delete(ctx, urlStr)
 	return err
 }
 
 // AssignIssue assigns an issue to a user
-func (c *Client) AssignIssue(issueKey, accountID string) error {
+func (c *Client) AssignIssue(ctx context.Context, issueKey, accountID string) error {
 	if issueKey == "" {
 		return ErrIssueKeyRequired
 	}
@@ -80,18 +84,18 @@ func (c *Client) AssignIssue(issueKey, accountID string) error {
 		body["accountId"] = nil
 	}
 
-	_, err := c.put(urlStr, body)
+	_, err := c.put(ctx, urlStr, body)
 	return err
 }
 
 // GetIssueEditMeta returns the edit metadata for an issue
-func (c *Client) GetIssueEditMeta(issueKey string) (map[string]interface{}, error) {
+func (c *Client) GetIssueEditMeta(ctx context.Context, issueKey string) (map[string]interface{}, error) {
 	if issueKey == "" {
 		return nil, ErrIssueKeyRequired
 	}
 
 	urlStr := fmt.Sprintf("%s/issue/%s/editmeta", c.BaseURL, url.PathEscape(issueKey))
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -127,3 +131,82 @@ func BuildCreateRequest(projectKey, issueType, summary, description string, extr
 func BuildUpdateRequest(fields map[string]interface{}) *UpdateIssueRequest {
 	return &UpdateIssueRequest{Fields: fields}
 }
+
+// BulkCreateLimit is the most issues Jira creates in one bulk request
+const BulkCreateLimit = 50
+
+// BulkCreateResult is the outcome of creating one issue in a bulk request:
+// either the created issue or the reason it failed
+type BulkCreateResult struct {
+	Issue *Issue
+	Err   error
+}
+
+// BulkCreateIssues creates up to BulkCreateLimit issues in one request and
+// returns a result for each request, in order. Jira creates the issues it
+// can, so some may fail while others succeed.
+func (c *Client) BulkCreateIssues(ctx context.Context, reqs []*CreateIssueRequest) ([]BulkCreateResult, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+	if len(reqs) > BulkCreateLimit {
+		return nil, fmt.Errorf("cannot create more than %d issues in one request, got %d", BulkCreateLimit, len(reqs))
+	}
+
+	urlStr := fmt.Sprintf("%s/issue/bulk", c.BaseURL)
+	body, err := c.post(ctx, urlStr, BulkCreateRequest{IssueUpdates: reqs})
+
+	var resp BulkCreateResponse
+	if err != nil {
+		// When every issue fails, Jira responds 400 with the usual body
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || json.Unmarshal(apiErr.Body, &resp) != nil || len(resp.Errors) == 0 {
+			return nil, err
+		}
+	} else if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("failed to parse bulk create response: %w", err)
+	}
+
+	results := make([]BulkCreateResult, len(reqs))
+	failed := make(map[int]bool)
+	for _, f := range resp.Errors {
+		if f.FailedElementNumber >= 0 && f.FailedElementNumber < len(reqs) {
+			results[f.FailedElementNumber].Err = f.ElementErrors
+			failed[f.FailedElementNumber] = true
+		}
+	}
+
+	created := resp.Issues
+	for i := range results {
+		if failed[i] {
+			continue
+		}
+		if len(created) == 0 {
+			results[i].Err = fmt.Errorf("issue was not created")
+			continue
+		}
+		issue := created[0]
+		results[i].Issue = &issue
+		created = created[1:]
+	}
+
+	return results, nil
+}
+
+// Error returns the element errors as one message, with field errors in
+// field order
+func (e BulkElementErrors) Error() string {
+	parts := append([]string{}, e.ErrorMessages...)
+	fields := make([]string, 0, len(e.Errors))
+	for field := range e.Errors {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	for _, field := range fields {
+		parts = append(parts, fmt.Sprintf("%s: %s", field, e.Errors[field]))
+	}
+	if len(parts) == 0 {
+		return "issue was not created"
+	}
+	return strings.Join(parts, "; ")
+}
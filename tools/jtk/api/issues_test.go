@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_BulkCreateIssues(t *testing.T) {
+	var got BulkCreateRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/rest/api/3/issue/bulk", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{
+			"issues": [{"id": "10", "key": "PROJ-10"}, {"id": "11", "key": "PROJ-11"}],
+			"errors": [{"status": 400, "failedElementNumber": 1, "elementErrors": {"errors": {"summary": "Summary is required"}}}]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	reqs := []*CreateIssueRequest{
+		{Fields: map[string]interface{}{"summary": "One"}},
+		{Fields: map[string]interface{}{}},
+		{Fields: map[string]interface{}{"summary": "Three"}},
+	}
+	results, err := client.BulkCreateIssues(context.Background(), reqs)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Len(t, got.IssueUpdates, 3)
+
+	assert.Equal(t, "PROJ-10", results[0].Issue.Key)
+	assert.Nil(t, results[1].Issue)
+	assert.EqualError(t, results[1].Err, "summary: Summary is required")
+	assert.Equal(t, "PROJ-11", results[2].Issue.Key)
+}
+
+func TestClient_BulkCreateIssues_AllFailed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{
+			"issues": [],
+			"errors": [
+				{"status": 400, "failedElementNumber": 0, "elementErrors": {"errorMessages": ["Project is archived"]}},
+				{"status": 400, "failedElementNumber": 1, "elementErrors": {"errorMessages": ["Project is archived"]}}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	results, err := client.BulkCreateIssues(context.Background(), []*CreateIssueRequest{{}, {}})
+	require.NoError(t, err)
+	for _, r := range results {
+		assert.EqualError(t, r.Err, "Project is archived")
+	}
+}
+
+func TestClient_BulkCreateIssues_TooMany(t *testing.T) {
+	client, err := New(ClientConfig{URL: "http://localhost", Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	_, err = client.BulkCreateIssues(context.Background(), make([]*CreateIssueRequest, BulkCreateLimit+1))
+	assert.Error(t, err)
+}
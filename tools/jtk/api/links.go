@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// GetIssueLinkTypes returns the issue link types configured on the site
+func (c *Client) GetIssueLinkTypes(ctx context.Context) ([]IssueLinkType, error) {
+	urlStr := fmt.Sprintf("%s/issueLinkType", c.BaseURL)
+	body, err := c.get(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var result IssueLinkTypesResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse issue link types: %w", err)
+	}
+
+	return result.IssueLinkTypes, nil
+}
+
+// GetIssueLinks returns the links of an issue
+func (c *Client) GetIssueLinks(ctx context.Context, issueKey string) ([]IssueLink, error) {
+	if issueKey == "" {
+		return nil, ErrIssueKeyRequired
+	}
+
+	urlStr := buildURL(fmt.Sprintf("%s/issue/%s", c.BaseURL, url.PathEscape(issueKey)), map[string]string{
+		"fields": "issuelinks",
+	})
+	body, err := c.get(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var issue Issue
+	if err := json.Unmarshal(body, &issue); err != nil {
+		return nil, fmt.Errorf("failed to parse issue: %w", err)
+	}
+
+	return issue.Fields.IssueLinks, nil
+}
+
+// CreateIssueLink links two issues so that the link reads
+// "<inwardKey> <outward description> <outwardKey>", for example
+// "PROJ-1 blocks PROJ-2" with the Blocks type
+func (c *Client) CreateIssueLink(ctx context.Context, typeName, inwardKey, outwardKey string) error {
+	if inwardKey == "" || outwardKey == "" {
+		return ErrIssueKeyRequired
+	}
+	if typeName == "" {
+		return fmt.Errorf("link type is required")
+	}
+
+	urlStr := fmt.Sprintf("%s/issueLink", c.BaseURL)
+	req := CreateIssueLinkRequest{
+		Type:         IssueLinkType{Name: typeName},
+		InwardIssue:  IssueKeyRef{Key: inwardKey},
+		OutwardIssue: IssueKeyRef{Key: outwardKey},
+	}
+
+	_, err := c.post(ctx, urlStr, req)
+	return err
+}
+
+// DeleteIssueLink deletes an issue link
+func (c *Client) DeleteIssueLink(ctx context.Context, linkID string) error {
+	if linkID == "" {
+		return fmt.Errorf("link ID is required")
+	}
+
+	urlStr := fmt.Sprintf("%s/issueLink/%s", c.BaseURL, url.PathEscape(linkID))
+	_, err := c.delete(ctx, urlStr)
+	return err
+}
+
+// FindLinkTypeByName finds a link type by its name or by the description of
+// either direction (case-insensitive). inward reports whether name matched
+// the inward description, as "is blocked by" does for Blocks, in which case
+// the issues must be swapped to read the link the way it was written.
+func FindLinkTypeByName(types []IssueLinkType, name string) (linkType *IssueLinkType, inward bool) {
+	if name == "" {
+		return nil, false
+	}
+	nameLower := strings.ToLower(name)
+	for i := range types {
+		if strings.ToLower(types[i].Name) == nameLower || strings.ToLower(types[i].Outward) == nameLower {
+			return &types[i], false
+		}
+	}
+	for i := range types {
+		if strings.ToLower(types[i].Inward) == nameLower {
+			return &types[i], true
+		}
+	}
+	return nil, false
+}
+
+// Describe returns the link as seen from the issue whose fields it was read
+// from: the description of its direction and the other issue
+func (l *IssueLink) Describe() (string, *Issue) {
+	if l.OutwardIssue != nil {
+		return l.Type.Outward, l.OutwardIssue
+	}
+	return l.Type.Inward, l.InwardIssue
+}
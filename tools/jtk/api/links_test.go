@@ -0,0 +1,127 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindLinkTypeByName(t *testing.T) {
+	types := []IssueLinkType{
+		{ID: "1", Name: "Blocks", Inward: "is blocked by", Outward: "blocks"},
+		{ID: "2", Name: "Duplicate", Inward: "is duplicated by", Outward: "duplicates"},
+		{ID: "3", Name: "Relates", Inward: "relates to", Outward: "relates to"},
+	}
+
+	tests := []struct {
+		name       string
+		searchName string
+		wantID     string
+		wantInward bool
+		wantNil    bool
+	}{
+		{name: "type name", searchName: "Blocks", wantID: "1"},
+		{name: "case insensitive", searchName: "duplicate", wantID: "2"},
+		{name: "outward description", searchName: "DUPLICATES", wantID: "2"},
+		{name: "inward description", searchName: "is blocked by", wantID: "1", wantInward: true},
+		{name: "same both ways", searchName: "relates to", wantID: "3"},
+		{name: "not found", searchName: "Clones", wantNil: true},
+		{name: "empty name", searchName: "", wantNil: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, inward := FindLinkTypeByName(types, tt.searchName)
+			if tt.wantNil {
+				assert.Nil(t, result)
+				return
+			}
+			require.NotNil(t, result)
+			assert.Equal(t, tt.wantID, result.ID)
+			assert.Equal(t, tt.wantInward, inward)
+		})
+	}
+}
+
+func TestIssueLink_Describe(t *testing.T) {
+	linkType := IssueLinkType{Name: "Blocks", Inward: "is blocked by", Outward: "blocks"}
+
+	outward := IssueLink{Type: linkType, OutwardIssue: &Issue{Key: "PROJ-2"}}
+	desc, other := outward.Describe()
+	assert.Equal(t, "blocks", desc)
+	assert.Equal(t, "PROJ-2", other.Key)
+
+	inward := IssueLink{Type: linkType, InwardIssue: &Issue{Key: "PROJ-3"}}
+	desc, other = inward.Describe()
+	assert.Equal(t, "is blocked by", desc)
+	assert.Equal(t, "PROJ-3", other.Key)
+}
+
+func TestClient_GetIssueLinks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1", r.URL.Path)
+		assert.Equal(t, "issuelinks", r.URL.Query().Get("fields"))
+		w.Write([]byte(`{"key": "PROJ-1", "fields": {"issuelinks": [
+			{"id": "100", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+			 "outwardIssue": {"key": "PROJ-2", "fields": {"summary": "Blocked work", "status": {"name": "To Do"}}}}
+		]}}`))
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	links, err := client.GetIssueLinks(context.Background(), "PROJ-1")
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+	assert.Equal(t, "100", links[0].ID)
+	assert.Equal(t, "Blocks", links[0].Type.Name)
+	require.NotNil(t, links[0].OutwardIssue)
+	assert.Equal(t, "Blocked work", links[0].OutwardIssue.Fields.Summary)
+	assert.Nil(t, links[0].InwardIssue)
+
+	_, err = client.GetIssueLinks(context.Background(), "")
+	assert.ErrorIs(t, err, ErrIssueKeyRequired)
+}
+
+func TestClient_CreateIssueLink(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/rest/api/3/issueLink", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	err = client.CreateIssueLink(context.Background(), "Blocks", "PROJ-1", "PROJ-2")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"type":         map[string]interface{}{"name": "Blocks"},
+		"inwardIssue":  map[string]interface{}{"key": "PROJ-1"},
+		"outwardIssue": map[string]interface{}{"key": "PROJ-2"},
+	}, got)
+}
+
+func TestClient_DeleteIssueLink(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/rest/api/3/issueLink/100", r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	require.NoError(t, client.DeleteIssueLink(context.Background(), "100"))
+	assert.Error(t, client.DeleteIssueLink(context.Background(), ""))
+}
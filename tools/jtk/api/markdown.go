@@ -1,6 +1,9 @@
 package api
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/open-cli-collective/atlassian-go/adf"
 )
 
@@ -22,3 +25,34 @@ func MarkdownToADF(markdown string) *ADFDocument {
 
 	return adf.ToDocument(markdown)
 }
+
+// CheckADF validates a document before it is sent, so that a malformed
+// document is reported with the paths of its problems rather than as Jira's
+// INVALID_INPUT. The error is prefixed with name, such as "description".
+func CheckADF(name string, doc *ADFDocument) error {
+	if doc == nil {
+		return nil
+	}
+	if err := adf.Check(doc); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+// CheckADFFields runs CheckADF on every ADF document among issue fields.
+func CheckADFFields(fields map[string]interface{}) error {
+	ids := make([]string, 0, len(fields))
+	for id := range fields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		if doc, ok := fields[id].(*ADFDocument); ok {
+			if err := CheckADF(id, doc); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -557,3 +557,17 @@ func TestMarkdownToADF_Formatting(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckADFFields(t *testing.T) {
+	fields := map[string]interface{}{
+		"summary":     "Plain text is not checked",
+		"description": NewADFDocument("# Valid\n\n| a |  |\n|---|---|\n| 1 |  |"),
+	}
+	assert.NoError(t, CheckADFFields(fields))
+
+	fields["customfield_10001"] = &ADFDocument{Type: "doc", Version: 1, Content: []*ADFNode{{Type: "heading"}}}
+	err := CheckADFFields(fields)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "customfield_10001: invalid ADF document:")
+	assert.Contains(t, err.Error(), "/content/0/attrs/level: required attribute level is missing")
+}
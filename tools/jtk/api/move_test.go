@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -56,7 +57,7 @@ func TestGetMoveTaskStatus(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	status, err := client.GetMoveTaskStatus("task-123")
+	status, err := client.GetMoveTaskStatus(context.Background(), "task-123")
 	require.NoError(t, err)
 	assert.Equal(t, "task-123", status.TaskID)
 	assert.Equal(t, "COMPLETE", status.Status)
@@ -73,7 +74,7 @@ func TestGetMoveTaskStatus_EmptyID(t *testing.T) {
 		APIToken: "token",
 	})
 
-	_, err := client.GetMoveTaskStatus("")
+	_, err := client.GetMoveTaskStatus(context.Background(), "")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "task ID is required")
 }
@@ -103,7 +104,7 @@ func TestGetMoveTaskStatus_WithFailures(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	status, err := client.GetMoveTaskStatus("task-456")
+	status, err := client.GetMoveTaskStatus(context.Background(), "task-456")
 	require.NoError(t, err)
 	require.NotNil(t, status.Result)
 	assert.Len(t, status.Result.Successful, 1)
@@ -137,7 +138,7 @@ func TestMoveIssues(t *testing.T) {
 	require.NoError(t, err)
 
 	req := BuildMoveRequest([]string{"PROJ-1"}, "TARGET", "10001", true)
-	resp, err := client.MoveIssues(req)
+	resp, err := client.MoveIssues(context.Background(), req)
 	require.NoError(t, err)
 	assert.Equal(t, "new-task-id", resp.TaskID)
 }
@@ -163,7 +164,7 @@ func TestGetProjectIssueTypes(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	types, err := client.GetProjectIssueTypes("PROJ")
+	types, err := client.GetProjectIssueTypes(context.Background(), "PROJ")
 	require.NoError(t, err)
 	assert.Len(t, types, 3)
 	assert.Equal(t, "Task", types[0].Name)
@@ -178,7 +179,7 @@ func TestGetProjectIssueTypes_EmptyProject(t *testing.T) {
 		APIToken: "token",
 	})
 
-	_, err := client.GetProjectIssueTypes("")
+	_, err := client.GetProjectIssueTypes(context.Background(), "")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "project key is required")
 }
@@ -209,7 +210,7 @@ func TestGetProjectStatuses(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	statuses, err := client.GetProjectStatuses("PROJ")
+	statuses, err := client.GetProjectStatuses(context.Background(), "PROJ")
 	require.NoError(t, err)
 	assert.Len(t, statuses, 1)
 	assert.Equal(t, "Task", statuses[0].Name)
@@ -224,7 +225,7 @@ func TestGetProjectStatuses_EmptyProject(t *testing.T) {
 		APIToken: "token",
 	})
 
-	_, err := client.GetProjectStatuses("")
+	_, err := client.GetProjectStatuses(context.Background(), "")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "project key is required")
 }
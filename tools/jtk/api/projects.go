@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -19,9 +20,9 @@ type ProjectDetail struct {
 }
 
 // ListProjects returns all projects
-func (c *Client) ListProjects() ([]Project, error) {
+func (c *Client) ListProjects(ctx context.Context) ([]Project, error) {
 	urlStr := fmt.Sprintf("%s/project", c.BaseURL)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -35,13 +36,13 @@ func (c *Client) ListProjects() ([]Project, error) {
 }
 
 // GetProject retrieves a project by key or ID
-func (c *Client) GetProject(projectKeyOrID string) (*ProjectDetail, error) {
+func (c *Client) GetProject(ctx context.Context, projectKeyOrID string) (*ProjectDetail, error) {
 	if projectKeyOrID == "" {
 		return nil, ErrProjectKeyRequired
 	}
 
 	urlStr := fmt.Sprintf("%s/project/%s", c.BaseURL, url.PathEscape(projectKeyOrID))
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// maxIssuesPerRank is the most issues Jira ranks in one request
+const maxIssuesPerRank = 50
+
+// RankRequest represents a request to rank issues before or after another
+// issue
+type RankRequest struct {
+	Issues            []string `json:"issues"`
+	RankBeforeIssue   string   `json:"rankBeforeIssue,omitempty"`
+	RankAfterIssue    string   `json:"rankAfterIssue,omitempty"`
+	RankCustomFieldID int64    `json:"rankCustomFieldId,omitempty"`
+}
+
+// rankResponse is returned when only some issues could be ranked
+type rankResponse struct {
+	Entries []struct {
+		IssueKey string   `json:"issueKey"`
+		Status   int      `json:"status"`
+		Errors   []string `json:"errors"`
+	} `json:"entries"`
+}
+
+// RankError lists the issues Jira could not rank, with its reasons
+type RankError struct {
+	Failed map[string]string
+}
+
+func (e *RankError) Error() string {
+	parts := make([]string, 0, len(e.Failed))
+	for _, key := range slices.Sorted(maps.Keys(e.Failed)) {
+		parts = append(parts, fmt.Sprintf("%s: %s", key, e.Failed[key]))
+	}
+	return fmt.Sprintf("failed to rank %d issue(s): %s", len(e.Failed), strings.Join(parts, "; "))
+}
+
+// RankIssues ranks issues, in the order given, before or after another
+// issue. Exactly one of before and after must be set. Issues are ranked in
+// batches, each after the last issue of the batch before, so they end up
+// together and in order. rankFieldID picks the rank field of a board; zero
+// uses the default.
+func (c *Client) RankIssues(ctx context.Context, issueKeys []string, before, after string, rankFieldID int64) error {
+	if len(issueKeys) == 0 {
+		return ErrIssueKeyRequired
+	}
+	if (before == "") == (after == "") {
+		return fmt.Errorf("exactly one of before and after is required")
+	}
+
+	for start := 0; start < len(issueKeys); start += maxIssuesPerRank {
+		end := min(start+maxIssuesPerRank, len(issueKeys))
+		req := &RankRequest{
+			Issues:            issueKeys[start:end],
+			RankBeforeIssue:   before,
+			RankAfterIssue:    after,
+			RankCustomFieldID: rankFieldID,
+		}
+		if start > 0 {
+			req.RankBeforeIssue, req.RankAfterIssue = "", issueKeys[start-1]
+		}
+
+		body, err := c.put(ctx, c.AgileURL+"/issue/rank", req)
+		if err != nil {
+			return err
+		}
+		if err := parseRankResponse(body); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseRankResponse returns a RankError if a rank response reports issues
+// that were not ranked. Jira only sends a body when some were not.
+func parseRankResponse(body []byte) error {
+	if len(body) == 0 {
+		return nil
+	}
+
+	var result rankResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return fmt.Errorf("failed to parse rank response: %w", err)
+	}
+
+	failed := map[string]string{}
+	for _, entry := range result.Entries {
+		if entry.Status < 300 {
+			continue
+		}
+		reason := strings.Join(entry.Errors, ", ")
+		if reason == "" {
+			reason = fmt.Sprintf("status %d", entry.Status)
+		}
+		failed[entry.IssueKey] = reason
+	}
+	if len(failed) > 0 {
+		return &RankError{Failed: failed}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RankIssues_Batches(t *testing.T) {
+	var requests []RankRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/rest/agile/1.0/issue/rank", r.URL.Path)
+		var req RankRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		requests = append(requests, req)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	keys := make([]string, 120)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("PROJ-%d", i+1)
+	}
+
+	require.NoError(t, client.RankIssues(context.Background(), keys, "PROJ-500", "", 10019))
+
+	require.Len(t, requests, 3)
+	assert.Equal(t, keys[:50], requests[0].Issues)
+	assert.Equal(t, "PROJ-500", requests[0].RankBeforeIssue)
+	assert.Equal(t, int64(10019), requests[0].RankCustomFieldID)
+	// Later batches follow the one before, keeping the issues in order
+	assert.Equal(t, keys[50:100], requests[1].Issues)
+	assert.Equal(t, "", requests[1].RankBeforeIssue)
+	assert.Equal(t, "PROJ-50", requests[1].RankAfterIssue)
+	assert.Equal(t, keys[100:], requests[2].Issues)
+	assert.Equal(t, "PROJ-100", requests[2].RankAfterIssue)
+}
+
+func TestClient_RankIssues_PartialFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusMultiStatus)
+		w.Write([]byte(`{"entries": [
+			{"issueId": 1, "issueKey": "PROJ-1", "status": 200},
+			{"issueId": 2, "issueKey": "PROJ-2", "status": 400, "errors": ["Rank is not available for this issue"]}
+		]}`))
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	err = client.RankIssues(context.Background(), []string{"PROJ-1", "PROJ-2"}, "", "PROJ-9", 0)
+	var rankErr *RankError
+	require.ErrorAs(t, err, &rankErr)
+	assert.Equal(t, map[string]string{"PROJ-2": "Rank is not available for this issue"}, rankErr.Failed)
+	assert.EqualError(t, err, "failed to rank 1 issue(s): PROJ-2: Rank is not available for this issue")
+}
+
+func TestClient_RankIssues_Validation(t *testing.T) {
+	client, err := New(ClientConfig{URL: "https://example.atlassian.net", Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, client.RankIssues(context.Background(), nil, "PROJ-1", "", 0), ErrIssueKeyRequired)
+	assert.Error(t, client.RankIssues(context.Background(), []string{"PROJ-2"}, "", "", 0))
+	assert.Error(t, client.RankIssues(context.Background(), []string{"PROJ-2"}, "PROJ-1", "PROJ-3", 0))
+}
@@ -1,26 +1,36 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
+
+	"github.com/open-cli-collective/atlassian-go/client"
 )
 
 // SearchOptions contains options for JQL search
 type SearchOptions struct {
-	JQL        string
-	StartAt    int
-	MaxResults int
-	Fields     []string
+	JQL           string
+	StartAt       int
+	MaxResults    int
+	Fields        []string
+	NextPageToken string
 }
 
 // SearchRequest is the request body for the new JQL search API
 type SearchRequest struct {
-	JQL        string   `json:"jql"`
-	StartAt    int      `json:"startAt,omitempty"`
-	MaxResults int      `json:"maxResults,omitempty"`
-	Fields     []string `json:"fields,omitempty"`
+	JQL           string   `json:"jql"`
+	StartAt       int      `json:"startAt,omitempty"`
+	MaxResults    int      `json:"maxResults,omitempty"`
+	Fields        []string `json:"fields,omitempty"`
+	NextPageToken string   `json:"nextPageToken,omitempty"`
 }
 
+// searchPageSize is the number of issues SearchIter requests per page,
+// the most Jira returns
+const searchPageSize = 100
+
 // DefaultSearchFields are the fields returned by default in search results
 var DefaultSearchFields = []string{
 	"summary",
@@ -39,9 +49,10 @@ var DefaultSearchFields = []string{
 }
 
 // Search searches for issues using JQL (uses new /search/jql endpoint)
-func (c *Client) Search(opts SearchOptions) (*SearchResult, error) {
+func (c *Client) Search(ctx context.Context, opts SearchOptions) (*SearchResult, error) {
 	req := SearchRequest{
-		JQL: opts.JQL,
+		JQL:           opts.JQL,
+		NextPageToken: opts.NextPageToken,
 	}
 
 	if opts.StartAt > 0 {
@@ -62,7 +73,7 @@ func (c *Client) Search(opts SearchOptions) (*SearchResult, error) {
 	}
 
 	urlStr := fmt.Sprintf("%s/search/jql", c.BaseURL)
-	body, err := c.post(urlStr, req)
+	body, err := c.postIdempotent(ctx, urlStr, req)
 	if err != nil {
 		return nil, err
 	}
@@ -75,41 +86,45 @@ func (c *Client) Search(opts SearchOptions) (*SearchResult, error) {
 	return &result, nil
 }
 
-// SearchAll searches for all issues matching JQL (handles pagination)
-func (c *Client) SearchAll(jql string, maxResults int) ([]Issue, error) {
-	if maxResults <= 0 {
-		maxResults = 1000
+// SearchIter returns an iterator over every issue matching the search,
+// following nextPageToken (or startAt for older responses) across pages
+func (c *Client) SearchIter(ctx context.Context, opts SearchOptions) iter.Seq2[Issue, error] {
+	if opts.MaxResults <= 0 {
+		opts.MaxResults = searchPageSize
 	}
 
-	var allIssues []Issue
-	startAt := 0
-	pageSize := 100
+	return client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Issue, *client.Cursor, error) {
+		pageOpts := opts
+		pageOpts.StartAt = opts.StartAt + cursor.StartAt
+		pageOpts.NextPageToken = cursor.Token
 
-	for {
-		result, err := c.Search(SearchOptions{
-			JQL:        jql,
-			StartAt:    startAt,
-			MaxResults: pageSize,
-		})
+		result, err := c.Search(ctx, pageOpts)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
-		allIssues = append(allIssues, result.Issues...)
-
-		if len(allIssues) >= result.Total || len(allIssues) >= maxResults {
-			break
+		if result.NextPageToken != "" {
+			return result.Issues, client.NextToken(result.NextPageToken), nil
 		}
-
-		startAt += len(result.Issues)
-		if len(result.Issues) == 0 {
-			break
+		// Without a token, only a reported total tells us there is more
+		if result.IsLast || result.Total <= 0 {
+			return result.Issues, nil, nil
 		}
-	}
+		return result.Issues, client.NextOffset(cursor.StartAt, len(result.Issues), result.Total-opts.StartAt, false), nil
+	})
+}
 
-	if len(allIssues) > maxResults {
-		allIssues = allIssues[:maxResults]
+// SearchAll searches for issues matching JQL (handles pagination).
+// It returns at most maxResults issues, or every match if maxResults <= 0,
+// requesting no more than that from Jira.
+// Any extraFields are requested in addition to DefaultSearchFields.
+func (c *Client) SearchAll(ctx context.Context, jql string, maxResults int, extraFields ...string) ([]Issue, error) {
+	opts := SearchOptions{JQL: jql}
+	if maxResults > 0 {
+		opts.MaxResults = min(maxResults, searchPageSize)
 	}
-
-	return allIssues, nil
+	if len(extraFields) > 0 {
+		opts.Fields = append(append([]string{}, DefaultSearchFields...), extraFields...)
+	}
+	return client.Collect(c.SearchIter(ctx, opts), maxResults)
 }
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchAll(t *testing.T) {
+	t.Run("follows next page tokens", func(t *testing.T) {
+		var tokens []string
+		client, server := newTestClientWithServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req SearchRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			tokens = append(tokens, req.NextPageToken)
+
+			w.WriteHeader(http.StatusOK)
+			if req.NextPageToken == "" {
+				_, _ = w.Write([]byte(`{"issues":[{"key":"PROJ-1"},{"key":"PROJ-2"}],"nextPageToken":"tok2"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"issues":[{"key":"PROJ-3"}],"isLast":true}`))
+		}))
+		defer server.Close()
+
+		issues, err := client.SearchAll(context.Background(), "project = PROJ", 0)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"", "tok2"}, tokens)
+		require.Len(t, issues, 3)
+		assert.Equal(t, "PROJ-3", issues[2].Key)
+	})
+
+	t.Run("stops at max results", func(t *testing.T) {
+		requests := 0
+		client, server := newTestClientWithServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req SearchRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			assert.Equal(t, 2, req.MaxResults)
+			requests++
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"issues":[{"key":"PROJ-1"},{"key":"PROJ-2"}],"nextPageToken":"more"}`))
+		}))
+		defer server.Close()
+
+		issues, err := client.SearchAll(context.Background(), "project = PROJ", 2)
+		require.NoError(t, err)
+		assert.Len(t, issues, 2)
+		assert.Equal(t, 1, requests)
+	})
+	t.Run("pages of at most 100 issues", func(t *testing.T) {
+		var sizes []int
+		client, server := newTestClientWithServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req SearchRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			sizes = append(sizes, req.MaxResults)
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"issues":[{"key":"PROJ-1"}],"isLast":true}`))
+		}))
+		defer server.Close()
+
+		_, err := client.SearchAll(context.Background(), "project = PROJ", 250)
+		require.NoError(t, err)
+		_, err = client.SearchAll(context.Background(), "project = PROJ", 0)
+		require.NoError(t, err)
+		assert.Equal(t, []int{100, 100}, sizes)
+	})
+}
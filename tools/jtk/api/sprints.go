@@ -1,13 +1,27 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
+
+	"github.com/open-cli-collective/atlassian-go/client"
+)
+
+// maxIssuesPerMove is the most issues Jira moves to a sprint or the backlog
+// in one request
+const maxIssuesPerMove = 50
+
+// Sprint states
+const (
+	SprintStateFuture = "future"
+	SprintStateActive = "active"
+	SprintStateClosed = "closed"
 )
 
 // ListSprints returns sprints for a board
-func (c *Client) ListSprints(boardID int, state string, startAt, maxResults int) (*SprintsResponse, error) {
+func (c *Client) ListSprints(ctx context.Context, boardID int, state string, startAt, maxResults int) (*SprintsResponse, error) {
 	params := map[string]string{}
 
 	if state != "" {
@@ -21,7 +35,7 @@ func (c *Client) ListSprints(boardID int, state string, startAt, maxResults int)
 	}
 
 	urlStr := buildURL(fmt.Sprintf("%s/board/%d/sprint", c.AgileURL, boardID), params)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -34,10 +48,21 @@ func (c *Client) ListSprints(boardID int, state string, startAt, maxResults int)
 	return &result, nil
 }
 
+// ListAllSprints returns every sprint for a board, following pagination
+func (c *Client) ListAllSprints(ctx context.Context, boardID int, state string) ([]Sprint, error) {
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Sprint, *client.Cursor, error) {
+		result, err := c.ListSprints(ctx, boardID, state, cursor.StartAt, 50)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Values, client.NextOffset(cursor.StartAt, len(result.Values), 0, result.IsLast), nil
+	}), 0)
+}
+
 // GetSprint retrieves a sprint by ID
-func (c *Client) GetSprint(sprintID int) (*Sprint, error) {
+func (c *Client) GetSprint(ctx context.Context, sprintID int) (*Sprint, error) {
 	urlStr := fmt.Sprintf("%s/sprint/%d", c.AgileURL, sprintID)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +76,7 @@ func (c *Client) GetSprint(sprintID int) (*Sprint, error) {
 }
 
 // GetSprintIssues returns issues in a sprint
-func (c *Client) GetSprintIssues(sprintID int, startAt, maxResults int) (*SearchResult, error) {
+func (c *Client) GetSprintIssues(ctx context.Context, sprintID int, startAt, maxResults int) (*SearchResult, error) {
 	params := map[string]string{}
 
 	if startAt > 0 {
@@ -62,7 +87,7 @@ func (c *Client) GetSprintIssues(sprintID int, startAt, maxResults int) (*Search
 	}
 
 	urlStr := buildURL(fmt.Sprintf("%s/sprint/%d/issue", c.AgileURL, sprintID), params)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -75,9 +100,20 @@ func (c *Client) GetSprintIssues(sprintID int, startAt, maxResults int) (*Search
 	return &result, nil
 }
 
+// GetAllSprintIssues returns every issue in a sprint, following pagination
+func (c *Client) GetAllSprintIssues(ctx context.Context, sprintID int) ([]Issue, error) {
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Issue, *client.Cursor, error) {
+		result, err := c.GetSprintIssues(ctx, sprintID, cursor.StartAt, 50)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Issues, client.NextOffset(cursor.StartAt, len(result.Issues), result.Total, false), nil
+	}), 0)
+}
+
 // GetCurrentSprint returns the active sprint for a board
-func (c *Client) GetCurrentSprint(boardID int) (*Sprint, error) {
-	result, err := c.ListSprints(boardID, "active", 0, 1)
+func (c *Client) GetCurrentSprint(ctx context.Context, boardID int) (*Sprint, error) {
+	result, err := c.ListSprints(ctx, boardID, SprintStateActive, 0, 1)
 	if err != nil {
 		return nil, err
 	}
@@ -90,12 +126,71 @@ func (c *Client) GetCurrentSprint(boardID int) (*Sprint, error) {
 }
 
 // MoveIssuesToSprint moves issues to a sprint
-func (c *Client) MoveIssuesToSprint(sprintID int, issueKeys []string) error {
+func (c *Client) MoveIssuesToSprint(ctx context.Context, sprintID int, issueKeys []string) error {
 	urlStr := fmt.Sprintf("%s/sprint/%d/issue", c.AgileURL, sprintID)
-	req := map[string]interface{}{
-		"issues": issueKeys,
+	for start := 0; start < len(issueKeys); start += maxIssuesPerMove {
+		end := min(start+maxIssuesPerMove, len(issueKeys))
+		req := map[string]interface{}{
+			"issues": issueKeys[start:end],
+		}
+		if _, err := c.post(ctx, urlStr, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateSprint creates a future sprint on the board given by
+// req.OriginBoardID
+func (c *Client) CreateSprint(ctx context.Context, req *SprintRequest) (*Sprint, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("sprint name is required")
+	}
+	if req.OriginBoardID == 0 {
+		return nil, fmt.Errorf("board ID is required")
+	}
+
+	body, err := c.post(ctx, c.AgileURL+"/sprint", req)
+	if err != nil {
+		return nil, err
+	}
+
+	var sprint Sprint
+	if err := json.Unmarshal(body, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to parse sprint: %w", err)
+	}
+
+	return &sprint, nil
+}
+
+// UpdateSprint changes the fields of a sprint set in req. Setting State
+// starts or completes the sprint; a sprint can only be started once it has
+// start and end dates.
+func (c *Client) UpdateSprint(ctx context.Context, sprintID int, req *SprintRequest) (*Sprint, error) {
+	urlStr := fmt.Sprintf("%s/sprint/%d", c.AgileURL, sprintID)
+	body, err := c.post(ctx, urlStr, req)
+	if err != nil {
+		return nil, err
 	}
 
-	_, err := c.post(urlStr, req)
-	return err
+	var sprint Sprint
+	if err := json.Unmarshal(body, &sprint); err != nil {
+		return nil, fmt.Errorf("failed to parse sprint: %w", err)
+	}
+
+	return &sprint, nil
+}
+
+// MoveIssuesToBacklog moves issues out of their sprints to the backlog
+func (c *Client) MoveIssuesToBacklog(ctx context.Context, issueKeys []string) error {
+	for start := 0; start < len(issueKeys); start += maxIssuesPerMove {
+		end := min(start+maxIssuesPerMove, len(issueKeys))
+		req := map[string]interface{}{
+			"issues": issueKeys[start:end],
+		}
+		if _, err := c.post(ctx, c.AgileURL+"/backlog/issue", req); err != nil {
+			return err
+		}
+	}
+	return nil
 }
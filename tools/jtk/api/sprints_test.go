@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_CreateAndUpdateSprint(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.Write([]byte(`{"id": 7, "name": "Sprint 7", "state": "future", "originBoardId": 3}`))
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	start := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	sprint, err := client.CreateSprint(context.Background(), &SprintRequest{Name: "Sprint 7", OriginBoardID: 3, StartDate: &start})
+	require.NoError(t, err)
+	assert.Equal(t, 7, sprint.ID)
+
+	goal := ""
+	_, err = client.UpdateSprint(context.Background(), 7, &SprintRequest{Goal: &goal})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`POST /rest/agile/1.0/sprint {"name":"Sprint 7","startDate":"2026-10-19T09:00:00Z","originBoardId":3}`,
+		`POST /rest/agile/1.0/sprint/7 {"goal":""}`,
+	}, requests)
+
+	_, err = client.CreateSprint(context.Background(), &SprintRequest{OriginBoardID: 3})
+	assert.Error(t, err)
+	_, err = client.CreateSprint(context.Background(), &SprintRequest{Name: "Sprint 8"})
+	assert.Error(t, err)
+}
+
+func TestClient_MoveIssuesInBatches(t *testing.T) {
+	var batches []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Issues []string `json:"issues"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		batches = append(batches, fmt.Sprintf("%s %d", r.URL.Path, len(req.Issues)))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	keys := make([]string, 120)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("PROJ-%d", i+1)
+	}
+
+	require.NoError(t, client.MoveIssuesToSprint(context.Background(), 7, keys))
+	require.NoError(t, client.MoveIssuesToBacklog(context.Background(), keys[:10]))
+
+	assert.Equal(t, []string{
+		"/rest/agile/1.0/sprint/7/issue 50",
+		"/rest/agile/1.0/sprint/7/issue 50",
+		"/rest/agile/1.0/sprint/7/issue 20",
+		"/rest/agile/1.0/backlog/issue 10",
+	}, batches)
+}
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -8,13 +9,13 @@ import (
 )
 
 // GetTransitions returns available transitions for an issue
-func (c *Client) GetTransitions(issueKey string) ([]Transition, error) {
-	return c.GetTransitionsWithFields(issueKey, false)
+func (c *Client) GetTransitions(ctx context.Context, issueKey string) ([]Transition, error) {
+	return c.GetTransitionsWithFields(ctx, issueKey, false)
 }
 
 // GetTransitionsWithFields returns available transitions for an issue,
 // optionally including field metadata (required fields, allowed values)
-func (c *Client) GetTransitionsWithFields(issueKey string, includeFields bool) ([]Transition, error) {
+func (c *Client) GetTransitionsWithFields(ctx context.Context, issueKey string, includeFields bool) ([]Transition, error) {
 	if issueKey == "" {
 		return nil, ErrIssueKeyRequired
 	}
@@ -24,7 +25,7 @@ func (c *Client) GetTransitionsWithFields(issueKey string, includeFields bool) (
 		urlStr += "?expand=transitions.fields"
 	}
 
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +39,7 @@ func (c *Client) GetTransitionsWithFields(issueKey string, includeFields bool) (
 }
 
 // DoTransition performs a transition on an issue with optional fields
-func (c *Client) DoTransition(issueKey, transitionID string, fields map[string]interface{}) error {
+func (c *Client) DoTransition(ctx context.Context, issueKey, transitionID string, fields map[string]interface{}) error {
 	if issueKey == "" {
 		return ErrIssueKeyRequired
 	}
@@ -49,7 +50,7 @@ func (c *Client) DoTransition(issueKey, transitionID string, fields map[string]i
 		Fields:     fields,
 	}
 
-	_, err := c.post(urlStr, req)
+	_, err := c.post(ctx, urlStr, req)
 	return err
 }
 
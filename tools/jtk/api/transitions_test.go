@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -94,7 +95,7 @@ func TestClient_GetTransitions(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	transitions, err := client.GetTransitions("PROJ-123")
+	transitions, err := client.GetTransitions(context.Background(), "PROJ-123")
 	require.NoError(t, err)
 	assert.Len(t, transitions, 2)
 	assert.Equal(t, "11", transitions[0].ID)
@@ -132,7 +133,7 @@ func TestClient_GetTransitionsWithFields(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.wantErr != nil {
 				client := &Client{}
-				_, err := client.GetTransitionsWithFields(tt.issueKey, tt.includeFields)
+				_, err := client.GetTransitionsWithFields(context.Background(), tt.issueKey, tt.includeFields)
 				assert.ErrorIs(t, err, tt.wantErr)
 				return
 			}
@@ -174,7 +175,7 @@ func TestClient_GetTransitionsWithFields(t *testing.T) {
 			})
 			require.NoError(t, err)
 
-			transitions, err := client.GetTransitionsWithFields(tt.issueKey, tt.includeFields)
+			transitions, err := client.GetTransitionsWithFields(context.Background(), tt.issueKey, tt.includeFields)
 			require.NoError(t, err)
 			assert.Len(t, transitions, 1)
 			assert.Equal(t, "In Progress", transitions[0].Name)
@@ -223,7 +224,7 @@ func TestClient_DoTransition(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			if tt.wantErr != nil {
 				client := &Client{}
-				err := client.DoTransition(tt.issueKey, tt.transitionID, tt.fields)
+				err := client.DoTransition(context.Background(), tt.issueKey, tt.transitionID, tt.fields)
 				assert.ErrorIs(t, err, tt.wantErr)
 				return
 			}
@@ -245,7 +246,7 @@ func TestClient_DoTransition(t *testing.T) {
 			})
 			require.NoError(t, err)
 
-			err = client.DoTransition(tt.issueKey, tt.transitionID, tt.fields)
+			err = client.DoTransition(context.Background(), tt.issueKey, tt.transitionID, tt.fields)
 			require.NoError(t, err)
 			assert.Equal(t, tt.transitionID, receivedBody.Transition.ID)
 			if tt.fields != nil {
@@ -7,6 +7,10 @@ import (
 	"github.com/open-cli-collective/atlassian-go/adf"
 )
 
+// TimeFormat is the layout of the timestamps Jira returns, such as issue
+// created times, changelog entries and worklog start times
+const TimeFormat = "2006-01-02T15:04:05.000-0700"
+
 // Issue represents a Jira issue
 type Issue struct {
 	ID     string      `json:"id"`
@@ -31,6 +35,7 @@ type IssueFields struct {
 	Components  []Component  `json:"components,omitempty"`
 	Sprint      *Sprint      `json:"sprint,omitempty"`
 	Parent      *Issue       `json:"parent,omitempty"`
+	IssueLinks  []IssueLink  `json:"issuelinks,omitempty"`
 
 	// CustomFields holds any fields not mapped to struct fields (e.g., customfield_10001)
 	CustomFields map[string]interface{} `json:"-"`
@@ -42,7 +47,7 @@ var knownFieldKeys = map[string]bool{
 	"issuetype": true, "priority": true, "assignee": true,
 	"reporter": true, "project": true, "created": true,
 	"updated": true, "labels": true, "components": true,
-	"sprint": true, "parent": true,
+	"sprint": true, "parent": true, "issuelinks": true,
 }
 
 // UnmarshalJSON custom unmarshaler to capture custom fields
@@ -123,6 +128,9 @@ func (f IssueFields) MarshalJSON() ([]byte, error) {
 	if f.Parent != nil {
 		result["parent"] = f.Parent
 	}
+	if len(f.IssueLinks) > 0 {
+		result["issuelinks"] = f.IssueLinks
+	}
 
 	// Add custom fields
 	for key, value := range f.CustomFields {
@@ -189,6 +197,18 @@ func (d *Description) ToPlainText() string {
 	return d.Text
 }
 
+// ToMarkdown returns the description as Markdown, or as plain text if it
+// was not an ADF document
+func (d *Description) ToMarkdown() string {
+	if d == nil {
+		return ""
+	}
+	if d.ADF != nil {
+		return d.ADF.ToMarkdown()
+	}
+	return d.Text
+}
+
 // Status represents an issue status
 type Status struct {
 	ID             string         `json:"id"`
@@ -268,6 +288,55 @@ type BoardLocation struct {
 	ProjectName string `json:"projectName"`
 }
 
+// BoardConfiguration is the configuration of an agile board
+type BoardConfiguration struct {
+	ID           int               `json:"id"`
+	Name         string            `json:"name"`
+	Type         string            `json:"type"`
+	Filter       BoardFilter       `json:"filter"`
+	ColumnConfig BoardColumnConfig `json:"columnConfig"`
+	Ranking      *BoardRanking     `json:"ranking,omitempty"`
+}
+
+// BoardColumnConfig holds the columns of a board. ConstraintType says what
+// the WIP limits count: "issueCount", "issueCountExclSubs" or "none".
+type BoardColumnConfig struct {
+	Columns        []BoardColumn `json:"columns"`
+	ConstraintType string        `json:"constraintType,omitempty"`
+}
+
+// BoardColumn is a board column, the statuses mapped to it and its WIP
+// limits
+type BoardColumn struct {
+	Name     string              `json:"name"`
+	Statuses []BoardColumnStatus `json:"statuses"`
+	Min      *int                `json:"min,omitempty"`
+	Max      *int                `json:"max,omitempty"`
+}
+
+// BoardColumnStatus refers to a status mapped to a board column
+type BoardColumnStatus struct {
+	ID string `json:"id"`
+}
+
+// BoardFilter refers to the saved filter that selects a board's issues
+type BoardFilter struct {
+	ID string `json:"id"`
+}
+
+// BoardRanking holds the rank field of a board. It is missing when the
+// board has ranking disabled.
+type BoardRanking struct {
+	RankCustomFieldID int64 `json:"rankCustomFieldId"`
+}
+
+// Filter represents a saved filter
+type Filter struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	JQL  string `json:"jql"`
+}
+
 // Transition represents a workflow transition
 type Transition struct {
 	ID     string                     `json:"id"`
@@ -278,10 +347,11 @@ type Transition struct {
 
 // TransitionField represents field metadata for a transition
 type TransitionField struct {
-	Required      bool          `json:"required"`
-	Name          string        `json:"name"`
-	Schema        FieldSchema   `json:"schema,omitempty"`
-	AllowedValues []FieldOption `json:"allowedValues,omitempty"`
+	Required        bool          `json:"required"`
+	HasDefaultValue bool          `json:"hasDefaultValue,omitempty"`
+	Name            string        `json:"name"`
+	Schema          FieldSchema   `json:"schema,omitempty"`
+	AllowedValues   []FieldOption `json:"allowedValues,omitempty"`
 }
 
 // FieldOption represents an allowed value for a field
@@ -300,6 +370,83 @@ type Comment struct {
 	Updated string       `json:"updated"`
 }
 
+// Watchers represents the users watching an issue
+type Watchers struct {
+	WatchCount int    `json:"watchCount"`
+	IsWatching bool   `json:"isWatching"`
+	Watchers   []User `json:"watchers"`
+}
+
+// Votes represents the votes on an issue. Voters are only listed for users
+// with permission to view them.
+type Votes struct {
+	Votes    int    `json:"votes"`
+	HasVoted bool   `json:"hasVoted"`
+	Voters   []User `json:"voters,omitempty"`
+}
+
+// Worklog represents time logged against an issue
+type Worklog struct {
+	ID               string       `json:"id"`
+	IssueID          string       `json:"issueId,omitempty"`
+	Author           *User        `json:"author,omitempty"`
+	Comment          *ADFDocument `json:"comment,omitempty"`
+	Started          string       `json:"started"`
+	TimeSpent        string       `json:"timeSpent,omitempty"`
+	TimeSpentSeconds int          `json:"timeSpentSeconds"`
+	Created          string       `json:"created,omitempty"`
+	Updated          string       `json:"updated,omitempty"`
+}
+
+// ChangelogResponse represents a page of an issue's changelog
+type ChangelogResponse struct {
+	StartAt    int             `json:"startAt"`
+	MaxResults int             `json:"maxResults"`
+	Total      int             `json:"total"`
+	IsLast     bool            `json:"isLast"`
+	Values     []ChangeHistory `json:"values"`
+}
+
+// ChangeHistory represents the fields changed together by one user at one
+// time
+type ChangeHistory struct {
+	ID      string       `json:"id"`
+	Author  *User        `json:"author,omitempty"`
+	Created string       `json:"created"`
+	Items   []ChangeItem `json:"items"`
+}
+
+// ChangeItem represents the change to one field. From and To hold IDs or
+// raw values; FromString and ToString hold their display form.
+type ChangeItem struct {
+	Field      string `json:"field"`
+	FieldType  string `json:"fieldtype"`
+	FieldID    string `json:"fieldId,omitempty"`
+	From       string `json:"from"`
+	FromString string `json:"fromString"`
+	To         string `json:"to"`
+	ToString   string `json:"toString"`
+}
+
+// IssueLinkType represents a kind of issue link, such as "Blocks", with
+// the descriptions of its two directions
+type IssueLinkType struct {
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name"`
+	Inward  string `json:"inward,omitempty"`
+	Outward string `json:"outward,omitempty"`
+}
+
+// IssueLink represents a link from an issue to another issue. Links read
+// from an issue's fields hold only the other issue: OutwardIssue when the
+// issue is on the inward side of the link and InwardIssue otherwise.
+type IssueLink struct {
+	ID           string        `json:"id,omitempty"`
+	Type         IssueLinkType `json:"type"`
+	InwardIssue  *Issue        `json:"inwardIssue,omitempty"`
+	OutwardIssue *Issue        `json:"outwardIssue,omitempty"`
+}
+
 // Field represents a Jira field definition
 type Field struct {
 	ID          string      `json:"id"`
@@ -324,10 +471,12 @@ type FieldSchema struct {
 
 // SearchResult represents search results from JQL
 type SearchResult struct {
-	StartAt    int     `json:"startAt"`
-	MaxResults int     `json:"maxResults"`
-	Total      int     `json:"total"`
-	Issues     []Issue `json:"issues"`
+	StartAt       int     `json:"startAt"`
+	MaxResults    int     `json:"maxResults"`
+	Total         int     `json:"total"`
+	Issues        []Issue `json:"issues"`
+	NextPageToken string  `json:"nextPageToken,omitempty"`
+	IsLast        bool    `json:"isLast,omitempty"`
 }
 
 // BoardsResponse represents the response from listing boards
@@ -360,11 +509,49 @@ type CommentsResponse struct {
 	Comments   []Comment `json:"comments"`
 }
 
+// WorklogsResponse represents the worklogs of an issue
+type WorklogsResponse struct {
+	StartAt    int       `json:"startAt"`
+	MaxResults int       `json:"maxResults"`
+	Total      int       `json:"total"`
+	Worklogs   []Worklog `json:"worklogs"`
+}
+
+// IssueLinkTypesResponse represents the available issue link types
+type IssueLinkTypesResponse struct {
+	IssueLinkTypes []IssueLinkType `json:"issueLinkTypes"`
+}
+
 // CreateIssueRequest represents a request to create an issue
 type CreateIssueRequest struct {
 	Fields map[string]interface{} `json:"fields"`
 }
 
+// BulkCreateRequest represents a request to create several issues at once
+type BulkCreateRequest struct {
+	IssueUpdates []*CreateIssueRequest `json:"issueUpdates"`
+}
+
+// BulkCreateResponse represents the result of a bulk create. Issues lists
+// the created issues in request order, skipping the failed ones.
+type BulkCreateResponse struct {
+	Issues []Issue             `json:"issues"`
+	Errors []BulkCreateFailure `json:"errors"`
+}
+
+// BulkCreateFailure describes an issue that could not be created
+type BulkCreateFailure struct {
+	Status              int               `json:"status"`
+	FailedElementNumber int               `json:"failedElementNumber"`
+	ElementErrors       BulkElementErrors `json:"elementErrors"`
+}
+
+// BulkElementErrors holds the errors for one issue of a bulk create
+type BulkElementErrors struct {
+	ErrorMessages []string          `json:"errorMessages,omitempty"`
+	Errors        map[string]string `json:"errors,omitempty"`
+}
+
 // UpdateIssueRequest represents a request to update an issue
 type UpdateIssueRequest struct {
 	Fields map[string]interface{} `json:"fields,omitempty"`
@@ -382,6 +569,37 @@ type TransitionID struct {
 	ID string `json:"id"`
 }
 
+// WorklogRequest represents a request to add or update a worklog. Fields
+// left empty are not changed on update.
+type WorklogRequest struct {
+	Comment   *ADFDocument `json:"comment,omitempty"`
+	Started   string       `json:"started,omitempty"`
+	TimeSpent string       `json:"timeSpent,omitempty"`
+}
+
+// SprintRequest represents a request to create or update a sprint. Fields
+// left empty are not changed on update; an empty Goal clears the goal.
+type SprintRequest struct {
+	Name          string     `json:"name,omitempty"`
+	State         string     `json:"state,omitempty"`
+	StartDate     *time.Time `json:"startDate,omitempty"`
+	EndDate       *time.Time `json:"endDate,omitempty"`
+	Goal          *string    `json:"goal,omitempty"`
+	OriginBoardID int        `json:"originBoardId,omitempty"`
+}
+
+// IssueKeyRef refers to an issue by key
+type IssueKeyRef struct {
+	Key string `json:"key"`
+}
+
+// CreateIssueLinkRequest represents a request to link two issues
+type CreateIssueLinkRequest struct {
+	Type         IssueLinkType `json:"type"`
+	InwardIssue  IssueKeyRef   `json:"inwardIssue"`
+	OutwardIssue IssueKeyRef   `json:"outwardIssue"`
+}
+
 // AddCommentRequest represents a request to add a comment
 type AddCommentRequest struct {
 	Body *ADFDocument `json:"body"`
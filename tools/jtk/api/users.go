@@ -1,14 +1,16 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
 // GetCurrentUser returns the currently authenticated user
-func (c *Client) GetCurrentUser() (*User, error) {
+func (c *Client) GetCurrentUser(ctx context.Context) (*User, error) {
 	urlStr := fmt.Sprintf("%s/myself", c.BaseURL)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -22,12 +24,12 @@ func (c *Client) GetCurrentUser() (*User, error) {
 }
 
 // GetUser returns a user by their account ID
-func (c *Client) GetUser(accountID string) (*User, error) {
+func (c *Client) GetUser(ctx context.Context, accountID string) (*User, error) {
 	params := map[string]string{
 		"accountId": accountID,
 	}
 	urlStr := buildURL(fmt.Sprintf("%s/user", c.BaseURL), params)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +43,7 @@ func (c *Client) GetUser(accountID string) (*User, error) {
 }
 
 // SearchUsers searches for users by query string
-func (c *Client) SearchUsers(query string, maxResults int) ([]User, error) {
+func (c *Client) SearchUsers(ctx context.Context, query string, maxResults int) ([]User, error) {
 	params := map[string]string{
 		"query": query,
 	}
@@ -50,7 +52,7 @@ func (c *Client) SearchUsers(query string, maxResults int) ([]User, error) {
 	}
 
 	urlStr := buildURL(fmt.Sprintf("%s/user/search", c.BaseURL), params)
-	body, err := c.get(urlStr)
+	body, err := c.get(ctx, urlStr)
 	if err != nil {
 		return nil, err
 	}
@@ -62,3 +64,59 @@ func (c *Client) SearchUsers(query string, maxResults int) ([]User, error) {
 
 	return users, nil
 }
+
+// ResolveUser finds the single user matching an email address or display
+// name. Exact matches on email address, then display name, are preferred
+// (case-insensitive); otherwise the search must return exactly one user.
+func (c *Client) ResolveUser(ctx context.Context, query string) (*User, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("user is required")
+	}
+
+	users, err := c.SearchUsers(ctx, query, 50)
+	if err != nil {
+		return nil, err
+	}
+
+	return matchUser(users, query)
+}
+
+// matchUser picks the user matching query from search results
+func matchUser(users []User, query string) (*User, error) {
+	var byEmail, byName []User
+	for _, u := range users {
+		if u.EmailAddress != "" && strings.EqualFold(u.EmailAddress, query) {
+			byEmail = append(byEmail, u)
+		}
+		if strings.EqualFold(u.DisplayName, query) {
+			byName = append(byName, u)
+		}
+	}
+
+	candidates := users
+	switch {
+	case len(byEmail) > 0:
+		candidates = byEmail
+	case len(byName) > 0:
+		candidates = byName
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("no user found matching %q", query)
+	case 1:
+		return &candidates[0], nil
+	}
+
+	names := make([]string, 0, len(candidates))
+	for _, u := range candidates {
+		name := u.DisplayName
+		if u.EmailAddress != "" {
+			name += " <" + u.EmailAddress + ">"
+		}
+		names = append(names, name)
+	}
+	return nil, fmt.Errorf("%q matches %d users (%s); use a more specific name or an email address",
+		query, len(candidates), strings.Join(names, ", "))
+}
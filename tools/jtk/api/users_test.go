@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -59,7 +60,7 @@ func TestGetUser(t *testing.T) {
 			require.NoError(t, err)
 			client.BaseURL = server.URL + "/rest/api/3"
 
-			user, err := client.GetUser(tt.accountID)
+			user, err := client.GetUser(context.Background(), tt.accountID)
 			if tt.wantErr {
 				assert.Error(t, err)
 				return
@@ -92,7 +93,7 @@ func TestGetCurrentUser(t *testing.T) {
 	require.NoError(t, err)
 	client.BaseURL = server.URL + "/rest/api/3"
 
-	user, err := client.GetCurrentUser()
+	user, err := client.GetCurrentUser(context.Background())
 	require.NoError(t, err)
 	assert.Equal(t, "Current User", user.DisplayName)
 	assert.Equal(t, "5b10ac8d82e05b22cc7d4ef5", user.AccountID)
@@ -128,9 +129,62 @@ func TestSearchUsers(t *testing.T) {
 	require.NoError(t, err)
 	client.BaseURL = server.URL + "/rest/api/3"
 
-	users, err := client.SearchUsers("john", 0)
+	users, err := client.SearchUsers(context.Background(), "john", 0)
 	require.NoError(t, err)
 	assert.Len(t, users, 2)
 	assert.Equal(t, "John Smith", users[0].DisplayName)
 	assert.Equal(t, "John Doe", users[1].DisplayName)
 }
+
+func TestMatchUser(t *testing.T) {
+	jane := User{AccountID: "1", DisplayName: "Jane Smith", EmailAddress: "jane@example.com"}
+	janet := User{AccountID: "2", DisplayName: "Janet Smith", EmailAddress: "janet@example.com"}
+	otherJane := User{AccountID: "3", DisplayName: "Jane Smith", EmailAddress: "jane.smith@example.org"}
+
+	tests := []struct {
+		name    string
+		users   []User
+		query   string
+		wantID  string
+		wantErr string
+	}{
+		{name: "email", users: []User{jane, janet}, query: "JANE@example.com", wantID: "1"},
+		{name: "display name", users: []User{jane, janet}, query: "jane smith", wantID: "1"},
+		{name: "single partial match", users: []User{janet}, query: "janet", wantID: "2"},
+		{name: "email beats shared name", users: []User{jane, otherJane}, query: "jane.smith@example.org", wantID: "3"},
+		{name: "ambiguous name", users: []User{jane, otherJane}, query: "Jane Smith", wantErr: `"Jane Smith" matches 2 users (Jane Smith <jane@example.com>, Jane Smith <jane.smith@example.org>)`},
+		{name: "ambiguous partial", users: []User{jane, janet}, query: "smith", wantErr: "matches 2 users"},
+		{name: "no match", query: "nobody", wantErr: `no user found matching "nobody"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := matchUser(tt.users, tt.query)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, user.AccountID)
+		})
+	}
+}
+
+func TestResolveUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/user/search", r.URL.Path)
+		assert.Equal(t, "jane@example.com", r.URL.Query().Get("query"))
+		json.NewEncoder(w).Encode([]User{{AccountID: "1", DisplayName: "Jane Smith", EmailAddress: "jane@example.com"}})
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	user, err := client.ResolveUser(context.Background(), " jane@example.com ")
+	require.NoError(t, err)
+	assert.Equal(t, "1", user.AccountID)
+
+	_, err = client.ResolveUser(context.Background(), "")
+	assert.ErrorContains(t, err, "user is required")
+}
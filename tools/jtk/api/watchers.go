@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// GetWatchers returns the users watching an issue
+func (c *Client) GetWatchers(ctx context.Context, issueKey string) (*Watchers, error) {
+	if issueKey == "" {
+		return nil, ErrIssueKeyRequired
+	}
+
+	urlStr := fmt.Sprintf("%s/issue/%s/watchers", c.BaseURL, url.PathEscape(issueKey))
+	body, err := c.get(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var watchers Watchers
+	if err := json.Unmarshal(body, &watchers); err != nil {
+		return nil, fmt.Errorf("failed to parse watchers: %w", err)
+	}
+
+	return &watchers, nil
+}
+
+// AddWatcher adds a user to the watchers of an issue
+func (c *Client) AddWatcher(ctx context.Context, issueKey, accountID string) error {
+	if issueKey == "" {
+		return ErrIssueKeyRequired
+	}
+	if accountID == "" {
+		return fmt.Errorf("account ID is required")
+	}
+
+	// The request body is the account ID as a bare JSON string
+	urlStr := fmt.Sprintf("%s/issue/%s/watchers", c.BaseURL, url.PathEscape(issueKey))
+	_, err := c.post(ctx, urlStr, accountID)
+	return err
+}
+
+// RemoveWatcher removes a user from the watchers of an issue
+func (c *Client) RemoveWatcher(ctx context.Context, issueKey, accountID string) error {
+	if issueKey == "" {
+		return ErrIssueKeyRequired
+	}
+	if accountID == "" {
+		return fmt.Errorf("account ID is required")
+	}
+
+	urlStr := buildURL(fmt.Sprintf("%s/issue/%s/watchers", c.BaseURL, url.PathEscape(issueKey)), map[string]string{
+		"accountId": accountID,
+	})
+	_, err := c.delete(ctx, urlStr)
+	return err
+}
+
+// GetVotes returns the votes on an issue
+func (c *Client) GetVotes(ctx context.Context, issueKey string) (*Votes, error) {
+	if issueKey == "" {
+		return nil, ErrIssueKeyRequired
+	}
+
+	urlStr := fmt.Sprintf("%s/issue/%s/votes", c.BaseURL, url.PathEscape(issueKey))
+	body, err := c.get(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var votes Votes
+	if err := json.Unmarshal(body, &votes); err != nil {
+		return nil, fmt.Errorf("failed to parse votes: %w", err)
+	}
+
+	return &votes, nil
+}
+
+// AddVote votes for an issue as the current user
+func (c *Client) AddVote(ctx context.Context, issueKey string) error {
+	if issueKey == "" {
+		return ErrIssueKeyRequired
+	}
+
+	urlStr := fmt.Sprintf("%s/issue/%s/votes", c.BaseURL, url.PathEscape(issueKey))
+	_, err := c.post(ctx, urlStr, nil)
+	return err
+}
+
+// RemoveVote withdraws the current user's vote for an issue
+func (c *Client) RemoveVote(ctx context.Context, issueKey string) error {
+	if issueKey == "" {
+		return ErrIssueKeyRequired
+	}
+
+	urlStr := fmt.Sprintf("%s/issue/%s/votes", c.BaseURL, url.PathEscape(issueKey))
+	_, err := c.delete(ctx, urlStr)
+	return err
+}
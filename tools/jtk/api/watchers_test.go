@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Watchers(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/watchers", r.URL.Path)
+		body, _ := io.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.RawQuery+" "+string(body))
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(`{"watchCount": 1, "isWatching": true, "watchers": [{"accountId": "1", "displayName": "Jane"}]}`))
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	watchers, err := client.GetWatchers(context.Background(), "PROJ-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, watchers.WatchCount)
+	assert.True(t, watchers.IsWatching)
+	assert.Equal(t, "Jane", watchers.Watchers[0].DisplayName)
+
+	require.NoError(t, client.AddWatcher(context.Background(), "PROJ-1", "abc"))
+	require.NoError(t, client.RemoveWatcher(context.Background(), "PROJ-1", "abc"))
+	assert.Equal(t, []string{"GET  ", `POST  "abc"`, "DELETE accountId=abc "}, requests)
+
+	assert.ErrorIs(t, client.AddWatcher(context.Background(), "", "abc"), ErrIssueKeyRequired)
+	assert.Error(t, client.RemoveWatcher(context.Background(), "PROJ-1", ""))
+}
+
+func TestClient_Votes(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/votes", r.URL.Path)
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"votes": 3, "hasVoted": true}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	require.NoError(t, client.AddVote(context.Background(), "PROJ-1"))
+	votes, err := client.GetVotes(context.Background(), "PROJ-1")
+	require.NoError(t, err)
+	assert.Equal(t, 3, votes.Votes)
+	assert.True(t, votes.HasVoted)
+	require.NoError(t, client.RemoveVote(context.Background(), "PROJ-1"))
+	assert.Equal(t, []string{http.MethodPost, http.MethodGet, http.MethodDelete}, methods)
+}
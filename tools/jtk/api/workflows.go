@@ -0,0 +1,152 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// Workflow is a workflow with its statuses and transitions
+type Workflow struct {
+	Name        string
+	Statuses    []WorkflowStatus
+	Transitions []WorkflowTransition
+}
+
+// WorkflowStatus is a status used by a workflow
+type WorkflowStatus struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// WorkflowTransition is a transition of a workflow. Global transitions
+// have no From statuses and can be taken from any status.
+type WorkflowTransition struct {
+	ID   string   `json:"id"`
+	Name string   `json:"name"`
+	From []string `json:"from"`
+	To   string   `json:"to"`
+	Type string   `json:"type"`
+}
+
+// WorkflowScheme maps issue types to workflows
+type WorkflowScheme struct {
+	ID                int64             `json:"id"`
+	Name              string            `json:"name"`
+	DefaultWorkflow   string            `json:"defaultWorkflow"`
+	IssueTypeMappings map[string]string `json:"issueTypeMappings"`
+}
+
+type workflowSchemeProjectsResponse struct {
+	Values []struct {
+		ProjectIDs     []string       `json:"projectIds"`
+		WorkflowScheme WorkflowScheme `json:"workflowScheme"`
+	} `json:"values"`
+}
+
+type workflowSearchResponse struct {
+	Values []struct {
+		ID struct {
+			Name string `json:"name"`
+		} `json:"id"`
+		Statuses    []WorkflowStatus     `json:"statuses"`
+		Transitions []WorkflowTransition `json:"transitions"`
+	} `json:"values"`
+}
+
+// GetProjectWorkflowScheme returns the workflow scheme of a company-managed
+// project. Reading workflow schemes requires Jira admin permission.
+func (c *Client) GetProjectWorkflowScheme(ctx context.Context, projectID string) (*WorkflowScheme, error) {
+	if projectID == "" {
+		return nil, fmt.Errorf("project ID is required")
+	}
+
+	body, err := c.get(ctx, buildURL(c.BaseURL+"/workflowscheme/project", map[string]string{"projectId": projectID}))
+	if err != nil {
+		return nil, err
+	}
+
+	var result workflowSchemeProjectsResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow scheme: %w", err)
+	}
+	if len(result.Values) == 0 {
+		return nil, fmt.Errorf("project %s has no workflow scheme", projectID)
+	}
+
+	return &result.Values[0].WorkflowScheme, nil
+}
+
+// GetWorkflow returns a workflow by name, with its statuses and transitions
+func (c *Client) GetWorkflow(ctx context.Context, name string) (*Workflow, error) {
+	if name == "" {
+		return nil, fmt.Errorf("workflow name is required")
+	}
+
+	body, err := c.get(ctx, buildURL(c.BaseURL+"/workflow/search", map[string]string{
+		"workflowName": name,
+		"expand":       "statuses,transitions",
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	var result workflowSearchResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse workflow: %w", err)
+	}
+	for _, w := range result.Values {
+		if w.ID.Name == name {
+			return &Workflow{Name: w.ID.Name, Statuses: w.Statuses, Transitions: w.Transitions}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("workflow not found: %s", name)
+}
+
+// GetIssueWorkflow returns the workflow an issue follows, found through the
+// workflow scheme of its project. The issue must include its project and
+// issue type.
+func (c *Client) GetIssueWorkflow(ctx context.Context, issue *Issue) (*Workflow, error) {
+	if issue.Fields.Project == nil || issue.Fields.IssueType == nil {
+		return nil, fmt.Errorf("issue %s has no project or issue type", issue.Key)
+	}
+
+	scheme, err := c.GetProjectWorkflowScheme(ctx, issue.Fields.Project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := scheme.DefaultWorkflow
+	if mapped, ok := scheme.IssueTypeMappings[issue.Fields.IssueType.ID]; ok {
+		name = mapped
+	}
+
+	return c.GetWorkflow(ctx, name)
+}
+
+// TransitionsFrom returns the transitions that can be taken from a status,
+// including global transitions, in the form returned by GetTransitions
+func (w *Workflow) TransitionsFrom(statusID string) []Transition {
+	names := make(map[string]string, len(w.Statuses))
+	for _, s := range w.Statuses {
+		names[s.ID] = s.Name
+	}
+
+	var transitions []Transition
+	for _, t := range w.Transitions {
+		if t.Type == "initial" {
+			continue
+		}
+		if len(t.From) > 0 && !slices.Contains(t.From, statusID) {
+			continue
+		}
+		transitions = append(transitions, Transition{
+			ID:   t.ID,
+			Name: t.Name,
+			To:   Status{ID: t.To, Name: names[t.To]},
+		})
+	}
+	return transitions
+}
@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetIssueWorkflow(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/workflowscheme/project":
+			assert.Equal(t, "10000", r.URL.Query().Get("projectId"))
+			w.Write([]byte(`{"values": [{"projectIds": ["10000"], "workflowScheme": {"id": 1, "defaultWorkflow": "jira", "issueTypeMappings": {"10002": "Bug flow"}}}]}`))
+		case "/rest/api/3/workflow/search":
+			assert.Equal(t, "Bug flow", r.URL.Query().Get("workflowName"))
+			assert.Equal(t, "statuses,transitions", r.URL.Query().Get("expand"))
+			w.Write([]byte(`{"values": [{
+				"id": {"name": "Bug flow"},
+				"statuses": [{"id": "1", "name": "Open"}, {"id": "2", "name": "Fixing"}, {"id": "3", "name": "Closed"}],
+				"transitions": [
+					{"id": "1", "name": "Create", "from": [], "to": "1", "type": "initial"},
+					{"id": "11", "name": "Fix", "from": ["1"], "to": "2", "type": "directed"},
+					{"id": "21", "name": "Close", "from": ["2"], "to": "3", "type": "directed"},
+					{"id": "31", "name": "Reopen", "from": [], "to": "1", "type": "global"}
+				]
+			}]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	issue := &Issue{Key: "PROJ-1", Fields: IssueFields{Project: &Project{ID: "10000"}, IssueType: &IssueType{ID: "10002"}}}
+	workflow, err := client.GetIssueWorkflow(context.Background(), issue)
+	require.NoError(t, err)
+	assert.Equal(t, "Bug flow", workflow.Name)
+
+	assert.Equal(t, []Transition{
+		{ID: "11", Name: "Fix", To: Status{ID: "2", Name: "Fixing"}},
+		{ID: "31", Name: "Reopen", To: Status{ID: "1", Name: "Open"}},
+	}, workflow.TransitionsFrom("1"))
+	assert.Equal(t, []Transition{
+		{ID: "31", Name: "Reopen", To: Status{ID: "1", Name: "Open"}},
+	}, workflow.TransitionsFrom("3"))
+}
@@ -0,0 +1,221 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/open-cli-collective/atlassian-go/client"
+)
+
+// Estimate adjustment modes for adding, updating and deleting worklogs
+const (
+	AdjustAuto   = "auto"
+	AdjustNew    = "new"
+	AdjustLeave  = "leave"
+	AdjustManual = "manual"
+)
+
+// EstimateAdjustment controls how the remaining estimate of an issue changes
+// when a worklog is added, updated or deleted. The zero value lets Jira
+// adjust the estimate automatically.
+type EstimateAdjustment struct {
+	// Mode is one of auto, new, leave or manual
+	Mode string
+	// NewEstimate is the remaining estimate to set in new mode, such as "2h"
+	NewEstimate string
+	// Amount is how much to change the estimate by in manual mode: reduced
+	// when adding a worklog and increased when deleting one
+	Amount string
+}
+
+// params returns the query parameters for the adjustment. manualParam
+// names the parameter for manual mode, or is empty if the operation does
+// not support it.
+func (a EstimateAdjustment) params(manualParam string) (map[string]string, error) {
+	params := map[string]string{}
+	switch a.Mode {
+	case "", AdjustAuto, AdjustLeave:
+	case AdjustNew:
+		if a.NewEstimate == "" {
+			return nil, fmt.Errorf("a new estimate is required with the %q adjustment", AdjustNew)
+		}
+		params["newEstimate"] = a.NewEstimate
+	case AdjustManual:
+		if manualParam == "" {
+			return nil, fmt.Errorf("the %q adjustment is not supported when updating a worklog", AdjustManual)
+		}
+		if a.Amount == "" {
+			return nil, fmt.Errorf("an amount is required with the %q adjustment", AdjustManual)
+		}
+		params[manualParam] = a.Amount
+	default:
+		return nil, fmt.Errorf("invalid estimate adjustment %q (expected auto, new, leave or manual)", a.Mode)
+	}
+	if a.Mode != "" {
+		params["adjustEstimate"] = a.Mode
+	}
+	return params, nil
+}
+
+// GetWorklogs returns a page of worklogs for an issue. If startedAfter is
+// not zero, only worklogs started at or after it are returned.
+func (c *Client) GetWorklogs(ctx context.Context, issueKey string, startAt, maxResults int, startedAfter time.Time) (*WorklogsResponse, error) {
+	if issueKey == "" {
+		return nil, ErrIssueKeyRequired
+	}
+
+	params := map[string]string{}
+	if startAt > 0 {
+		params["startAt"] = strconv.Itoa(startAt)
+	}
+	if maxResults > 0 {
+		params["maxResults"] = strconv.Itoa(maxResults)
+	}
+	if !startedAfter.IsZero() {
+		params["startedAfter"] = strconv.FormatInt(startedAfter.UnixMilli(), 10)
+	}
+
+	urlStr := buildURL(fmt.Sprintf("%s/issue/%s/worklog", c.BaseURL, url.PathEscape(issueKey)), params)
+	body, err := c.get(ctx, urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var result WorklogsResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse worklogs: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetAllWorklogs returns every worklog on an issue started at or after
+// startedAfter (or every worklog if it is zero), following pagination
+func (c *Client) GetAllWorklogs(ctx context.Context, issueKey string, startedAfter time.Time) ([]Worklog, error) {
+	return client.Collect(client.Paginate(ctx, func(ctx context.Context, cursor client.Cursor) ([]Worklog, *client.Cursor, error) {
+		result, err := c.GetWorklogs(ctx, issueKey, cursor.StartAt, 1000, startedAfter)
+		if err != nil {
+			return nil, nil, err
+		}
+		return result.Worklogs, client.NextOffset(cursor.StartAt, len(result.Worklogs), result.Total, false), nil
+	}), 0)
+}
+
+// AddWorklog logs time against an issue
+func (c *Client) AddWorklog(ctx context.Context, issueKey string, req *WorklogRequest, adjust EstimateAdjustment) (*Worklog, error) {
+	if issueKey == "" {
+		return nil, ErrIssueKeyRequired
+	}
+
+	params, err := adjust.params("reduceBy")
+	if err != nil {
+		return nil, err
+	}
+
+	urlStr := buildURL(fmt.Sprintf("%s/issue/%s/worklog", c.BaseURL, url.PathEscape(issueKey)), params)
+	body, err := c.post(ctx, urlStr, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var worklog Worklog
+	if err := json.Unmarshal(body, &worklog); err != nil {
+		return nil, fmt.Errorf("failed to parse worklog: %w", err)
+	}
+
+	return &worklog, nil
+}
+
+// UpdateWorklog updates a worklog. Manual estimate adjustment is not
+// supported by Jira for updates.
+func (c *Client) UpdateWorklog(ctx context.Context, issueKey, worklogID string, req *WorklogRequest, adjust EstimateAdjustment) (*Worklog, error) {
+	if issueKey == "" {
+		return nil, ErrIssueKeyRequired
+	}
+	if worklogID == "" {
+		return nil, fmt.Errorf("worklog ID is required")
+	}
+
+	params, err := adjust.params("")
+	if err != nil {
+		return nil, err
+	}
+
+	urlStr := buildURL(fmt.Sprintf("%s/issue/%s/worklog/%s", c.BaseURL, url.PathEscape(issueKey), url.PathEscape(worklogID)), params)
+	body, err := c.put(ctx, urlStr, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var worklog Worklog
+	if err := json.Unmarshal(body, &worklog); err != nil {
+		return nil, fmt.Errorf("failed to parse worklog: %w", err)
+	}
+
+	return &worklog, nil
+}
+
+// DeleteWorklog deletes a worklog from an issue
+func (c *Client) DeleteWorklog(ctx context.Context, issueKey, worklogID string, adjust EstimateAdjustment) error {
+	if issueKey == "" {
+		return ErrIssueKeyRequired
+	}
+	if worklogID == "" {
+		return fmt.Errorf("worklog ID is required")
+	}
+
+	params, err := adjust.params("increaseBy")
+	if err != nil {
+		return err
+	}
+
+	urlStr := buildURL(fmt.Sprintf("%s/issue/%s/worklog/%s", c.BaseURL, url.PathEscape(issueKey), url.PathEscape(worklogID)), params)
+	_, err = c.delete(ctx, urlStr)
+	return err
+}
+
+// durationPart matches one unit of a Jira duration, such as "30m" or "1.5h"
+var durationPart = regexp.MustCompile(`^(\d+(?:\.\d+)?)([wdhm])`)
+
+// NormalizeDuration checks a duration in Jira's format, such as "1h30m" or
+// "2d 4h", and returns it with the units separated by spaces, as Jira
+// expects. Days and weeks are left for Jira to convert, since their length
+// depends on the site's time tracking settings.
+func NormalizeDuration(s string) (string, error) {
+	rest := strings.ToLower(strings.TrimSpace(s))
+	if rest == "" {
+		return "", fmt.Errorf("duration is required")
+	}
+
+	var parts []string
+	for rest != "" {
+		m := durationPart.FindStringSubmatch(rest)
+		if m == nil {
+			return "", fmt.Errorf("invalid duration %q (expected a value such as 1h30m, 45m or 2d)", s)
+		}
+		parts = append(parts, m[0])
+		rest = strings.TrimLeft(rest[len(m[0]):], " ")
+	}
+	return strings.Join(parts, " "), nil
+}
+
+// FormatSeconds formats a number of seconds in hours and minutes, such as
+// "12h 30m". Days are not used, since their length varies between sites.
+func FormatSeconds(seconds int) string {
+	minutes := seconds / 60
+	hours, minutes := minutes/60, minutes%60
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%dm", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return fmt.Sprintf("%dh %dm", hours, minutes)
+	}
+}
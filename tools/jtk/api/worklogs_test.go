@@ -0,0 +1,159 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "1h30m", want: "1h 30m"},
+		{input: "45m", want: "45m"},
+		{input: " 1d 4h ", want: "1d 4h"},
+		{input: "1W2D", want: "1w 2d"},
+		{input: "1.5h", want: "1.5h"},
+		{input: "", wantErr: true},
+		{input: "90", wantErr: true},
+		{input: "1h30x", wantErr: true},
+		{input: "h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := NormalizeDuration(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFormatSeconds(t *testing.T) {
+	assert.Equal(t, "0m", FormatSeconds(0))
+	assert.Equal(t, "45m", FormatSeconds(2700))
+	assert.Equal(t, "2h", FormatSeconds(7200))
+	assert.Equal(t, "26h 30m", FormatSeconds(95400))
+}
+
+func TestEstimateAdjustment_Params(t *testing.T) {
+	tests := []struct {
+		name        string
+		adjust      EstimateAdjustment
+		manualParam string
+		want        map[string]string
+		wantErr     string
+	}{
+		{name: "default", want: map[string]string{}},
+		{name: "leave", adjust: EstimateAdjustment{Mode: AdjustLeave}, want: map[string]string{"adjustEstimate": "leave"}},
+		{
+			name:   "new",
+			adjust: EstimateAdjustment{Mode: AdjustNew, NewEstimate: "3h"},
+			want:   map[string]string{"adjustEstimate": "new", "newEstimate": "3h"},
+		},
+		{name: "new without estimate", adjust: EstimateAdjustment{Mode: AdjustNew}, wantErr: "new estimate is required"},
+		{
+			name:        "manual",
+			adjust:      EstimateAdjustment{Mode: AdjustManual, Amount: "1h"},
+			manualParam: "reduceBy",
+			want:        map[string]string{"adjustEstimate": "manual", "reduceBy": "1h"},
+		},
+		{name: "manual unsupported", adjust: EstimateAdjustment{Mode: AdjustManual, Amount: "1h"}, wantErr: "not supported"},
+		{name: "manual without amount", adjust: EstimateAdjustment{Mode: AdjustManual}, manualParam: "reduceBy", wantErr: "amount is required"},
+		{name: "invalid", adjust: EstimateAdjustment{Mode: "sometimes"}, wantErr: "invalid estimate adjustment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.adjust.params(tt.manualParam)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestClient_AddWorklog(t *testing.T) {
+	var got map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/worklog", r.URL.Path)
+		assert.Equal(t, "manual", r.URL.Query().Get("adjustEstimate"))
+		assert.Equal(t, "30m", r.URL.Query().Get("reduceBy"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "200", "timeSpent": "1h 30m", "timeSpentSeconds": 5400}`))
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	worklog, err := client.AddWorklog(context.Background(), "PROJ-1",
+		&WorklogRequest{TimeSpent: "1h 30m", Started: "2026-10-17T09:00:00.000+0000"},
+		EstimateAdjustment{Mode: AdjustManual, Amount: "30m"})
+	require.NoError(t, err)
+	assert.Equal(t, "200", worklog.ID)
+	assert.Equal(t, 5400, worklog.TimeSpentSeconds)
+	assert.Equal(t, map[string]interface{}{"timeSpent": "1h 30m", "started": "2026-10-17T09:00:00.000+0000"}, got)
+}
+
+func TestClient_GetAllWorklogs(t *testing.T) {
+	since := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/worklog", r.URL.Path)
+		assert.Equal(t, "1790812800000", r.URL.Query().Get("startedAfter"))
+		starts = append(starts, r.URL.Query().Get("startAt"))
+		if r.URL.Query().Get("startAt") == "" {
+			w.Write([]byte(`{"startAt": 0, "total": 3, "worklogs": [{"id": "1"}, {"id": "2"}]}`))
+			return
+		}
+		w.Write([]byte(`{"startAt": 2, "total": 3, "worklogs": [{"id": "3"}]}`))
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	worklogs, err := client.GetAllWorklogs(context.Background(), "PROJ-1", since)
+	require.NoError(t, err)
+	assert.Len(t, worklogs, 3)
+	assert.Equal(t, []string{"", "2"}, starts)
+}
+
+func TestClient_DeleteWorklog(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/worklog/200", r.URL.Path)
+		assert.Equal(t, "new", r.URL.Query().Get("adjustEstimate"))
+		assert.Equal(t, "4h", r.URL.Query().Get("newEstimate"))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := New(ClientConfig{URL: server.URL, Email: "user@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	err = client.DeleteWorklog(context.Background(), "PROJ-1", "200", EstimateAdjustment{Mode: AdjustNew, NewEstimate: "4h"})
+	require.NoError(t, err)
+
+	_, err = client.UpdateWorklog(context.Background(), "PROJ-1", "200", &WorklogRequest{}, EstimateAdjustment{Mode: AdjustManual, Amount: "1h"})
+	assert.ErrorContains(t, err, "not supported")
+}
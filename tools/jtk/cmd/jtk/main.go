@@ -2,34 +2,56 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/open-cli-collective/atlassian-go/exitcode"
 
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/adfcmd"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/attachments"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/automation"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/backlog"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/boards"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/comments"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/completion"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/configcmd"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/initcmd"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/issues"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/links"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/me"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/report"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/sprints"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/transitions"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/users"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/votes"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/watchers"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/worklog"
 )
 
 func main() {
-	if err := run(); err != nil {
+	// Ctrl-C cancels in-flight requests instead of killing the process
+	// mid-write
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := run(ctx)
+	interrupted := ctx.Err() != nil
+	stop()
+
+	if err != nil {
+		if interrupted && errors.Is(err, context.Canceled) {
+			fmt.Fprintln(os.Stderr, "Interrupted")
+			os.Exit(exitcode.Interrupted)
+		}
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(exitcode.GeneralError)
 	}
 }
 
-func run() error {
+func run(ctx context.Context) error {
 	rootCmd, opts := root.NewCmd()
 
 	// Register all commands
@@ -37,14 +59,21 @@ func run() error {
 	configcmd.Register(rootCmd, opts)
 	issues.Register(rootCmd, opts)
 	transitions.Register(rootCmd, opts)
+	links.Register(rootCmd, opts)
+	worklog.Register(rootCmd, opts)
+	report.Register(rootCmd, opts)
+	watchers.Register(rootCmd, opts)
+	votes.Register(rootCmd, opts)
 	comments.Register(rootCmd, opts)
 	attachments.Register(rootCmd, opts)
 	automation.Register(rootCmd, opts)
 	boards.Register(rootCmd, opts)
 	sprints.Register(rootCmd, opts)
+	backlog.Register(rootCmd, opts)
 	users.Register(rootCmd, opts)
 	me.Register(rootCmd, opts)
+	adfcmd.Register(rootCmd, opts)
 	completion.Register(rootCmd, opts)
 
-	return rootCmd.Execute()
+	return errors.Join(rootCmd.ExecuteContext(ctx), opts.Close())
 }
@@ -0,0 +1,227 @@
+// Package bulk applies a change to every issue matching a JQL query.
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	sharederrors "github.com/open-cli-collective/atlassian-go/errors"
+	"github.com/open-cli-collective/atlassian-go/prompt"
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+const (
+	// DefaultConcurrency is the number of issues changed at once
+	DefaultConcurrency = 4
+
+	// MaxConcurrency caps --concurrency to stay clear of Jira's rate limits
+	MaxConcurrency = 10
+
+	// defaultRateLimitPause is how long to pause after a rate-limited
+	// request that does not say how long to wait
+	defaultRateLimitPause = 5 * time.Second
+
+	// maxRateLimitRetries is how many times a rate-limited change is retried
+	maxRateLimitRetries = 3
+)
+
+// Flags are the options of a command run against a JQL query
+type Flags struct {
+	JQL         string
+	Max         int
+	Concurrency int
+	Force       bool
+}
+
+// AddFlags registers the bulk flags on cmd
+func AddFlags(cmd *cobra.Command, f *Flags) {
+	cmd.Flags().StringVar(&f.JQL, "jql", "", "Apply to every issue matching this JQL query instead of one issue")
+	cmd.Flags().IntVar(&f.Max, "max", 0, "With --jql, change at most this many issues (default all)")
+	cmd.Flags().IntVar(&f.Concurrency, "concurrency", DefaultConcurrency, "With --jql, number of issues to change at once")
+	cmd.Flags().BoolVar(&f.Force, "force", false, "With --jql, skip the confirmation prompt")
+}
+
+// Op changes one issue
+type Op func(ctx context.Context, issue *api.Issue) error
+
+// Result is the outcome of a change to one issue
+type Result struct {
+	Key     string `json:"key"`
+	Summary string `json:"summary"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Run finds the issues matching f.JQL, shows how many there are and asks
+// for confirmation unless f.Force is set, then applies op to each of them.
+// change describes op for the prompt, e.g. `transition to "Done"`. The
+// outcome for each issue is printed, and an error is returned if any
+// change failed.
+func Run(ctx context.Context, opts *root.Options, f Flags, change string, op Op) error {
+	v := opts.View()
+
+	if f.Concurrency < 1 || f.Concurrency > MaxConcurrency {
+		return fmt.Errorf("--concurrency must be between 1 and %d", MaxConcurrency)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	issues, err := client.SearchAll(ctx, f.JQL, f.Max)
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		v.Info("No issues match %s", f.JQL)
+		return nil
+	}
+
+	_, _ = fmt.Fprintf(opts.Stderr, "Found %d issue(s) matching: %s\nChange: %s\n", len(issues), f.JQL, change)
+	if !f.Force {
+		_, _ = fmt.Fprint(opts.Stderr, "Continue? [y/N]: ")
+	}
+	confirmed, err := prompt.ConfirmOrForce(f.Force, opts.Stdin)
+	if err != nil {
+		return fmt.Errorf("failed to read confirmation: %w", err)
+	}
+	if !confirmed {
+		v.Info("Cancelled.")
+		return nil
+	}
+
+	errs := Apply(ctx, issues, f.Concurrency, op)
+	return report(opts, issues, errs)
+}
+
+// Apply calls op for each issue, with at most concurrency calls in flight,
+// and returns the error of each call in issue order.
+//
+// The client already retries rate-limited reads, but not writes such as
+// transitions. When a call is rate-limited, every worker pauses for the
+// delay Jira asks for and the call is tried again.
+func Apply(ctx context.Context, issues []api.Issue, concurrency int, op Op) []error {
+	errs := make([]error, len(issues))
+	gate := &pauseGate{}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(concurrency, len(issues)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				errs[i] = gate.do(ctx, func() error {
+					return op(ctx, &issues[i])
+				})
+			}
+		}()
+	}
+
+	for i := range issues {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errs
+}
+
+// pauseGate holds back every worker once any of them is rate-limited
+type pauseGate struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+func (g *pauseGate) do(ctx context.Context, fn func() error) error {
+	for attempt := 0; ; attempt++ {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+
+		err := fn()
+		if err == nil || !sharederrors.IsRateLimited(err) || attempt == maxRateLimitRetries {
+			return err
+		}
+
+		delay := sharederrors.RetryAfter(err)
+		if delay <= 0 {
+			delay = defaultRateLimitPause
+		}
+		g.pause(delay)
+	}
+}
+
+func (g *pauseGate) pause(d time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if until := time.Now().Add(d); until.After(g.until) {
+		g.until = until
+	}
+}
+
+func (g *pauseGate) wait(ctx context.Context) error {
+	g.mu.Lock()
+	d := time.Until(g.until)
+	g.mu.Unlock()
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// report prints the outcome for each issue and returns an error if any
+// change failed
+func report(opts *root.Options, issues []api.Issue, errs []error) error {
+	v := opts.View()
+
+	results := make([]Result, len(issues))
+	failed := 0
+	for i, issue := range issues {
+		results[i] = Result{Key: issue.Key, Summary: issue.Fields.Summary, Status: "ok"}
+		if errs[i] != nil {
+			results[i].Status = "failed"
+			results[i].Error = errs[i].Error()
+			failed++
+		}
+	}
+
+	if view.IsStructured(opts.Output) {
+		if err := v.JSON(results); err != nil {
+			return err
+		}
+	} else {
+		headers := []string{"KEY", "SUMMARY", "STATUS", "ERROR"}
+		rows := make([][]string, len(results))
+		for i, r := range results {
+			rows[i] = []string{r.Key, view.Truncate(r.Summary, 50), r.Status, r.Error}
+		}
+		if err := v.Table(headers, rows); err != nil {
+			return err
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d issue(s) failed", failed, len(issues))
+	}
+	if !view.IsMachineReadable(opts.Output) {
+		v.Success("Changed %d issue(s)", len(issues))
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sharederrors "github.com/open-cli-collective/atlassian-go/errors"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+func testIssues(n int) []api.Issue {
+	issues := make([]api.Issue, n)
+	for i := range issues {
+		issues[i] = api.Issue{Key: fmt.Sprintf("PROJ-%d", i+1), Fields: api.IssueFields{Summary: fmt.Sprintf("Issue %d", i+1)}}
+	}
+	return issues
+}
+
+// rateLimitError returns a 429 error asking to retry after d
+func rateLimitError(d time.Duration) error {
+	err := sharederrors.ParseAPIError(http.StatusTooManyRequests, nil)
+	var apiErr *sharederrors.APIError
+	if errors.As(err, &apiErr) {
+		apiErr.RetryAfter = d
+	}
+	return err
+}
+
+func TestApply_BoundsConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	errs := Apply(context.Background(), testIssues(20), 3, func(ctx context.Context, issue *api.Issue) error {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		inFlight.Add(-1)
+		if issue.Key == "PROJ-7" {
+			return fmt.Errorf("boom")
+		}
+		return nil
+	})
+
+	require.Len(t, errs, 20)
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+	for i, err := range errs {
+		if i == 6 {
+			assert.EqualError(t, err, "boom")
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func TestApply_PausesWhenRateLimited(t *testing.T) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+
+	start := time.Now()
+	errs := Apply(context.Background(), testIssues(2), 2, func(ctx context.Context, issue *api.Issue) error {
+		mu.Lock()
+		defer mu.Unlock()
+		calls[issue.Key]++
+		if issue.Key == "PROJ-1" && calls[issue.Key] == 1 {
+			return rateLimitError(100 * time.Millisecond)
+		}
+		return nil
+	})
+
+	assert.Equal(t, []error{nil, nil}, errs)
+	assert.Equal(t, 2, calls["PROJ-1"])
+	assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestApply_GivesUpAfterRetries(t *testing.T) {
+	calls := 0
+	g := &pauseGate{}
+	err := g.do(context.Background(), func() error {
+		calls++
+		return rateLimitError(time.Millisecond)
+	})
+
+	assert.True(t, sharederrors.IsRateLimited(err))
+	assert.Equal(t, maxRateLimitRetries+1, calls)
+}
+
+func newSearchServer(t *testing.T, issues []api.Issue) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/search/jql", r.URL.Path)
+		json.NewEncoder(w).Encode(map[string]interface{}{"issues": issues, "isLast": true})
+	}))
+}
+
+func newTestOptions(t *testing.T, serverURL, stdin string) (*root.Options, *bytes.Buffer, *bytes.Buffer) {
+	client, err := api.New(api.ClientConfig{URL: serverURL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	var stdout, stderr bytes.Buffer
+	opts := &root.Options{Output: "table", Stdin: strings.NewReader(stdin), Stdout: &stdout, Stderr: &stderr}
+	opts.SetAPIClient(client)
+	return opts, &stdout, &stderr
+}
+
+func TestRun(t *testing.T) {
+	server := newSearchServer(t, testIssues(3))
+	defer server.Close()
+
+	opts, stdout, stderr := newTestOptions(t, server.URL, "y\n")
+	var changed []string
+	var mu sync.Mutex
+	err := Run(context.Background(), opts, Flags{JQL: "status = Review", Concurrency: 2}, `transition "To Do"`, func(ctx context.Context, issue *api.Issue) error {
+		mu.Lock()
+		changed = append(changed, issue.Key)
+		mu.Unlock()
+		if issue.Key == "PROJ-2" {
+			return fmt.Errorf("transition %q is not available", "To Do")
+		}
+		return nil
+	})
+
+	assert.EqualError(t, err, "1 of 3 issue(s) failed")
+	assert.ElementsMatch(t, []string{"PROJ-1", "PROJ-2", "PROJ-3"}, changed)
+	assert.Contains(t, stderr.String(), "Found 3 issue(s) matching: status = Review")
+	assert.Contains(t, stderr.String(), `Change: transition "To Do"`)
+
+	lines := strings.Split(stdout.String(), "\n")
+	assert.Contains(t, lines[1], "PROJ-1")
+	assert.Contains(t, lines[1], "ok")
+	assert.Contains(t, lines[2], "failed")
+	assert.Contains(t, lines[2], `transition "To Do" is not available`)
+}
+
+func TestRun_Cancelled(t *testing.T) {
+	server := newSearchServer(t, testIssues(2))
+	defer server.Close()
+
+	opts, stdout, _ := newTestOptions(t, server.URL, "n\n")
+	err := Run(context.Background(), opts, Flags{JQL: "project = PROJ", Concurrency: 1}, "unassign", func(ctx context.Context, issue *api.Issue) error {
+		t.Errorf("unexpected change to %s", issue.Key)
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Cancelled.")
+}
+
+func TestRun_ForceJSON(t *testing.T) {
+	server := newSearchServer(t, testIssues(2))
+	defer server.Close()
+
+	opts, stdout, stderr := newTestOptions(t, server.URL, "")
+	opts.Output = "json"
+	err := Run(context.Background(), opts, Flags{JQL: "project = PROJ", Concurrency: 2, Force: true}, "unassign", func(ctx context.Context, issue *api.Issue) error {
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.NotContains(t, stderr.String(), "Continue?")
+
+	var results []Result
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &results))
+	assert.Equal(t, []Result{
+		{Key: "PROJ-1", Summary: "Issue 1", Status: "ok"},
+		{Key: "PROJ-2", Summary: "Issue 2", Status: "ok"},
+	}, results)
+}
+
+func TestRun_CSV(t *testing.T) {
+	server := newSearchServer(t, testIssues(2))
+	defer server.Close()
+
+	opts, stdout, _ := newTestOptions(t, server.URL, "")
+	opts.Output = "csv"
+	err := Run(context.Background(), opts, Flags{JQL: "project = PROJ", Concurrency: 2, Force: true}, "unassign", func(ctx context.Context, issue *api.Issue) error {
+		return nil
+	})
+
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	assert.Len(t, lines, 3, "a header and a row per issue, without the summary")
+	assert.NotContains(t, stdout.String(), "Changed")
+}
+
+func TestRun_InvalidConcurrency(t *testing.T) {
+	opts := &root.Options{}
+	err := Run(context.Background(), opts, Flags{JQL: "project = PROJ", Concurrency: MaxConcurrency + 1}, "unassign", nil)
+	assert.ErrorContains(t, err, "--concurrency")
+}
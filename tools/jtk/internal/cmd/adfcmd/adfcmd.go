@@ -0,0 +1,88 @@
+package adfcmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/adf"
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// Register registers the adf commands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:   "adf",
+		Short: "Work with Atlassian Document Format",
+		Long:  "Commands for working with Atlassian Document Format (ADF), the JSON format of Jira rich text.",
+	}
+
+	cmd.AddCommand(newValidateCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+func newValidateCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate <file>",
+		Short: "Validate an ADF document",
+		Long: `Check an ADF JSON document against the ADF schema without sending it to Jira.
+
+Each problem is reported with the JSON pointer of the offending node or
+attribute. Use - to read the document from stdin.`,
+		Example: `  jtk adf validate description.json
+  cat comment.json | jtk adf validate -
+  jtk adf validate description.json -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runValidate(opts, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runValidate(opts *root.Options, file string) error {
+	v := opts.View()
+
+	var data []byte
+	var err error
+	if file == "-" {
+		data, err = io.ReadAll(opts.Stdin)
+	} else {
+		data, err = os.ReadFile(file)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", file, err)
+	}
+
+	doc, err := adf.ParseJSON(data)
+	if err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+	problems := adf.Validate(doc)
+
+	if view.IsStructured(opts.Output) {
+		if problems == nil {
+			problems = []adf.Problem{}
+		}
+		if err := v.JSON(problems); err != nil {
+			return err
+		}
+		if len(problems) > 0 {
+			return fmt.Errorf("%s: %d problem(s) found", file, len(problems))
+		}
+		return nil
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%s: %w", file, &adf.ValidationError{Problems: problems})
+	}
+
+	v.Success("%s is valid ADF", file)
+	return nil
+}
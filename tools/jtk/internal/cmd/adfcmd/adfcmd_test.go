@@ -0,0 +1,67 @@
+package adfcmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/atlassian-go/adf"
+
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+const invalidDoc = `{"type":"doc","version":1,"content":[{"type":"heading","attrs":{"level":9},"content":[{"type":"text","text":"Title"}]}]}`
+
+func writeFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "doc.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestRunValidate_Valid(t *testing.T) {
+	var stdout bytes.Buffer
+	opts := &root.Options{Output: "table", Stdout: &stdout, Stderr: &bytes.Buffer{}}
+
+	path := writeFile(t, `{"type":"doc","version":1,"content":[{"type":"paragraph","content":[{"type":"text","text":"Hi"}]}]}`)
+	require.NoError(t, runValidate(opts, path))
+	assert.Contains(t, stdout.String(), "is valid ADF")
+}
+
+func TestRunValidate_Problems(t *testing.T) {
+	opts := &root.Options{Output: "table", Stdout: &bytes.Buffer{}, Stderr: &bytes.Buffer{}}
+
+	err := runValidate(opts, writeFile(t, invalidDoc))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "/content/0/attrs/level: must be between 1 and 6, got 9")
+}
+
+func TestRunValidate_JSONFromStdin(t *testing.T) {
+	var stdout bytes.Buffer
+	opts := &root.Options{
+		Output: "json",
+		Stdin:  strings.NewReader(invalidDoc),
+		Stdout: &stdout,
+		Stderr: &bytes.Buffer{},
+	}
+
+	err := runValidate(opts, "-")
+	assert.EqualError(t, err, "-: 1 problem(s) found")
+
+	var problems []adf.Problem
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &problems))
+	assert.Equal(t, []adf.Problem{{Path: "/content/0/attrs/level", Message: "must be between 1 and 6, got 9"}}, problems)
+}
+
+func TestRunValidate_InvalidJSON(t *testing.T) {
+	opts := &root.Options{Output: "table", Stdout: &bytes.Buffer{}, Stderr: &bytes.Buffer{}}
+
+	err := runValidate(opts, writeFile(t, `{"type":"doc","version":1,"contents":[]}`))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown field "contents"`)
+}
@@ -2,6 +2,7 @@ package automation
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -59,7 +60,7 @@ func TestRunCreate(t *testing.T) {
 		err = os.WriteFile(filePath, []byte(`{"name":"Test Rule","state":"DISABLED"}`), 0644)
 		require.NoError(t, err)
 
-		err = runCreate(opts, filePath)
+		err = runCreate(context.Background(), opts, filePath)
 		require.NoError(t, err)
 		assert.Contains(t, stdout.String(), "Test Rule")
 		assert.Contains(t, stdout.String(), "new-uuid")
@@ -79,7 +80,7 @@ func TestRunCreate(t *testing.T) {
 			Stderr: &stderr,
 		}
 
-		err = runCreate(opts, filePath)
+		err = runCreate(context.Background(), opts, filePath)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "does not contain valid JSON")
 	})
@@ -92,7 +93,7 @@ func TestRunCreate(t *testing.T) {
 			Stderr: &stderr,
 		}
 
-		err := runCreate(opts, "/nonexistent/path/rule.json")
+		err := runCreate(context.Background(), opts, "/nonexistent/path/rule.json")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to read file")
 	})
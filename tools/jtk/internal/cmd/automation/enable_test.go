@@ -2,6 +2,7 @@ package automation
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -53,7 +54,7 @@ func TestRunSetState_AlreadyEnabled(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runSetState(opts, "42", true)
+	err = runSetState(context.Background(), opts, "42", true)
 	require.NoError(t, err)
 	assert.Contains(t, stdout.String(), "already ENABLED")
 }
@@ -83,7 +84,7 @@ func TestRunSetState_AlreadyDisabled(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runSetState(opts, "42", false)
+	err = runSetState(context.Background(), opts, "42", false)
 	require.NoError(t, err)
 	assert.Contains(t, stdout.String(), "already DISABLED")
 }
@@ -130,7 +131,7 @@ func TestRunSetState_EnableDisabledRule(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runSetState(opts, "42", true)
+	err = runSetState(context.Background(), opts, "42", true)
 	require.NoError(t, err)
 	assert.Contains(t, stdout.String(), "DISABLED")
 	assert.Contains(t, stdout.String(), "ENABLED")
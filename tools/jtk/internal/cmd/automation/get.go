@@ -1,11 +1,14 @@
 package automation
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
 	"github.com/open-cli-collective/jira-ticket-cli/api"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
@@ -27,7 +30,7 @@ For the exact JSON needed for editing, use 'jtk auto export' instead.`,
   jtk auto get 12345 -o json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runGet(opts, args[0], full)
+			return runGet(cmd.Context(), opts, args[0], full)
 		},
 	}
 
@@ -36,7 +39,7 @@ For the exact JSON needed for editing, use 'jtk auto export' instead.`,
 	return cmd
 }
 
-func runGet(opts *root.Options, ruleID string, full bool) error {
+func runGet(ctx context.Context, opts *root.Options, ruleID string, full bool) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -44,12 +47,12 @@ func runGet(opts *root.Options, ruleID string, full bool) error {
 		return err
 	}
 
-	rule, err := client.GetAutomationRule(ruleID)
+	rule, err := client.GetAutomationRule(ctx, ruleID)
 	if err != nil {
 		return err
 	}
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(rule)
 	}
 
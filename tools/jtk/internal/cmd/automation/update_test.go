@@ -2,6 +2,7 @@ package automation
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"path/filepath"
 	"testing"
@@ -26,7 +27,7 @@ func TestRunUpdate(t *testing.T) {
 			Stderr: &stderr,
 		}
 
-		err = runUpdate(opts, "12345", filePath)
+		err = runUpdate(context.Background(), opts, "12345", filePath)
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "does not contain valid JSON")
 	})
@@ -39,7 +40,7 @@ func TestRunUpdate(t *testing.T) {
 			Stderr: &stderr,
 		}
 
-		err := runUpdate(opts, "12345", "/nonexistent/path/rule.json")
+		err := runUpdate(context.Background(), opts, "12345", "/nonexistent/path/rule.json")
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to read file")
 	})
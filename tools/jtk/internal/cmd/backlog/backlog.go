@@ -0,0 +1,170 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// Register registers the backlog and rank commands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:     "backlog",
+		Aliases: []string{"bl"},
+		Short:   "Manage the backlog",
+		Long:    "Commands for viewing a board's backlog and moving issues to it.",
+	}
+
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newMoveCmd(opts))
+
+	parent.AddCommand(cmd)
+	parent.AddCommand(newRankCmd(opts))
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	var boardID int
+	var maxResults int
+	var all bool
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the backlog of a board",
+		Long:  "List the issues in a board's backlog, highest ranked first.",
+		Example: `  jtk backlog list --board 123
+  jtk backlog list --board 123 --all`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if boardID == 0 {
+				return fmt.Errorf("--board is required")
+			}
+			return runList(cmd.Context(), opts, boardID, maxResults, all)
+		},
+	}
+
+	cmd.Flags().IntVarP(&boardID, "board", "b", 0, "Board ID (required)")
+	cmd.Flags().IntVarP(&maxResults, "max", "m", 50, "Maximum number of results")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch all results, ignoring --max")
+
+	return cmd
+}
+
+func runList(ctx context.Context, opts *root.Options, boardID int, maxResults int, all bool) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	headers := []string{"KEY", "SUMMARY", "STATUS", "ASSIGNEE", "TYPE"}
+	var columns []api.FieldColumn
+	if !view.IsStructured(opts.Output) {
+		columns, err = client.ResolveFieldColumns(ctx, opts.Columns, headers)
+		if err != nil {
+			return err
+		}
+	}
+
+	var issues []api.Issue
+	if all {
+		issues, err = client.GetAllBoardBacklog(ctx, boardID)
+	} else {
+		var result *api.SearchResult
+		result, err = client.GetBoardBacklog(ctx, boardID, 0, maxResults)
+		if result != nil {
+			issues = result.Issues
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		if issues == nil {
+			issues = []api.Issue{}
+		}
+		return v.JSON(issues)
+	}
+
+	if len(issues) == 0 {
+		v.Info("Backlog is empty")
+		return nil
+	}
+
+	for _, col := range columns {
+		headers = append(headers, col.Name)
+	}
+	var rows [][]string
+
+	for _, issue := range issues {
+		status := ""
+		if issue.Fields.Status != nil {
+			status = issue.Fields.Status.Name
+		}
+
+		assignee := ""
+		if issue.Fields.Assignee != nil {
+			assignee = issue.Fields.Assignee.DisplayName
+		}
+
+		issueType := ""
+		if issue.Fields.IssueType != nil {
+			issueType = issue.Fields.IssueType.Name
+		}
+
+		row := []string{
+			issue.Key,
+			view.Truncate(issue.Fields.Summary, 50),
+			status,
+			assignee,
+			issueType,
+		}
+		rows = append(rows, append(row, api.FieldColumnValues(issue, columns)...))
+	}
+
+	return v.Table(headers, rows)
+}
+
+func newMoveCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "move <issue-key>...",
+		Short: "Move issues to the backlog",
+		Long:  "Remove one or more issues from their sprints, returning them to the backlog.",
+		Example: `  jtk backlog move PROJ-123
+  jtk backlog move PROJ-123 PROJ-124 PROJ-125`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMove(cmd.Context(), opts, args)
+		},
+	}
+
+	return cmd
+}
+
+func runMove(ctx context.Context, opts *root.Options, issueKeys []string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.MoveIssuesToBacklog(ctx, issueKeys); err != nil {
+		return err
+	}
+
+	if len(issueKeys) == 1 {
+		v.Success("Moved %s to the backlog", issueKeys[0])
+	} else {
+		v.Success("Moved %d issues to the backlog", len(issueKeys))
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package backlog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/internal/testutil"
+)
+
+func TestRunList(t *testing.T) {
+	opts, stdout, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/board/42/backlog", r.URL.Path)
+		assert.Equal(t, "10", r.URL.Query().Get("maxResults"))
+		fmt.Fprint(w, `{"total": 2, "issues": [
+			{"key": "PROJ-2", "fields": {"summary": "Top", "status": {"name": "To Do"}, "issuetype": {"name": "Story"}}},
+			{"key": "PROJ-1", "fields": {"summary": "Next", "status": {"name": "To Do"}, "issuetype": {"name": "Bug"}}}
+		]}`)
+	})
+
+	require.NoError(t, runList(context.Background(), opts, 42, 10, false))
+
+	out := stdout.String()
+	assert.Contains(t, out, "PROJ-2")
+	assert.Less(t, bytes.Index(stdout.Bytes(), []byte("PROJ-2")), bytes.Index(stdout.Bytes(), []byte("PROJ-1")))
+}
+
+func TestRunMove(t *testing.T) {
+	var body string
+	opts, stdout, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/backlog/issue", r.URL.Path)
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	require.NoError(t, runMove(context.Background(), opts, []string{"PROJ-1", "PROJ-2"}))
+	assert.JSONEq(t, `{"issues": ["PROJ-1", "PROJ-2"]}`, body)
+	assert.Contains(t, stdout.String(), "Moved 2 issues to the backlog")
+}
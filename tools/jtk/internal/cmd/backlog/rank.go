@@ -0,0 +1,128 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	sharederrors "github.com/open-cli-collective/atlassian-go/errors"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// orderByPattern finds the ORDER BY clause of a JQL query
+var orderByPattern = regexp.MustCompile(`(?i)\border\s+by\s+(.+)$`)
+
+func newRankCmd(opts *root.Options) *cobra.Command {
+	var before, after string
+	var boardID int
+
+	cmd := &cobra.Command{
+		Use:   "rank <issue-key>... (--before|--after) <issue-key>",
+		Short: "Rank issues before or after another issue",
+		Long: `Rank one or more issues before or after another issue. Several issues
+are kept together, in the order given.
+
+Ranking only works on boards whose filter is ordered by Rank. With --board,
+the board is checked first, to say why ranking is disabled on it, and its
+rank field is used. Without it, Jira only rejects the request.`,
+		Example: `  # Move an issue above another
+  jtk rank PROJ-123 --before PROJ-100
+
+  # Put several issues, in order, below another
+  jtk rank PROJ-5 PROJ-7 PROJ-6 --after PROJ-100
+
+  # Rank on a given board
+  jtk rank PROJ-123 --before PROJ-100 --board 42`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRank(cmd.Context(), opts, args, before, after, boardID)
+		},
+	}
+
+	cmd.Flags().StringVar(&before, "before", "", "Rank the issues before this issue")
+	cmd.Flags().StringVar(&after, "after", "", "Rank the issues after this issue")
+	cmd.Flags().IntVarP(&boardID, "board", "b", 0, "Board to rank on (default Jira's rank field)")
+
+	return cmd
+}
+
+func runRank(ctx context.Context, opts *root.Options, issueKeys []string, before, after string, boardID int) error {
+	v := opts.View()
+
+	if (before == "") == (after == "") {
+		return fmt.Errorf("exactly one of --before and --after is required")
+	}
+	target, position := before, "before"
+	if after != "" {
+		target, position = after, "after"
+	}
+	if slices.ContainsFunc(issueKeys, func(key string) bool { return strings.EqualFold(key, target) }) {
+		return fmt.Errorf("cannot rank %s %s itself", target, position)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	var rankFieldID int64
+	if boardID != 0 {
+		if rankFieldID, err = boardRankField(ctx, client, boardID); err != nil {
+			return err
+		}
+	}
+
+	if err := client.RankIssues(ctx, issueKeys, before, after, rankFieldID); err != nil {
+		if boardID == 0 && sharederrors.IsBadRequest(err) {
+			return fmt.Errorf("%w (one possible cause is a board whose filter is not ordered by Rank, which disables ranking; use --board to check a board)", err)
+		}
+		return err
+	}
+
+	if len(issueKeys) == 1 {
+		v.Success("Ranked %s %s %s", issueKeys[0], position, target)
+	} else {
+		v.Success("Ranked %d issues %s %s", len(issueKeys), position, target)
+	}
+
+	return nil
+}
+
+// boardRankField returns the rank field of a board, or an error saying
+// why ranking is disabled on it
+func boardRankField(ctx context.Context, client *api.Client, boardID int) (int64, error) {
+	config, err := client.GetBoardConfiguration(ctx, boardID)
+	if err != nil {
+		return 0, err
+	}
+	if config.Ranking == nil || config.Ranking.RankCustomFieldID == 0 {
+		return 0, fmt.Errorf("ranking is disabled on board %d (%s)", boardID, config.Name)
+	}
+
+	// Jira only ranks on boards whose filter is ordered by Rank. The filter
+	// may not be readable, in which case ranking is left to fail in Jira.
+	if config.Filter.ID != "" {
+		if filter, err := client.GetFilter(ctx, config.Filter.ID); err == nil && !orderedByRank(filter.JQL) {
+			return 0, fmt.Errorf("ranking is disabled on board %d (%s): its filter %q is not ordered by Rank; add ORDER BY Rank ASC to the filter to enable it",
+				boardID, config.Name, filter.Name)
+		}
+	}
+
+	return config.Ranking.RankCustomFieldID, nil
+}
+
+// orderedByRank reports whether a JQL query sorts first by Rank
+func orderedByRank(jql string) bool {
+	m := orderByPattern.FindStringSubmatch(strings.TrimSpace(jql))
+	if m == nil {
+		return false
+	}
+	fields := strings.Fields(strings.Split(m[1], ",")[0])
+	return len(fields) > 0 && strings.EqualFold(strings.Trim(fields[0], `"`), "rank")
+}
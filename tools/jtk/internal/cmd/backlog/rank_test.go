@@ -0,0 +1,116 @@
+package backlog
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/internal/testutil"
+)
+
+func TestOrderedByRank(t *testing.T) {
+	tests := []struct {
+		jql  string
+		want bool
+	}{
+		{jql: "project = PROJ ORDER BY Rank ASC", want: true},
+		{jql: "project = PROJ order by rank", want: true},
+		{jql: `project = PROJ ORDER BY "Rank" ASC, created DESC`, want: true},
+		{jql: "project = PROJ ORDER BY created DESC, Rank ASC", want: false},
+		{jql: "project = PROJ", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.jql, func(t *testing.T) {
+			assert.Equal(t, tt.want, orderedByRank(tt.jql))
+		})
+	}
+}
+
+// rankServer fakes a board with the given filter, recording rank requests
+func rankServer(t *testing.T, ranking, jql string, ranked *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/agile/1.0/board/42/configuration":
+			fmt.Fprintf(w, `{"id": 42, "name": "Team board", "filter": {"id": "100"}%s}`, ranking)
+		case "/rest/api/3/filter/100":
+			fmt.Fprintf(w, `{"id": "100", "name": "Team filter", "jql": %q}`, jql)
+		case "/rest/agile/1.0/issue/rank":
+			b, _ := io.ReadAll(r.Body)
+			*ranked = append(*ranked, string(b))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}
+}
+
+func TestRunRank_Board(t *testing.T) {
+	var ranked []string
+	opts, stdout, _ := testutil.NewOptions(t, rankServer(t, `, "ranking": {"rankCustomFieldId": 10019}`, "project = PROJ ORDER BY Rank ASC", &ranked))
+
+	require.NoError(t, runRank(context.Background(), opts, []string{"PROJ-3", "PROJ-4"}, "", "PROJ-1", 42))
+
+	assert.Equal(t, []string{`{"issues":["PROJ-3","PROJ-4"],"rankAfterIssue":"PROJ-1","rankCustomFieldId":10019}`}, ranked)
+	assert.Contains(t, stdout.String(), "Ranked 2 issues after PROJ-1")
+}
+
+func TestRunRank_RankingDisabled(t *testing.T) {
+	tests := []struct {
+		name    string
+		ranking string
+		jql     string
+		wantErr string
+	}{
+		{
+			name:    "no rank field",
+			jql:     "project = PROJ ORDER BY Rank ASC",
+			wantErr: "ranking is disabled on board 42 (Team board)",
+		},
+		{
+			name:    "filter not ordered by rank",
+			ranking: `, "ranking": {"rankCustomFieldId": 10019}`,
+			jql:     "project = PROJ ORDER BY created DESC",
+			wantErr: `its filter "Team filter" is not ordered by Rank`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ranked []string
+			opts, _, _ := testutil.NewOptions(t, rankServer(t, tt.ranking, tt.jql, &ranked))
+
+			err := runRank(context.Background(), opts, []string{"PROJ-3"}, "PROJ-1", "", 42)
+			assert.ErrorContains(t, err, tt.wantErr)
+			assert.Empty(t, ranked)
+		})
+	}
+}
+
+func TestRunRank_RejectedWithoutBoard(t *testing.T) {
+	opts, _, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/agile/1.0/issue/rank", r.URL.Path)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages": ["Rank operation failed"]}`)
+	})
+
+	err := runRank(context.Background(), opts, []string{"PROJ-3"}, "PROJ-1", "", 0)
+	assert.ErrorContains(t, err, "Rank operation failed")
+	assert.ErrorContains(t, err, "one possible cause is a board whose filter is not ordered by Rank")
+	assert.ErrorContains(t, err, "use --board to check a board")
+}
+
+func TestRunRank_Invalid(t *testing.T) {
+	opts, _, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s", r.URL.Path)
+	})
+
+	assert.ErrorContains(t, runRank(context.Background(), opts, []string{"PROJ-3"}, "", "", 0), "exactly one of --before and --after")
+	assert.ErrorContains(t, runRank(context.Background(), opts, []string{"PROJ-3"}, "PROJ-1", "PROJ-2", 0), "exactly one of --before and --after")
+	assert.ErrorContains(t, runRank(context.Background(), opts, []string{"PROJ-1", "PROJ-3"}, "proj-1", "", 0), "cannot rank")
+}
@@ -1,10 +1,14 @@
 package boards
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
 
@@ -14,11 +18,12 @@ func Register(parent *cobra.Command, opts *root.Options) {
 		Use:     "boards",
 		Aliases: []string{"board", "b"},
 		Short:   "Manage agile boards",
-		Long:    "Commands for viewing agile boards.",
+		Long:    "Commands for viewing agile boards and their issues.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newShowCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -26,6 +31,7 @@ func Register(parent *cobra.Command, opts *root.Options) {
 func newListCmd(opts *root.Options) *cobra.Command {
 	var project string
 	var maxResults int
+	var all bool
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -35,19 +41,23 @@ func newListCmd(opts *root.Options) *cobra.Command {
   jtk boards list
 
   # List boards for a project
-  jtk boards list --project MYPROJECT`,
+  jtk boards list --project MYPROJECT
+
+  # List every board
+  jtk boards list --all`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runList(opts, project, maxResults)
+			return runList(cmd.Context(), opts, project, maxResults, all)
 		},
 	}
 
 	cmd.Flags().StringVarP(&project, "project", "p", "", "Filter by project key")
 	cmd.Flags().IntVarP(&maxResults, "max", "m", 50, "Maximum number of results")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch all results, ignoring --max")
 
 	return cmd
 }
 
-func runList(opts *root.Options, project string, maxResults int) error {
+func runList(ctx context.Context, opts *root.Options, project string, maxResults int, all bool) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -55,24 +65,33 @@ func runList(opts *root.Options, project string, maxResults int) error {
 		return err
 	}
 
-	result, err := client.ListBoards(project, 0, maxResults)
+	var boards []api.Board
+	if all {
+		boards, err = client.ListAllBoards(ctx, project)
+	} else {
+		var result *api.BoardsResponse
+		result, err = client.ListBoards(ctx, project, 0, maxResults)
+		if result != nil {
+			boards = result.Values
+		}
+	}
 	if err != nil {
 		return err
 	}
 
-	if len(result.Values) == 0 {
+	if len(boards) == 0 {
 		v.Info("No boards found")
 		return nil
 	}
 
-	if opts.Output == "json" {
-		return v.JSON(result.Values)
+	if view.IsStructured(opts.Output) {
+		return v.JSON(boards)
 	}
 
 	headers := []string{"ID", "NAME", "TYPE", "PROJECT"}
 	var rows [][]string
 
-	for _, b := range result.Values {
+	for _, b := range boards {
 		rows = append(rows, []string{
 			fmt.Sprintf("%d", b.ID),
 			b.Name,
@@ -96,12 +115,12 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 			if _, err := fmt.Sscanf(args[0], "%d", &boardID); err != nil {
 				return fmt.Errorf("invalid board ID: %s", args[0])
 			}
-			return runGet(opts, boardID)
+			return runGet(cmd.Context(), opts, boardID)
 		},
 	}
 }
 
-func runGet(opts *root.Options, boardID int) error {
+func runGet(ctx context.Context, opts *root.Options, boardID int) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -109,12 +128,12 @@ func runGet(opts *root.Options, boardID int) error {
 		return err
 	}
 
-	board, err := client.GetBoard(boardID)
+	board, err := client.GetBoard(ctx, boardID)
 	if err != nil {
 		return err
 	}
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(board)
 	}
 
@@ -0,0 +1,371 @@
+package boards
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+const (
+	// defaultWidth is the board width when the terminal size is unknown
+	defaultWidth = 80
+
+	// minColumnWidth is the narrowest column drawn; narrower boards are
+	// shown as a list
+	minColumnWidth = 14
+
+	// summaryLines is how many lines of its summary a card shows
+	summaryLines = 2
+
+	// columnSeparator is drawn between columns
+	columnSeparator = " │ "
+)
+
+// boardFields are the issue fields loaded for a board
+var boardFields = []string{"summary", "status", "assignee", "issuetype"}
+
+// boardView is a board's issues laid out in its columns
+type boardView struct {
+	ID       int           `json:"id"`
+	Name     string        `json:"name"`
+	Type     string        `json:"type"`
+	Sprint   *api.Sprint   `json:"sprint,omitempty"`
+	Columns  []boardColumn `json:"columns"`
+	Unmapped []boardCard   `json:"unmapped,omitempty"`
+}
+
+// boardColumn is a column with its issues and WIP limits
+type boardColumn struct {
+	Name   string      `json:"name"`
+	Min    *int        `json:"min,omitempty"`
+	Max    *int        `json:"max,omitempty"`
+	Count  int         `json:"count"`
+	Issues []boardCard `json:"issues"`
+}
+
+// boardCard is an issue on a board
+type boardCard struct {
+	Key      string `json:"key"`
+	Summary  string `json:"summary"`
+	Status   string `json:"status"`
+	Assignee string `json:"assignee,omitempty"`
+	Type     string `json:"type,omitempty"`
+}
+
+func newShowCmd(opts *root.Options) *cobra.Command {
+	var maxResults, width int
+
+	cmd := &cobra.Command{
+		Use:   "show <board-id>",
+		Short: "Show a board with its columns",
+		Long: `Show a board's issues in its columns, as set in the board configuration.
+
+Scrum boards show the issues of the active sprint; kanban boards the issues
+selected by the board's filter. Column headers show the number of issues and
+the WIP limits, marked with ! when a limit is broken. Issues in statuses not
+mapped to a column are not shown, as in Jira.
+
+The board fits the terminal width, or --width. When the columns would be too
+narrow, and for output formats other than table, the issues are listed by
+column instead.`,
+		Example: `  # Show a board
+  jtk boards show 123
+
+  # List a board's issues by column
+  jtk boards show 123 -o plain
+
+  # The whole board as JSON
+  jtk boards show 123 -o json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var boardID int
+			if _, err := fmt.Sscanf(args[0], "%d", &boardID); err != nil {
+				return fmt.Errorf("invalid board ID: %s", args[0])
+			}
+			if width == 0 {
+				width = terminalWidth(opts.Stdout)
+			}
+			return runShow(cmd.Context(), opts, boardID, maxResults, width)
+		},
+	}
+
+	cmd.Flags().IntVarP(&maxResults, "max", "m", 200, "Maximum number of issues to load from a kanban board")
+	cmd.Flags().IntVarP(&width, "width", "w", 0, "Board width in characters (default the terminal width)")
+
+	return cmd
+}
+
+func runShow(ctx context.Context, opts *root.Options, boardID, maxResults, width int) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	config, err := client.GetBoardConfiguration(ctx, boardID)
+	if err != nil {
+		return err
+	}
+	if len(config.ColumnConfig.Columns) == 0 {
+		return fmt.Errorf("board %d has no columns", boardID)
+	}
+
+	board := &boardView{ID: config.ID, Name: config.Name, Type: config.Type}
+	var issues []api.Issue
+	switch config.Type {
+	case "scrum", "simple":
+		sprint, err := client.GetCurrentSprint(ctx, boardID)
+		if err == nil {
+			board.Sprint = sprint
+			issues, err = client.GetAllSprintIssues(ctx, sprint.ID)
+			if err != nil {
+				return err
+			}
+			break
+		}
+		// Team-managed boards may not use sprints
+		if config.Type == "scrum" {
+			return err
+		}
+		fallthrough
+	default:
+		issues, err = client.GetAllBoardIssues(ctx, boardID, boardFields, maxResults)
+		if err != nil {
+			return err
+		}
+	}
+
+	layoutBoard(board, config.ColumnConfig, issues)
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(board)
+	}
+
+	if len(board.Unmapped) > 0 {
+		v.Warning("%d issue(s) in statuses not mapped to a column are not shown", len(board.Unmapped))
+	}
+
+	columnWidth := (width - len([]rune(columnSeparator))*(len(board.Columns)-1)) / len(board.Columns)
+	if view.Format(opts.Output) != view.FormatTable || columnWidth < minColumnWidth {
+		return listBoard(v, board)
+	}
+
+	_, err = io.WriteString(opts.Stdout, renderBoard(board, columnWidth))
+	return err
+}
+
+// layoutBoard puts issues in the columns their statuses are mapped to, in
+// the order given. WIP limits apply when the board sets a constraint; with
+// issueCountExclSubs, sub-tasks do not count towards them.
+func layoutBoard(board *boardView, config api.BoardColumnConfig, issues []api.Issue) {
+	limited := config.ConstraintType != "" && config.ConstraintType != "none"
+	columnOf := map[string]int{}
+	board.Columns = make([]boardColumn, len(config.Columns))
+	for i, c := range config.Columns {
+		board.Columns[i] = boardColumn{Name: c.Name, Issues: []boardCard{}}
+		if limited {
+			board.Columns[i].Min, board.Columns[i].Max = c.Min, c.Max
+		}
+		for _, s := range c.Statuses {
+			columnOf[s.ID] = i
+		}
+	}
+
+	for _, issue := range issues {
+		card := boardCard{Key: issue.Key, Summary: issue.Fields.Summary}
+		statusID := ""
+		if issue.Fields.Status != nil {
+			statusID, card.Status = issue.Fields.Status.ID, issue.Fields.Status.Name
+		}
+		if issue.Fields.Assignee != nil {
+			card.Assignee = issue.Fields.Assignee.DisplayName
+		}
+		subtask := false
+		if issue.Fields.IssueType != nil {
+			card.Type, subtask = issue.Fields.IssueType.Name, issue.Fields.IssueType.Subtask
+		}
+
+		i, ok := columnOf[statusID]
+		if !ok {
+			board.Unmapped = append(board.Unmapped, card)
+			continue
+		}
+		board.Columns[i].Issues = append(board.Columns[i].Issues, card)
+		if !subtask || config.ConstraintType != "issueCountExclSubs" {
+			board.Columns[i].Count++
+		}
+	}
+}
+
+// columnHeader returns a column's name with its issue count and WIP limits
+func columnHeader(c boardColumn) string {
+	count := strconv.Itoa(c.Count)
+	if c.Max != nil {
+		count += "/" + strconv.Itoa(*c.Max)
+	}
+	if c.Min != nil {
+		count += " min " + strconv.Itoa(*c.Min)
+	}
+	if (c.Max != nil && c.Count > *c.Max) || (c.Min != nil && c.Count < *c.Min) {
+		count += " !"
+	}
+	return fmt.Sprintf("%s (%s)", c.Name, count)
+}
+
+// renderBoard draws a board with a column per board column, each
+// columnWidth characters wide
+func renderBoard(board *boardView, columnWidth int) string {
+	var b strings.Builder
+
+	title := board.Name
+	if board.Sprint != nil {
+		title += " - " + board.Sprint.Name
+		if board.Sprint.EndDate != nil {
+			title += " (ends " + board.Sprint.EndDate.Format("2006-01-02") + ")"
+		}
+	}
+	b.WriteString(title + "\n\n")
+
+	cells := make([][]string, len(board.Columns))
+	headers := make([]string, len(board.Columns))
+	rules := make([]string, len(board.Columns))
+	height := 0
+	for i, c := range board.Columns {
+		headers[i] = fitText(columnHeader(c), columnWidth)
+		rules[i] = strings.Repeat("─", columnWidth)
+		for j, card := range c.Issues {
+			if j > 0 {
+				cells[i] = append(cells[i], "")
+			}
+			cells[i] = append(cells[i], cardLines(card, columnWidth)...)
+		}
+		height = max(height, len(cells[i]))
+	}
+
+	writeRow(&b, headers, columnWidth)
+	b.WriteString(strings.Join(rules, "─┼─") + "\n")
+	for line := range height {
+		row := make([]string, len(cells))
+		for i := range cells {
+			if line < len(cells[i]) {
+				row[i] = cells[i][line]
+			}
+		}
+		writeRow(&b, row, columnWidth)
+	}
+
+	return b.String()
+}
+
+// writeRow writes one line of the board, padding each cell to the column
+// width
+func writeRow(b *strings.Builder, cells []string, columnWidth int) {
+	var line strings.Builder
+	for i, cell := range cells {
+		if i > 0 {
+			line.WriteString(columnSeparator)
+		}
+		line.WriteString(cell)
+		line.WriteString(strings.Repeat(" ", columnWidth-len([]rune(cell))))
+	}
+	b.WriteString(strings.TrimRight(line.String(), " ") + "\n")
+}
+
+// cardLines returns the lines of a card: its key and assignee, then its
+// summary wrapped to the column width
+func cardLines(card boardCard, width int) []string {
+	first := card.Key
+	if name := strings.Fields(card.Assignee); len(name) > 0 {
+		first += " @" + name[0]
+	}
+	return append([]string{fitText(first, width)}, wrapText(card.Summary, width, summaryLines)...)
+}
+
+// wrapText wraps text at spaces into at most maxLines lines of width
+// characters, cutting the last line short with "..." if it does not fit
+func wrapText(text string, width, maxLines int) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		for len([]rune(word)) > width {
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			r := []rune(word)
+			lines = append(lines, string(r[:width]))
+			word = string(r[width:])
+		}
+		switch {
+		case line == "":
+			line = word
+		case len([]rune(line))+1+len([]rune(word)) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	if len(lines) > maxLines {
+		lines = lines[:maxLines]
+		last := []rune(lines[maxLines-1])
+		lines[maxLines-1] = string(last[:min(len(last), width-3)]) + "..."
+	}
+	return lines
+}
+
+// fitText cuts text to width characters, ending with "..." if cut
+func fitText(text string, width int) string {
+	r := []rune(text)
+	if len(r) <= width {
+		return text
+	}
+	return string(r[:width-3]) + "..."
+}
+
+// listBoard shows a board's issues as a list grouped by column
+func listBoard(v *view.View, board *boardView) error {
+	headers := []string{"COLUMN", "KEY", "STATUS", "ASSIGNEE", "SUMMARY"}
+	var rows [][]string
+	for _, c := range board.Columns {
+		for _, card := range c.Issues {
+			rows = append(rows, []string{c.Name, card.Key, card.Status, card.Assignee, card.Summary})
+		}
+	}
+
+	if len(rows) == 0 && !view.IsMachineReadable(string(v.Format)) {
+		v.Info("No issues on board %d", board.ID)
+		return nil
+	}
+	return v.Table(headers, rows)
+}
+
+// terminalWidth returns the width of the terminal w writes to, or else of
+// $COLUMNS, or else defaultWidth
+func terminalWidth(w io.Writer) int {
+	if f, ok := w.(*os.File); ok && term.IsTerminal(f.Fd()) {
+		if width, _, err := term.GetSize(f.Fd()); err == nil && width > 0 {
+			return width
+		}
+	}
+	if width, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && width > 0 {
+		return width
+	}
+	return defaultWidth
+}
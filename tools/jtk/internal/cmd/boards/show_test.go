@@ -0,0 +1,132 @@
+package boards
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+const boardConfig = `{"id": 7, "name": "Team board", "type": "%s", "columnConfig": {
+	"constraintType": "issueCountExclSubs",
+	"columns": [
+		{"name": "To Do", "statuses": [{"id": "1"}]},
+		{"name": "In Progress", "statuses": [{"id": "3"}, {"id": "5"}], "max": 1},
+		{"name": "Done", "statuses": [{"id": "4"}]}
+	]}}`
+
+const boardIssues = `{"total": 4, "issues": [
+	{"key": "PROJ-1", "fields": {"summary": "Write the importer", "status": {"id": "3", "name": "In Progress"},
+		"assignee": {"displayName": "Jane Doe"}, "issuetype": {"name": "Story"}}},
+	{"key": "PROJ-2", "fields": {"summary": "Review", "status": {"id": "5", "name": "In Review"},
+		"issuetype": {"name": "Story"}}},
+	{"key": "PROJ-3", "fields": {"summary": "Sub-task", "status": {"id": "3", "name": "In Progress"},
+		"issuetype": {"name": "Sub-task", "subtask": true}}},
+	{"key": "PROJ-4", "fields": {"summary": "Parked", "status": {"id": "9", "name": "Parked"}}}
+]}`
+
+func newShowOptions(t *testing.T, boardType, output string) (*root.Options, *bytes.Buffer, *bytes.Buffer) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/agile/1.0/board/7/configuration":
+			fmt.Fprintf(w, boardConfig, boardType)
+		case "/rest/agile/1.0/board/7/sprint":
+			fmt.Fprint(w, `{"isLast": true, "values": [{"id": 10, "name": "Sprint 10", "state": "active", "endDate": "2026-10-31T17:00:00.000Z"}]}`)
+		case "/rest/agile/1.0/sprint/10/issue":
+			fmt.Fprint(w, boardIssues)
+		case "/rest/agile/1.0/board/7/issue":
+			assert.Equal(t, "summary,status,assignee,issuetype", r.URL.Query().Get("fields"))
+			fmt.Fprint(w, boardIssues)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := api.New(api.ClientConfig{URL: server.URL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	var stdout, stderr bytes.Buffer
+	opts := &root.Options{Output: output, NoColor: true, Stdout: &stdout, Stderr: &stderr}
+	opts.SetAPIClient(client)
+	return opts, &stdout, &stderr
+}
+
+func TestRunShow_Scrum(t *testing.T) {
+	opts, stdout, stderr := newShowOptions(t, "scrum", "table")
+
+	require.NoError(t, runShow(context.Background(), opts, 7, 200, 80))
+
+	lines := strings.Split(strings.TrimRight(stdout.String(), "\n"), "\n")
+	assert.Equal(t, "Team board - Sprint 10 (ends 2026-10-31)", lines[0])
+	assert.Contains(t, lines[2], "To Do (0)")
+	// Two issues against a limit of one; the sub-task does not count
+	assert.Contains(t, lines[2], "In Progress (2/1 !)")
+	assert.Contains(t, lines[4], "PROJ-1 @Jane")
+	for _, line := range lines[2:] {
+		assert.LessOrEqual(t, len([]rune(line)), 80, line)
+	}
+	assert.Contains(t, stderr.String(), "1 issue(s) in statuses not mapped")
+}
+
+func TestRunShow_KanbanJSON(t *testing.T) {
+	opts, stdout, _ := newShowOptions(t, "kanban", "json")
+
+	require.NoError(t, runShow(context.Background(), opts, 7, 200, 80))
+
+	var board boardView
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &board))
+	assert.Nil(t, board.Sprint)
+	require.Len(t, board.Columns, 3)
+	assert.Equal(t, 2, board.Columns[1].Count)
+	assert.Len(t, board.Columns[1].Issues, 3)
+	require.NotNil(t, board.Columns[1].Max)
+	assert.Equal(t, 1, *board.Columns[1].Max)
+	assert.Equal(t, "PROJ-4", board.Unmapped[0].Key)
+}
+
+func TestRunShow_List(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		output string
+		width  int
+	}{
+		{name: "plain", output: "plain", width: 80},
+		{name: "too narrow", output: "table", width: 30},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			opts, stdout, _ := newShowOptions(t, "kanban", tt.output)
+
+			require.NoError(t, runShow(context.Background(), opts, 7, 200, tt.width))
+
+			out := stdout.String()
+			assert.NotContains(t, out, "│")
+			assert.Contains(t, out, "In Progress")
+			assert.Contains(t, out, "PROJ-2")
+		})
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	assert.Equal(t, []string{"Write the", "importer"}, wrapText("Write the importer", 10, 2))
+	assert.Equal(t, []string{"Write the", "importe..."}, wrapText("Write the importer for CSV", 10, 2))
+	assert.Equal(t, []string{"abcdefghij", "klm"}, wrapText("abcdefghijklm", 10, 2))
+	assert.Empty(t, wrapText("", 10, 2))
+}
+
+func TestColumnHeader(t *testing.T) {
+	one, three := 1, 3
+	assert.Equal(t, "Done (4)", columnHeader(boardColumn{Name: "Done", Count: 4}))
+	assert.Equal(t, "Doing (2/3)", columnHeader(boardColumn{Name: "Doing", Count: 2, Max: &three}))
+	assert.Equal(t, "Doing (0/3 min 1 !)", columnHeader(boardColumn{Name: "Doing", Count: 0, Min: &one, Max: &three}))
+}
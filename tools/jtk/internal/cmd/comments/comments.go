@@ -1,8 +1,13 @@
 package comments
 
 import (
+	"context"
+
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
 
@@ -25,26 +30,32 @@ func Register(parent *cobra.Command, opts *root.Options) {
 func newListCmd(opts *root.Options) *cobra.Command {
 	var maxResults int
 	var full bool
+	var all bool
+	var markdown bool
 
 	cmd := &cobra.Command{
 		Use:   "list <issue-key>",
 		Short: "List comments on an issue",
 		Long:  "List all comments on a specific issue.",
 		Example: `  jtk comments list PROJ-123
-  jtk comments list PROJ-123 --full`,
+  jtk comments list PROJ-123 --full
+  jtk comments list PROJ-123 --all
+  jtk comments list PROJ-123 -o json --markdown`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runList(opts, args[0], maxResults, full)
+			return runList(cmd.Context(), opts, args[0], maxResults, full, all, markdown)
 		},
 	}
 
 	cmd.Flags().IntVarP(&maxResults, "max", "m", 50, "Maximum number of comments")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch all comments, ignoring --max")
 	cmd.Flags().BoolVar(&full, "full", false, "Show full comment bodies without truncation")
+	cmd.Flags().BoolVar(&markdown, "markdown", false, "Add comment bodies as Markdown (bodyMarkdown) to JSON output")
 
 	return cmd
 }
 
-func runList(opts *root.Options, issueKey string, maxResults int, full bool) error {
+func runList(ctx context.Context, opts *root.Options, issueKey string, maxResults int, full, all, markdown bool) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -52,30 +63,43 @@ func runList(opts *root.Options, issueKey string, maxResults int, full bool) err
 		return err
 	}
 
-	result, err := client.GetComments(issueKey, 0, maxResults)
+	var comments []api.Comment
+	if all {
+		comments, err = client.GetAllComments(ctx, issueKey)
+	} else {
+		var result *api.CommentsResponse
+		result, err = client.GetComments(ctx, issueKey, 0, maxResults)
+		if result != nil {
+			comments = result.Comments
+		}
+	}
 	if err != nil {
 		return err
 	}
 
-	if len(result.Comments) == 0 {
+	if len(comments) == 0 {
 		v.Info("No comments on %s", issueKey)
 		return nil
 	}
 
-	if opts.Output == "json" {
-		return v.JSON(result.Comments)
+	if view.IsStructured(opts.Output) {
+		if markdown {
+			enriched := make([]markdownComment, len(comments))
+			for i, c := range comments {
+				enriched[i] = markdownComment{Comment: c, BodyMarkdown: c.Body.ToMarkdown()}
+			}
+			return v.JSON(enriched)
+		}
+		return v.JSON(comments)
 	}
 
-	// Full mode: display each comment with complete body text
+	// Full mode: display each comment with its complete body as Markdown
 	if full {
-		for i, c := range result.Comments {
+		for i, c := range comments {
 			if i > 0 {
 				v.Println("---")
 			}
-			body := ""
-			if c.Body != nil {
-				body = c.Body.ToPlainText()
-			}
+			body := c.Body.ToMarkdown()
 			v.Println("ID:      %s", c.ID)
 			v.Println("Author:  %s", c.Author.DisplayName)
 			v.Println("Created: %s", formatTime(c.Created))
@@ -87,7 +111,7 @@ func runList(opts *root.Options, issueKey string, maxResults int, full bool) err
 	headers := []string{"ID", "AUTHOR", "CREATED", "BODY"}
 	var rows [][]string
 
-	for _, c := range result.Comments {
+	for _, c := range comments {
 		body := ""
 		if c.Body != nil {
 			body = c.Body.ToPlainText()
@@ -107,6 +131,13 @@ func runList(opts *root.Options, issueKey string, maxResults int, full bool) err
 	return v.Table(headers, rows)
 }
 
+// markdownComment adds the body rendered as Markdown to a comment for JSON
+// output.
+type markdownComment struct {
+	api.Comment
+	BodyMarkdown string `json:"bodyMarkdown,omitempty"`
+}
+
 func newAddCmd(opts *root.Options) *cobra.Command {
 	var body string
 
@@ -117,7 +148,7 @@ func newAddCmd(opts *root.Options) *cobra.Command {
 		Example: `  jtk comments add PROJ-123 --body "This is my comment"`,
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runAdd(opts, args[0], body)
+			return runAdd(cmd.Context(), opts, args[0], body)
 		},
 	}
 
@@ -127,20 +158,24 @@ func newAddCmd(opts *root.Options) *cobra.Command {
 	return cmd
 }
 
-func runAdd(opts *root.Options, issueKey, body string) error {
+func runAdd(ctx context.Context, opts *root.Options, issueKey, body string) error {
 	v := opts.View()
 
+	if err := api.CheckADF("comment body", api.NewADFDocument(body)); err != nil {
+		return err
+	}
+
 	client, err := opts.APIClient()
 	if err != nil {
 		return err
 	}
 
-	comment, err := client.AddComment(issueKey, body)
+	comment, err := client.AddComment(ctx, issueKey, body)
 	if err != nil {
 		return err
 	}
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(comment)
 	}
 
@@ -156,14 +191,14 @@ func newDeleteCmd(opts *root.Options) *cobra.Command {
 		Example: `  jtk comments delete PROJ-123 12345`,
 		Args:    cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runDelete(opts, args[0], args[1])
+			return runDelete(cmd.Context(), opts, args[0], args[1])
 		},
 	}
 
 	return cmd
 }
 
-func runDelete(opts *root.Options, issueKey, commentID string) error {
+func runDelete(ctx context.Context, opts *root.Options, issueKey, commentID string) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -171,11 +206,11 @@ func runDelete(opts *root.Options, issueKey, commentID string) error {
 		return err
 	}
 
-	if err := client.DeleteComment(issueKey, commentID); err != nil {
+	if err := client.DeleteComment(ctx, issueKey, commentID); err != nil {
 		return err
 	}
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(map[string]string{"status": "deleted", "commentId": commentID})
 	}
 
@@ -2,9 +2,11 @@ package comments
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -85,7 +87,7 @@ func TestRunList_TruncatesCommentBody(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runList(opts, "TEST-1", 50, false)
+	err = runList(context.Background(), opts, "TEST-1", 50, false, false, false)
 	require.NoError(t, err)
 
 	output := stdout.String()
@@ -134,7 +136,7 @@ func TestRunList_FullCommentBody(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runList(opts, "TEST-1", 50, true)
+	err = runList(context.Background(), opts, "TEST-1", 50, true, false, false)
 	require.NoError(t, err)
 
 	output := stdout.String()
@@ -185,7 +187,7 @@ func TestRunList_ShortCommentNotTruncated(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runList(opts, "TEST-1", 50, false)
+	err = runList(context.Background(), opts, "TEST-1", 50, false, false, false)
 	require.NoError(t, err)
 
 	output := stdout.String()
@@ -212,7 +214,7 @@ func TestRunList_NoComments(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runList(opts, "TEST-1", 50, false)
+	err = runList(context.Background(), opts, "TEST-1", 50, false, false, false)
 	require.NoError(t, err)
 
 	combined := stdout.String() + stderr.String()
@@ -265,7 +267,7 @@ func TestRunList_MultipleCommentsFullMode(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runList(opts, "TEST-1", 50, true)
+	err = runList(context.Background(), opts, "TEST-1", 50, true, false, false)
 	require.NoError(t, err)
 
 	output := stdout.String()
@@ -273,3 +275,82 @@ func TestRunList_MultipleCommentsFullMode(t *testing.T) {
 	assert.Contains(t, output, "Second comment")
 	assert.Contains(t, output, "---") // separator between comments
 }
+
+func TestRunList_AllFetchesEveryPage(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		response := api.CommentsResponse{
+			StartAt:    startAt,
+			MaxResults: 1,
+			Total:      2,
+			Comments: []api.Comment{
+				{ID: strconv.Itoa(startAt + 1), Author: api.User{DisplayName: "Author" + strconv.Itoa(startAt+1)}},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer server.Close()
+
+	client, err := api.New(api.ClientConfig{
+		URL:      server.URL,
+		Email:    "test@example.com",
+		APIToken: "token",
+	})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{
+		Output: "table",
+		Stdout: &stdout,
+		Stderr: &bytes.Buffer{},
+	}
+	opts.SetAPIClient(client)
+
+	err = runList(context.Background(), opts, "TEST-1", 50, false, true, false)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, requests)
+	assert.Contains(t, stdout.String(), "Author1")
+	assert.Contains(t, stdout.String(), "Author2")
+}
+
+func TestRunList_MarkdownJSON(t *testing.T) {
+	comments := []api.Comment{
+		{
+			ID:     "1",
+			Author: api.User{DisplayName: "Alice"},
+			Body:   api.NewADFDocument("See **the docs** at [the wiki](https://example.com)"),
+		},
+	}
+
+	server := newTestCommentsServer(t, comments)
+	defer server.Close()
+
+	client, err := api.New(api.ClientConfig{
+		URL:      server.URL,
+		Email:    "test@example.com",
+		APIToken: "token",
+	})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{
+		Output: "json",
+		Stdout: &stdout,
+		Stderr: &bytes.Buffer{},
+	}
+	opts.SetAPIClient(client)
+
+	err = runList(context.Background(), opts, "TEST-1", 50, false, false, true)
+	require.NoError(t, err)
+
+	var result []map[string]interface{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &result))
+	require.Len(t, result, 1)
+	assert.Equal(t, "1", result[0]["id"])
+	assert.NotNil(t, result[0]["body"])
+	assert.Equal(t, "See **the docs** at [the wiki](https://example.com)", result[0]["bodyMarkdown"])
+}
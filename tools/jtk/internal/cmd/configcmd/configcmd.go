@@ -8,6 +8,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
+
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/config"
 )
@@ -23,6 +25,10 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	cmd.AddCommand(newShowCmd(opts))
 	cmd.AddCommand(newClearCmd(opts))
 	cmd.AddCommand(newTestCmd(opts))
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newUseCmd(opts))
+	cmd.AddCommand(newAddCmd(opts))
+	cmd.AddCommand(newRemoveCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -45,23 +51,38 @@ func newShowCmd(opts *root.Options) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
-			url := config.GetURL()
-			email := config.GetEmail()
-			token := config.GetAPIToken()
-			defaultProject := config.GetDefaultProject()
+			cfg, err := opts.Config()
+			if err != nil {
+				return err
+			}
+
+			profile := config.ActiveProfile()
+			url := cfg.GetURL()
+			email := cfg.GetEmail()
+			token := cfg.GetAPIToken()
+			defaultProject := cfg.GetDefaultProject()
+
+			authMethod := cfg.GetAuthMethod()
 
 			maskedToken := maskToken(token)
+			if authMethod == auth.MethodOAuth && token != "" {
+				maskedToken = "(OAuth credentials)"
+			}
 
 			headers := []string{"KEY", "VALUE", "SOURCE"}
 			rows := [][]string{
-				{"url", url, getURLSource()},
-				{"email", email, getEmailSource()},
-				{"api_token", maskedToken, getAPITokenSource()},
-				{"default_project", defaultProject, getDefaultProjectSource()},
+				{"profile", profile, getProfileSource(opts.Profile)},
+				{"url", url, getURLSource(cfg)},
+				{"auth_method", authMethod, getAuthMethodSource(cfg)},
+				{"email", email, getEmailSource(cfg)},
+				{"api_token", maskedToken, getAPITokenSource(cfg)},
+				{"default_project", defaultProject, getDefaultProjectSource(cfg)},
 			}
 
 			data := map[string]string{
+				"profile":         profile,
 				"url":             url,
+				"auth_method":     authMethod,
 				"email":           email,
 				"api_token":       maskedToken,
 				"default_project": defaultProject,
@@ -93,7 +114,9 @@ func newClearCmd(opts *root.Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "clear",
 		Short: "Clear stored configuration",
-		Long: `Remove the stored configuration file.
+		Long: `Remove the stored configuration file, including every profile, and the
+API tokens held in the credential store. To remove a single profile, use
+'jtk config remove <profile>'.
 
 Note: Environment variables (JIRA_*, ATLASSIAN_*) will still be used if set.`,
 		Example: `  # Clear configuration (with confirmation)
@@ -167,17 +190,30 @@ func runClear(opts *clearOptions) error {
 	return nil
 }
 
-func getURLSource() string {
+func getProfileSource(flag string) string {
+	if flag != "" {
+		return "flag (--profile)"
+	}
+	if os.Getenv("JIRA_PROFILE") != "" {
+		return "env (JIRA_PROFILE)"
+	}
+	if os.Getenv("ATLASSIAN_PROFILE") != "" {
+		return "env (ATLASSIAN_PROFILE)"
+	}
+	f, err := config.LoadFile()
+	if err != nil || f.Default == "" {
+		return "-"
+	}
+	return "config"
+}
+
+func getURLSource(cfg *config.Config) string {
 	if os.Getenv("JIRA_URL") != "" {
 		return "env (JIRA_URL)"
 	}
 	if os.Getenv("ATLASSIAN_URL") != "" {
 		return "env (ATLASSIAN_URL)"
 	}
-	cfg, err := config.Load()
-	if err != nil {
-		return "-"
-	}
 	if cfg.URL != "" {
 		return "config"
 	}
@@ -191,48 +227,52 @@ func getURLSource() string {
 	return "-"
 }
 
-func getEmailSource() string {
+func getAuthMethodSource(cfg *config.Config) string {
+	if os.Getenv("JIRA_AUTH_METHOD") != "" {
+		return "env (JIRA_AUTH_METHOD)"
+	}
+	if os.Getenv("ATLASSIAN_AUTH_METHOD") != "" {
+		return "env (ATLASSIAN_AUTH_METHOD)"
+	}
+	if cfg.AuthMethod == "" {
+		return "-"
+	}
+	return "config"
+}
+
+func getEmailSource(cfg *config.Config) string {
 	if os.Getenv("JIRA_EMAIL") != "" {
 		return "env (JIRA_EMAIL)"
 	}
 	if os.Getenv("ATLASSIAN_EMAIL") != "" {
 		return "env (ATLASSIAN_EMAIL)"
 	}
-	cfg, err := config.Load()
-	if err != nil {
-		return "-"
-	}
 	if cfg.Email != "" {
 		return "config"
 	}
 	return "-"
 }
 
-func getAPITokenSource() string {
+func getAPITokenSource(cfg *config.Config) string {
 	if os.Getenv("JIRA_API_TOKEN") != "" {
 		return "env (JIRA_API_TOKEN)"
 	}
 	if os.Getenv("ATLASSIAN_API_TOKEN") != "" {
 		return "env (ATLASSIAN_API_TOKEN)"
 	}
-	cfg, err := config.Load()
-	if err != nil {
-		return "-"
-	}
 	if cfg.APIToken != "" {
+		if cfg.TokenStore != "" {
+			return cfg.TokenStore
+		}
 		return "config"
 	}
 	return "-"
 }
 
-func getDefaultProjectSource() string {
+func getDefaultProjectSource(cfg *config.Config) string {
 	if os.Getenv("JIRA_DEFAULT_PROJECT") != "" {
 		return "env (JIRA_DEFAULT_PROJECT)"
 	}
-	cfg, err := config.Load()
-	if err != nil {
-		return "-"
-	}
 	if cfg.DefaultProject != "" {
 		return "config"
 	}
@@ -252,7 +292,15 @@ pass/fail status and troubleshooting suggestions on failure.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			v := opts.View()
 
-			url := config.GetURL()
+			cfg, err := opts.Config()
+			if err != nil {
+				v.Error("Failed to load configuration: %v", err)
+				v.Println("")
+				v.Info("Reconfigure with: jtk init")
+				return nil
+			}
+
+			url := cfg.GetURL()
 			if url == "" {
 				v.Error("No Jira URL configured")
 				v.Println("")
@@ -273,7 +321,7 @@ pass/fail status and troubleshooting suggestions on failure.`,
 				return nil
 			}
 
-			user, err := client.GetCurrentUser()
+			user, err := client.GetCurrentUser(cmd.Context())
 			if err != nil {
 				v.Error("Authentication failed: %v", err)
 				v.Println("")
@@ -12,6 +12,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
+
 	"github.com/open-cli-collective/jira-ticket-cli/api"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/config"
@@ -151,6 +153,7 @@ func getConfigDir(t *testing.T) string {
 	tempDir := t.TempDir()
 	t.Setenv("HOME", tempDir)
 	t.Setenv("XDG_CONFIG_HOME", filepath.Join(tempDir, ".config"))
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
 
 	// Get the actual config path the config package will use
 	configPath := config.Path()
@@ -227,15 +230,55 @@ func TestGetDefaultProjectSource(t *testing.T) {
 	// Clear env vars
 	t.Setenv("JIRA_DEFAULT_PROJECT", "")
 
-	// Use temp dir for cross-platform behavior
-	tempDir := t.TempDir()
-	t.Setenv("HOME", tempDir)
-	t.Setenv("XDG_CONFIG_HOME", tempDir)
-
 	// No config, no env
-	assert.Equal(t, "-", getDefaultProjectSource())
+	assert.Equal(t, "-", getDefaultProjectSource(&config.Config{}))
+
+	// From config
+	assert.Equal(t, "config", getDefaultProjectSource(&config.Config{DefaultProject: "CFG"}))
 
 	// With env var
 	t.Setenv("JIRA_DEFAULT_PROJECT", "PROJ")
-	assert.Equal(t, "env (JIRA_DEFAULT_PROJECT)", getDefaultProjectSource())
+	assert.Equal(t, "env (JIRA_DEFAULT_PROJECT)", getDefaultProjectSource(&config.Config{DefaultProject: "CFG"}))
+}
+
+func TestProfileCommands(t *testing.T) {
+	getConfigDir(t)
+	t.Setenv("JIRA_PROFILE", "")
+	t.Setenv("ATLASSIAN_PROFILE", "")
+	config.SetProfile("")
+
+	opts := newTestRootOptions()
+	for _, name := range []string{"default", "sandbox"} {
+		err := runAdd(&addOptions{
+			Options: opts,
+			name:    name,
+			url:     name + ".atlassian.net",
+			email:   "me@example.com",
+			token:   name + "-token",
+		})
+		require.NoError(t, err)
+	}
+
+	err := runAdd(&addOptions{Options: opts, name: "sandbox", url: "x", email: "y", token: "z"})
+	assert.ErrorContains(t, err, "already exists")
+
+	require.NoError(t, runUse(opts, "sandbox"))
+	assert.Equal(t, "sandbox", config.ActiveProfile())
+	assert.Equal(t, "https://sandbox.atlassian.net", config.GetURL())
+	assert.Equal(t, "sandbox-token", config.GetAPIToken())
+
+	opts = newTestRootOptions()
+	require.NoError(t, runList(opts))
+	stdout := opts.Stdout.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, "https://default.atlassian.net")
+	assert.Regexp(t, `\*\s+sandbox`, stdout)
+
+	opts = newTestRootOptions()
+	err = runRemove(&removeOptions{Options: opts, name: "sandbox", stdin: strings.NewReader("y\n")})
+	require.NoError(t, err)
+	assert.Equal(t, "default", config.ActiveProfile())
+	assert.Equal(t, "default-token", config.GetAPIToken())
+
+	err = runRemove(&removeOptions{Options: opts, name: "sandbox", force: true})
+	assert.ErrorContains(t, err, "not found")
 }
@@ -0,0 +1,267 @@
+package configcmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	sharedconfig "github.com/open-cli-collective/atlassian-go/config"
+	sharedurl "github.com/open-cli-collective/atlassian-go/url"
+
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/config"
+)
+
+// profileEntry is the JSON form of a profile in `config list`
+type profileEntry struct {
+	Name           string `json:"name"`
+	URL            string `json:"url"`
+	Email          string `json:"email"`
+	DefaultProject string `json:"default_project,omitempty"`
+	Default        bool   `json:"default"`
+	Active         bool   `json:"active"`
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Aliases: []string{"ls"},
+		Short:   "List config profiles",
+		Long: `List the configured profiles.
+
+The active profile is marked with '*'. It is chosen by --profile, then
+JIRA_PROFILE, then ATLASSIAN_PROFILE, then the default set with 'jtk config use'.`,
+		Example: `  jtk config list
+  jtk config list -o json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(opts)
+		},
+	}
+}
+
+func runList(opts *root.Options) error {
+	v := opts.View()
+
+	f, err := config.LoadFile()
+	if err != nil {
+		return err
+	}
+
+	if len(f.Profiles) == 0 {
+		v.Info("No profiles configured. Run 'jtk init' or 'jtk config add' to create one.")
+		return nil
+	}
+
+	active := config.ActiveProfile()
+	headers := []string{"", "NAME", "URL", "EMAIL", "DEFAULT PROJECT"}
+	var rows [][]string
+	var entries []profileEntry
+
+	for _, name := range f.Names() {
+		cfg, _ := f.Get(name)
+		marker := ""
+		if name == active {
+			marker = "*"
+		}
+		url := cfg.URL
+		if url == "" && cfg.Domain != "" {
+			url = "https://" + cfg.Domain + ".atlassian.net"
+		}
+		rows = append(rows, []string{marker, name, url, cfg.Email, cfg.DefaultProject})
+		entries = append(entries, profileEntry{
+			Name:           name,
+			URL:            url,
+			Email:          cfg.Email,
+			DefaultProject: cfg.DefaultProject,
+			Default:        name == f.DefaultName(),
+			Active:         name == active,
+		})
+	}
+
+	return v.Render(headers, rows, entries)
+}
+
+func newUseCmd(opts *root.Options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use <profile>",
+		Short: "Set the default config profile",
+		Long: `Make a profile the default for subsequent commands.
+
+JIRA_PROFILE, ATLASSIAN_PROFILE and --profile still take precedence.`,
+		Example: `  jtk config use sandbox`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUse(opts, args[0])
+		},
+	}
+}
+
+func runUse(opts *root.Options, name string) error {
+	v := opts.View()
+
+	if err := config.UseProfile(name); err != nil {
+		return err
+	}
+
+	v.Success("Default profile set to %s", name)
+	if active := config.ActiveProfile(); active != name {
+		v.Warning("Profile %s is still selected by --profile or an environment variable", active)
+	}
+	return nil
+}
+
+type addOptions struct {
+	*root.Options
+	name            string
+	url             string
+	email           string
+	token           string
+	defaultProject  string
+	credentialStore string
+	use             bool
+}
+
+func newAddCmd(opts *root.Options) *cobra.Command {
+	addOpts := &addOptions{Options: opts}
+
+	cmd := &cobra.Command{
+		Use:   "add <profile>",
+		Short: "Add a config profile",
+		Long: `Add a profile for another Atlassian site or account.
+
+For interactive setup with connection verification, use
+'jtk init --profile <profile>' instead.`,
+		Example: `  # Add a sandbox site
+  jtk config add sandbox --url https://mycompany-sandbox.atlassian.net \
+    --email user@example.com --token YOUR_TOKEN
+
+  # Add and make it the default
+  jtk config add sandbox --url ... --email ... --token ... --use`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addOpts.name = args[0]
+			return runAdd(addOpts)
+		},
+	}
+
+	cmd.Flags().StringVar(&addOpts.url, "url", "", "Jira URL (required)")
+	cmd.Flags().StringVar(&addOpts.email, "email", "", "Email address for authentication (required)")
+	cmd.Flags().StringVar(&addOpts.token, "token", "", "API token (required)")
+	cmd.Flags().StringVar(&addOpts.defaultProject, "default-project", "", "Default project key")
+	cmd.Flags().StringVar(&addOpts.credentialStore, "credential-store", "", "Where to keep the API token: keyring, file, or command:<helper>")
+	cmd.Flags().BoolVar(&addOpts.use, "use", false, "Make this the default profile")
+
+	_ = cmd.MarkFlagRequired("url")
+	_ = cmd.MarkFlagRequired("email")
+	_ = cmd.MarkFlagRequired("token")
+
+	return cmd
+}
+
+func runAdd(opts *addOptions) error {
+	v := opts.View()
+
+	if err := sharedconfig.ValidateProfileName(opts.name); err != nil {
+		return err
+	}
+
+	f, err := config.LoadFile()
+	if err != nil {
+		return err
+	}
+	if _, exists := f.Get(opts.name); exists {
+		return fmt.Errorf("profile %q already exists (reconfigure it with 'jtk init --profile %s')", opts.name, opts.name)
+	}
+
+	cfg := &config.Config{
+		URL:            sharedurl.NormalizeURL(opts.url),
+		Email:          opts.email,
+		DefaultProject: opts.defaultProject,
+		Auth: sharedconfig.Auth{
+			APIToken:        opts.token,
+			CredentialStore: opts.credentialStore,
+		},
+	}
+	if err := config.SaveProfile(opts.name, cfg); err != nil {
+		return err
+	}
+	v.Success("Profile %s added (API token stored in %s)", opts.name, cfg.TokenStore)
+
+	if opts.use {
+		if err := config.UseProfile(opts.name); err != nil {
+			return err
+		}
+		v.Success("Default profile set to %s", opts.name)
+	}
+	return nil
+}
+
+type removeOptions struct {
+	*root.Options
+	name  string
+	force bool
+	stdin io.Reader // For testing
+}
+
+func newRemoveCmd(opts *root.Options) *cobra.Command {
+	removeOpts := &removeOptions{
+		Options: opts,
+		stdin:   os.Stdin,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "remove <profile>",
+		Aliases: []string{"rm"},
+		Short:   "Remove a config profile",
+		Long:    "Remove a profile and its stored API token.",
+		Example: `  jtk config remove sandbox
+  jtk config remove sandbox --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			removeOpts.name = args[0]
+			return runRemove(removeOpts)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&removeOpts.force, "force", "f", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runRemove(opts *removeOptions) error {
+	v := opts.View()
+
+	f, err := config.LoadFile()
+	if err != nil {
+		return err
+	}
+	if _, exists := f.Get(opts.name); !exists {
+		return fmt.Errorf("profile %q not found", opts.name)
+	}
+
+	if !opts.force {
+		fmt.Fprintf(opts.Stdout, "This will remove profile %s and its API token.\n", opts.name)
+		fmt.Fprint(opts.Stdout, "Are you sure? [y/N]: ")
+
+		var response string
+		_, err := fmt.Fscanln(opts.stdin, &response)
+		if err != nil && err.Error() != "unexpected newline" {
+			return err
+		}
+
+		response = strings.TrimSpace(strings.ToLower(response))
+		if response != "y" && response != "yes" {
+			v.Info("Cancelled.")
+			return nil
+		}
+	}
+
+	if err := config.RemoveProfile(opts.name); err != nil {
+		return err
+	}
+	v.Success("Profile %s removed", opts.name)
+	return nil
+}
@@ -1,12 +1,14 @@
 package initcmd
 
 import (
+	"context"
 	"fmt"
-	"os"
+	"time"
 
 	"github.com/charmbracelet/huh"
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
 	sharedurl "github.com/open-cli-collective/atlassian-go/url"
 
 	"github.com/open-cli-collective/jira-ticket-cli/api"
@@ -14,18 +16,42 @@ import (
 	"github.com/open-cli-collective/jira-ticket-cli/internal/config"
 )
 
+// oauthScopes are requested when logging in with an OAuth 2.0 app
+var oauthScopes = []string{"read:jira-work", "write:jira-work", "read:jira-user", "offline_access"}
+
+type initOptions struct {
+	*root.Options
+	url               string
+	email             string
+	token             string
+	credentialStore   string
+	authMethod        string
+	oauthClientID     string
+	oauthClientSecret string
+	oauthRedirectURL  string
+	noVerify          bool
+}
+
 // Register registers the init command
 func Register(parent *cobra.Command, opts *root.Options) {
-	var url, email, token string
-	var noVerify bool
+	initOpts := &initOptions{Options: opts}
 
 	cmd := &cobra.Command{
 		Use:   "init",
 		Short: "Initialize jtk with guided setup",
 		Long: `Interactive setup wizard for configuring jtk.
 
-Prompts for your Jira URL, email, and API token, then verifies
-the connection before saving the configuration.
+Prompts for your Jira URL and credentials, then verifies the connection
+before saving the configuration. Three ways to authenticate are supported:
+
+  basic    Atlassian account email and API token (default)
+  bearer   Personal access token, for Jira Data Center
+  oauth    OAuth 2.0 app from developer.atlassian.com, logging in through
+           the browser; requests go through the api.atlassian.com gateway
+
+The API token is kept in the OS keychain when one is available, or in an
+encrypted file next to the config otherwise. Use --credential-store to pick
+a backend explicitly (keyring, file, or command:<helper>).
 
 Get your API token from: https://id.atlassian.com/manage-profile/security/api-tokens`,
 		Example: `  # Interactive setup
@@ -35,41 +61,68 @@ Get your API token from: https://id.atlassian.com/manage-profile/security/api-to
   jtk init --url https://mycompany.atlassian.net --email user@example.com --token YOUR_TOKEN
 
   # Skip connection verification
-  jtk init --no-verify`,
+  jtk init --no-verify
+
+  # Keep the API token in an encrypted file instead of the keychain
+  jtk init --credential-store file
+
+  # Configure a second site as a named profile
+  jtk init --profile sandbox
+
+  # Use a Data Center personal access token
+  jtk init --url https://jira.internal.corp.com --auth bearer --token YOUR_PAT
+
+  # Log in with an OAuth 2.0 app (register the redirect URL as its callback)
+  jtk init --auth oauth --oauth-client-id CLIENT_ID --oauth-client-secret SECRET`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runInit(opts, url, email, token, noVerify)
+			return runInit(cmd.Context(), initOpts)
 		},
 	}
 
-	cmd.Flags().StringVar(&url, "url", "", "Jira URL (e.g., https://mycompany.atlassian.net)")
-	cmd.Flags().StringVar(&email, "email", "", "Email address for authentication")
-	cmd.Flags().StringVar(&token, "token", "", "API token")
-	cmd.Flags().BoolVar(&noVerify, "no-verify", false, "Skip connection verification")
+	cmd.Flags().StringVar(&initOpts.url, "url", "", "Jira URL (e.g., https://mycompany.atlassian.net)")
+	cmd.Flags().StringVar(&initOpts.email, "email", "", "Email address for authentication")
+	cmd.Flags().StringVar(&initOpts.token, "token", "", "API token, or personal access token with --auth bearer")
+	cmd.Flags().StringVar(&initOpts.credentialStore, "credential-store", "", "Where to keep the API token: keyring, file, or command:<helper>")
+	cmd.Flags().StringVar(&initOpts.authMethod, "auth", "", "Authentication method: basic, bearer, or oauth")
+	cmd.Flags().StringVar(&initOpts.oauthClientID, "oauth-client-id", "", "OAuth 2.0 app client ID")
+	cmd.Flags().StringVar(&initOpts.oauthClientSecret, "oauth-client-secret", "", "OAuth 2.0 app client secret")
+	cmd.Flags().StringVar(&initOpts.oauthRedirectURL, "oauth-redirect-url", auth.DefaultRedirectURL, "OAuth 2.0 callback URL registered on the app")
+	cmd.Flags().BoolVar(&initOpts.noVerify, "no-verify", false, "Skip connection verification")
 
 	parent.AddCommand(cmd)
 }
 
-func runInit(opts *root.Options, prefillURL, prefillEmail, prefillToken string, noVerify bool) error {
+func runInit(ctx context.Context, opts *initOptions) error {
 	v := opts.View()
 	configPath := config.Path()
+	profile := config.ActiveProfile()
+
+	if err := auth.ValidateMethod(opts.authMethod); err != nil {
+		return err
+	}
 
 	// Load existing config for pre-population
-	existingCfg, _ := config.Load()
-
-	// Check if config already exists
-	if _, err := os.Stat(configPath); err == nil {
-		var overwrite bool
-		err := huh.NewConfirm().
-			Title("Configuration already exists").
-			Description(fmt.Sprintf("Overwrite %s?", configPath)).
-			Value(&overwrite).
-			Run()
-		if err != nil {
-			return err
-		}
-		if !overwrite {
-			v.Info("Initialization cancelled.")
-			return nil
+	existingCfg, err := config.Load()
+	if err != nil {
+		existingCfg = &config.Config{}
+	}
+
+	// Check if the profile already exists
+	if f, err := config.LoadFile(); err == nil {
+		if _, exists := f.Get(profile); exists {
+			var overwrite bool
+			err := huh.NewConfirm().
+				Title("Configuration already exists").
+				Description(fmt.Sprintf("Overwrite profile %s in %s?", profile, configPath)).
+				Value(&overwrite).
+				Run()
+			if err != nil {
+				return err
+			}
+			if !overwrite {
+				v.Info("Initialization cancelled.")
+				return nil
+			}
 		}
 	}
 
@@ -77,28 +130,68 @@ func runInit(opts *root.Options, prefillURL, prefillEmail, prefillToken string,
 	// Priority: CLI flag > existing config value
 	cfg := &config.Config{}
 
-	if prefillURL != "" {
-		cfg.URL = prefillURL
+	if opts.url != "" {
+		cfg.URL = opts.url
 	} else if existingCfg.URL != "" {
 		cfg.URL = existingCfg.URL
 	}
 
-	if prefillEmail != "" {
-		cfg.Email = prefillEmail
+	if opts.email != "" {
+		cfg.Email = opts.email
 	} else if existingCfg.Email != "" {
 		cfg.Email = existingCfg.Email
 	}
 
-	if prefillToken != "" {
-		cfg.APIToken = prefillToken
-	} else if existingCfg.APIToken != "" {
+	if opts.authMethod != "" {
+		cfg.AuthMethod = opts.authMethod
+	} else if existingCfg.AuthMethod != "" {
+		cfg.AuthMethod = existingCfg.AuthMethod
+	} else {
+		cfg.AuthMethod = auth.MethodBasic
+	}
+
+	// An existing OAuth profile stores encoded credentials, not a token
+	var clientSecret string
+	if existingCfg.AuthMethod == auth.MethodOAuth {
+		if creds, err := auth.ParseOAuthCredentials(existingCfg.APIToken); err == nil {
+			clientSecret = creds.ClientSecret
+		}
+	} else if existingCfg.AuthMethod == cfg.AuthMethod || (existingCfg.AuthMethod == "" && cfg.AuthMethod == auth.MethodBasic) {
 		cfg.APIToken = existingCfg.APIToken
 	}
 
+	if opts.token != "" {
+		cfg.APIToken = opts.token
+	}
+
+	if opts.oauthClientID != "" {
+		cfg.OAuthClientID = opts.oauthClientID
+	} else {
+		cfg.OAuthClientID = existingCfg.OAuthClientID
+	}
+	if opts.oauthClientSecret != "" {
+		clientSecret = opts.oauthClientSecret
+	}
+
 	if existingCfg.DefaultProject != "" {
 		cfg.DefaultProject = existingCfg.DefaultProject
 	}
 
+	if opts.credentialStore != "" {
+		cfg.CredentialStore = opts.credentialStore
+	} else {
+		cfg.CredentialStore = existingCfg.CredentialStore
+	}
+
+	required := func(name string) func(string) error {
+		return func(s string) error {
+			if s == "" {
+				return fmt.Errorf("%s is required", name)
+			}
+			return nil
+		}
+	}
+
 	// Build the form
 	form := huh.NewForm(
 		huh.NewGroup(
@@ -107,37 +200,58 @@ func runInit(opts *root.Options, prefillURL, prefillEmail, prefillToken string,
 				Description("Your Jira instance URL").
 				Placeholder("https://mycompany.atlassian.net").
 				Value(&cfg.URL).
-				Validate(func(s string) error {
-					if s == "" {
-						return fmt.Errorf("URL is required")
-					}
-					return nil
-				}),
+				Validate(required("URL")),
+
+			huh.NewSelect[string]().
+				Title("Authentication").
+				Options(
+					huh.NewOption("Email and API token", auth.MethodBasic),
+					huh.NewOption("Personal access token (Data Center)", auth.MethodBearer),
+					huh.NewOption("OAuth 2.0 app", auth.MethodOAuth),
+				).
+				Value(&cfg.AuthMethod),
+		),
 
+		huh.NewGroup(
 			huh.NewInput().
 				Title("Email").
 				Description("Your Atlassian account email").
 				Placeholder("you@example.com").
 				Value(&cfg.Email).
-				Validate(func(s string) error {
-					if s == "" {
-						return fmt.Errorf("email is required")
-					}
-					return nil
-				}),
+				Validate(required("email")),
 
 			huh.NewInput().
 				Title("API Token").
 				Description("Generate at: id.atlassian.com/manage-profile/security/api-tokens").
 				EchoMode(huh.EchoModePassword).
 				Value(&cfg.APIToken).
-				Validate(func(s string) error {
-					if s == "" {
-						return fmt.Errorf("API token is required")
-					}
-					return nil
-				}),
+				Validate(required("API token")),
+		).WithHideFunc(func() bool { return cfg.AuthMethod != auth.MethodBasic }),
+
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Personal Access Token").
+				Description("Create one from your Jira profile: Personal Access Tokens").
+				EchoMode(huh.EchoModePassword).
+				Value(&cfg.APIToken).
+				Validate(required("personal access token")),
+		).WithHideFunc(func() bool { return cfg.AuthMethod != auth.MethodBearer }),
+
+		huh.NewGroup(
+			huh.NewInput().
+				Title("OAuth Client ID").
+				Description("From your app at developer.atlassian.com/console/myapps").
+				Value(&cfg.OAuthClientID).
+				Validate(required("client ID")),
+
+			huh.NewInput().
+				Title("OAuth Client Secret").
+				EchoMode(huh.EchoModePassword).
+				Value(&clientSecret).
+				Validate(required("client secret")),
+		).WithHideFunc(func() bool { return cfg.AuthMethod != auth.MethodOAuth }),
 
+		huh.NewGroup(
 			huh.NewInput().
 				Title("Default Project (optional)").
 				Description("Default project key for commands").
@@ -153,20 +267,40 @@ func runInit(opts *root.Options, prefillURL, prefillEmail, prefillToken string,
 	// Normalize URL
 	cfg.URL = sharedurl.NormalizeURL(cfg.URL)
 
+	var authn auth.Authenticator
+	switch cfg.AuthMethod {
+	case auth.MethodBearer:
+		authn = &auth.Bearer{Token: cfg.APIToken}
+	case auth.MethodOAuth:
+		oauthCfg := &auth.OAuthConfig{
+			ClientID:     cfg.OAuthClientID,
+			ClientSecret: clientSecret,
+			RedirectURL:  opts.oauthRedirectURL,
+			Scopes:       oauthScopes,
+		}
+		token, err := loginOAuth(ctx, opts, oauthCfg, cfg)
+		if err != nil {
+			return err
+		}
+		authn = auth.NewOAuth(oauthCfg, token)
+	}
+
 	// Verify connection unless --no-verify
-	if !noVerify {
+	if !opts.noVerify {
 		v.Println("Testing connection...")
 
-		client, err := api.New(api.ClientConfig{
+		client, err := api.NewWithContext(ctx, api.ClientConfig{
 			URL:      cfg.URL,
 			Email:    cfg.Email,
 			APIToken: cfg.APIToken,
+			Auth:     authn,
+			CloudID:  cfg.CloudID,
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create client: %w", err)
 		}
 
-		user, err := client.GetCurrentUser()
+		user, err := client.GetCurrentUser(ctx)
 		if err != nil {
 			v.Error("Connection failed: %v", err)
 			v.Println("")
@@ -179,12 +313,18 @@ func runInit(opts *root.Options, prefillURL, prefillEmail, prefillToken string,
 		v.Println("")
 	}
 
+	// Basic is the default, so leave it out of the file
+	if cfg.AuthMethod == auth.MethodBasic {
+		cfg.AuthMethod = ""
+	}
+
 	// Save configuration
 	if err := config.Save(cfg); err != nil {
 		return fmt.Errorf("failed to save configuration: %w", err)
 	}
 
-	v.Success("Configuration saved to %s", configPath)
+	v.Success("Profile %s saved to %s", profile, configPath)
+	v.Success("Credentials stored in %s", cfg.TokenStore)
 	v.Println("")
 	v.Println("Try it out:")
 	v.Println("  jtk me")
@@ -192,3 +332,32 @@ func runInit(opts *root.Options, prefillURL, prefillEmail, prefillToken string,
 
 	return nil
 }
+
+// loginOAuth logs in through the browser and records the token and the
+// site's cloud ID in cfg
+func loginOAuth(ctx context.Context, opts *initOptions, oauthCfg *auth.OAuthConfig, cfg *config.Config) (*auth.Token, error) {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	token, err := oauthCfg.Login(ctx, opts.Stdout, auth.OpenBrowser)
+	if err != nil {
+		return nil, fmt.Errorf("OAuth login failed: %w", err)
+	}
+
+	resources, err := oauthCfg.AccessibleResources(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	site, ok := auth.FindResource(resources, cfg.URL)
+	if !ok {
+		return nil, fmt.Errorf("the OAuth app was not granted access to %s", cfg.URL)
+	}
+	cfg.CloudID = site.ID
+
+	creds := &auth.OAuthCredentials{ClientSecret: oauthCfg.ClientSecret, Token: *token}
+	cfg.APIToken, err = creds.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
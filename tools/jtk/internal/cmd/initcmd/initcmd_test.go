@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	sharedconfig "github.com/open-cli-collective/atlassian-go/config"
+
 	"github.com/open-cli-collective/jira-ticket-cli/internal/config"
 )
 
@@ -32,8 +34,8 @@ func TestConfig_DefaultProject_Struct(t *testing.T) {
 	cfg := &config.Config{
 		URL:            "https://test.atlassian.net",
 		Email:          "test@example.com",
-		APIToken:       "token",
 		DefaultProject: "MYPROJ",
+		Auth:           sharedconfig.Auth{APIToken: "token"},
 	}
 	assert.Equal(t, "MYPROJ", cfg.DefaultProject)
 }
@@ -1,39 +1,66 @@
 package issues
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/bulk"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
 
 func newAssignCmd(opts *root.Options) *cobra.Command {
 	var unassign bool
+	var bulkFlags bulk.Flags
 
 	cmd := &cobra.Command{
 		Use:   "assign <issue-key> [account-id]",
 		Short: "Assign an issue to a user",
-		Long:  "Assign an issue to a user by their account ID, or unassign it.",
+		Long: `Assign an issue to a user by their account ID, or unassign it.
+
+With --jql, every matching issue is assigned instead, after showing how many
+issues match and asking for confirmation. The only argument is then the
+account ID.`,
 		Example: `  # Assign to a user
   jtk issues assign PROJ-123 5b10ac8d82e05b22cc7d4ef5
 
   # Unassign an issue
-  jtk issues assign PROJ-123 --unassign`,
-		Args: cobra.RangeArgs(1, 2),
+  jtk issues assign PROJ-123 --unassign
+
+  # Hand over everything still open in a sprint
+  jtk issues assign --jql 'sprint = 42 AND statusCategory != Done' 5b10ac8d82e05b22cc7d4ef5`,
+		Args: cobra.RangeArgs(0, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if bulkFlags.JQL != "" {
+				if len(args) > 1 {
+					return fmt.Errorf("with --jql, give only the account ID")
+				}
+				accountID := ""
+				if len(args) > 0 {
+					accountID = args[0]
+				}
+				return runAssignJQL(cmd.Context(), opts, bulkFlags, accountID, unassign)
+			}
+			if len(args) == 0 {
+				return fmt.Errorf("requires an issue key or --jql")
+			}
 			accountID := ""
 			if len(args) > 1 {
 				accountID = args[1]
 			}
-			return runAssign(opts, args[0], accountID, unassign)
+			return runAssign(cmd.Context(), opts, args[0], accountID, unassign)
 		},
 	}
 
 	cmd.Flags().BoolVar(&unassign, "unassign", false, "Remove current assignee")
+	bulk.AddFlags(cmd, &bulkFlags)
 
 	return cmd
 }
 
-func runAssign(opts *root.Options, issueKey, accountID string, unassign bool) error {
+func runAssign(ctx context.Context, opts *root.Options, issueKey, accountID string, unassign bool) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -45,7 +72,7 @@ func runAssign(opts *root.Options, issueKey, accountID string, unassign bool) er
 		accountID = ""
 	}
 
-	if err := client.AssignIssue(issueKey, accountID); err != nil {
+	if err := client.AssignIssue(ctx, issueKey, accountID); err != nil {
 		return err
 	}
 
@@ -54,7 +81,7 @@ func runAssign(opts *root.Options, issueKey, accountID string, unassign bool) er
 	} else {
 		// Try to get the user's display name for a friendlier message
 		displayName := accountID
-		if user, err := client.GetUser(accountID); err == nil && user.DisplayName != "" {
+		if user, err := client.GetUser(ctx, accountID); err == nil && user.DisplayName != "" {
 			displayName = user.DisplayName
 		}
 		v.Success("Assigned issue %s to %s", issueKey, displayName)
@@ -62,3 +89,30 @@ func runAssign(opts *root.Options, issueKey, accountID string, unassign bool) er
 
 	return nil
 }
+
+func runAssignJQL(ctx context.Context, opts *root.Options, bulkFlags bulk.Flags, accountID string, unassign bool) error {
+	if unassign {
+		accountID = ""
+	}
+	if accountID == "" && !unassign {
+		return fmt.Errorf("give an account ID or --unassign")
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	change := "unassign"
+	if accountID != "" {
+		displayName := accountID
+		if user, err := client.GetUser(ctx, accountID); err == nil && user.DisplayName != "" {
+			displayName = user.DisplayName
+		}
+		change = "assign to " + displayName
+	}
+
+	return bulk.Run(ctx, opts, bulkFlags, change, func(ctx context.Context, issue *api.Issue) error {
+		return client.AssignIssue(ctx, issue.Key, accountID)
+	})
+}
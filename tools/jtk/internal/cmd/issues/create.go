@@ -1,11 +1,14 @@
 package issues
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
 	"github.com/open-cli-collective/jira-ticket-cli/api"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
@@ -30,7 +33,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
   # Create with custom fields
   jtk issues create --project MYPROJECT --type Story --summary "New feature" --field priority=High`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runCreate(opts, project, issueType, summary, description, fields)
+			return runCreate(cmd.Context(), opts, project, issueType, summary, description, fields)
 		},
 	}
 
@@ -46,7 +49,7 @@ func newCreateCmd(opts *root.Options) *cobra.Command {
 	return cmd
 }
 
-func runCreate(opts *root.Options, project, issueType, summary, description string, fieldArgs []string) error {
+func runCreate(ctx context.Context, opts *root.Options, project, issueType, summary, description string, fieldArgs []string) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -58,7 +61,7 @@ func runCreate(opts *root.Options, project, issueType, summary, description stri
 	extraFields := make(map[string]interface{})
 	if len(fieldArgs) > 0 {
 		// Get field metadata to resolve names to IDs
-		allFields, err := client.GetFields()
+		allFields, err := client.GetFields(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to get field metadata: %w", err)
 		}
@@ -83,13 +86,16 @@ func runCreate(opts *root.Options, project, issueType, summary, description stri
 	}
 
 	req := api.BuildCreateRequest(project, issueType, summary, description, extraFields)
+	if err := api.CheckADFFields(req.Fields); err != nil {
+		return err
+	}
 
-	issue, err := client.CreateIssue(req)
+	issue, err := client.CreateIssue(ctx, req)
 	if err != nil {
 		return err
 	}
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(issue)
 	}
 
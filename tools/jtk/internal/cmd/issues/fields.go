@@ -1,8 +1,11 @@
 package issues
 
 import (
+	"context"
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
 	"github.com/open-cli-collective/jira-ticket-cli/api"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
@@ -28,7 +31,7 @@ func newFieldsCmd(opts *root.Options) *cobra.Command {
 			if len(args) > 0 {
 				issueKey = args[0]
 			}
-			return runFields(opts, issueKey, customOnly)
+			return runFields(cmd.Context(), opts, issueKey, customOnly)
 		},
 	}
 
@@ -37,7 +40,7 @@ func newFieldsCmd(opts *root.Options) *cobra.Command {
 	return cmd
 }
 
-func runFields(opts *root.Options, issueKey string, customOnly bool) error {
+func runFields(ctx context.Context, opts *root.Options, issueKey string, customOnly bool) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -47,12 +50,12 @@ func runFields(opts *root.Options, issueKey string, customOnly bool) error {
 
 	if issueKey != "" {
 		// Get editable fields for a specific issue
-		meta, err := client.GetIssueEditMeta(issueKey)
+		meta, err := client.GetIssueEditMeta(ctx, issueKey)
 		if err != nil {
 			return err
 		}
 
-		if opts.Output == "json" {
+		if view.IsStructured(opts.Output) {
 			return v.JSON(meta)
 		}
 
@@ -93,16 +96,16 @@ func runFields(opts *root.Options, issueKey string, customOnly bool) error {
 	// List all fields
 	var fields []api.Field
 	if customOnly {
-		fields, err = client.GetCustomFields()
+		fields, err = client.GetCustomFields(ctx)
 	} else {
-		fields, err = client.GetFields()
+		fields, err = client.GetFields(ctx)
 	}
 
 	if err != nil {
 		return err
 	}
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(fields)
 	}
 
@@ -1,17 +1,20 @@
 package issues
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/atlassian-go/view"
 
+	"github.com/open-cli-collective/jira-ticket-cli/api"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
 
 func newGetCmd(opts *root.Options) *cobra.Command {
 	var full bool
+	var markdown bool
 
 	cmd := &cobra.Command{
 		Use:   "get <issue-key>",
@@ -19,19 +22,21 @@ func newGetCmd(opts *root.Options) *cobra.Command {
 		Long:  "Retrieve and display details for a specific issue.",
 		Example: `  jtk issues get PROJ-123
   jtk issues get PROJ-123 --full
-  jtk issues get PROJ-123 -o json`,
+  jtk issues get PROJ-123 -o json
+  jtk issues get PROJ-123 -o json --markdown`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runGet(opts, args[0], full)
+			return runGet(cmd.Context(), opts, args[0], full, markdown)
 		},
 	}
 
 	cmd.Flags().BoolVar(&full, "full", false, "Show full description without truncation")
+	cmd.Flags().BoolVar(&markdown, "markdown", false, "Add the description as Markdown (descriptionMarkdown) to JSON output")
 
 	return cmd
 }
 
-func runGet(opts *root.Options, issueKey string, full bool) error {
+func runGet(ctx context.Context, opts *root.Options, issueKey string, full, markdown bool) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -39,13 +44,19 @@ func runGet(opts *root.Options, issueKey string, full bool) error {
 		return err
 	}
 
-	issue, err := client.GetIssue(issueKey)
+	issue, err := client.GetIssue(ctx, issueKey)
 	if err != nil {
 		return err
 	}
 
 	// For JSON output, return the full issue
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
+		if markdown {
+			return v.JSON(&markdownIssue{
+				Issue:               issue,
+				DescriptionMarkdown: issue.Fields.Description.ToMarkdown(),
+			})
+		}
 		return v.JSON(issue)
 	}
 
@@ -77,7 +88,7 @@ func runGet(opts *root.Options, issueKey string, full bool) error {
 
 	description := ""
 	if issue.Fields.Description != nil {
-		description = issue.Fields.Description.ToPlainText()
+		description = issue.Fields.Description.ToMarkdown()
 		if !full && len(description) > 200 {
 			description = description[:200] + "... [truncated, use --full for complete text]"
 		}
@@ -98,6 +109,13 @@ func runGet(opts *root.Options, issueKey string, full bool) error {
 	return nil
 }
 
+// markdownIssue adds the description rendered as Markdown to an issue for
+// JSON output.
+type markdownIssue struct {
+	*api.Issue
+	DescriptionMarkdown string `json:"descriptionMarkdown,omitempty"`
+}
+
 func orDash(s string) string {
 	if s == "" {
 		return "-"
@@ -2,6 +2,7 @@ package issues
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -65,7 +66,7 @@ func TestRunGet_TruncatesDescription(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runGet(opts, "TEST-1", false)
+	err = runGet(context.Background(), opts, "TEST-1", false, false)
 	require.NoError(t, err)
 
 	output := stdout.String()
@@ -104,7 +105,7 @@ func TestRunGet_FullDescription(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runGet(opts, "TEST-1", true)
+	err = runGet(context.Background(), opts, "TEST-1", true, false)
 	require.NoError(t, err)
 
 	output := stdout.String()
@@ -141,7 +142,7 @@ func TestRunGet_ShortDescriptionNotTruncated(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runGet(opts, "TEST-1", false)
+	err = runGet(context.Background(), opts, "TEST-1", false, false)
 	require.NoError(t, err)
 
 	output := stdout.String()
@@ -177,7 +178,7 @@ func TestRunGet_JSONOutputIgnoresFullFlag(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runGet(opts, "TEST-1", true)
+	err = runGet(context.Background(), opts, "TEST-1", true, false)
 	require.NoError(t, err)
 
 	// Should be valid JSON
@@ -186,3 +187,47 @@ func TestRunGet_JSONOutputIgnoresFullFlag(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "TEST-1", result.Key)
 }
+
+func TestRunGet_DescriptionAsMarkdown(t *testing.T) {
+	issue := api.Issue{
+		Key: "TEST-1",
+		Fields: api.IssueFields{
+			Summary:     "Test issue",
+			Description: &api.Description{ADF: api.NewADFDocument("Fix the `parser`, see [the spec](https://example.com/spec)")},
+			Status:      &api.Status{Name: "Open"},
+			IssueType:   &api.IssueType{Name: "Task"},
+		},
+	}
+
+	server := newTestIssueServer(t, issue)
+	defer server.Close()
+
+	client, err := api.New(api.ClientConfig{
+		URL:      server.URL,
+		Email:    "test@example.com",
+		APIToken: "token",
+	})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{
+		Output: "table",
+		Stdout: &stdout,
+		Stderr: &bytes.Buffer{},
+	}
+	opts.SetAPIClient(client)
+
+	err = runGet(context.Background(), opts, "TEST-1", true, false)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Fix the `parser`, see [the spec](https://example.com/spec)")
+
+	stdout.Reset()
+	opts.Output = "json"
+	err = runGet(context.Background(), opts, "TEST-1", false, true)
+	require.NoError(t, err)
+
+	var result map[string]interface{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &result))
+	assert.Equal(t, "TEST-1", result["key"])
+	assert.Equal(t, "Fix the `parser`, see [the spec](https://example.com/spec)", result["descriptionMarkdown"])
+}
@@ -0,0 +1,192 @@
+package issues
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/timeflag"
+)
+
+func newHistoryCmd(opts *root.Options) *cobra.Command {
+	var fields []string
+	var since string
+
+	cmd := &cobra.Command{
+		Use:     "history <issue-key>",
+		Aliases: []string{"changelog"},
+		Short:   "Show the change history of an issue",
+		Long: `Show who changed which fields of an issue and when, oldest first.
+
+Each row is one field change with its old and new value. Use --field to show
+only some fields, by name or ID, and --since to skip older changes.`,
+		Example: `  # Full history
+  jtk issues history PROJ-123
+
+  # Status changes only
+  jtk issues history PROJ-123 --field status
+
+  # Recent changes to a custom field
+  jtk issues history PROJ-123 --field "Story Points" --since 2026-10-01`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runHistory(cmd.Context(), opts, args[0], fields, since)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&fields, "field", nil, "Only show changes to this field, by name or ID (can be repeated)")
+	cmd.Flags().StringVar(&since, "since", "", "Only show changes on or after this date (YYYY-MM-DD or RFC 3339)")
+
+	return cmd
+}
+
+// historyEntry is one field change, as shown by the history command
+type historyEntry struct {
+	ID      string `json:"id"`
+	Created string `json:"created"`
+	Author  string `json:"author,omitempty"`
+	Field   string `json:"field"`
+	FieldID string `json:"fieldId,omitempty"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+}
+
+func runHistory(ctx context.Context, opts *root.Options, issueKey string, fieldFilters []string, since string) error {
+	v := opts.View()
+
+	var sinceTime time.Time
+	if since != "" {
+		var err error
+		if sinceTime, err = timeflag.Parse("since", since); err != nil {
+			return err
+		}
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	allFields, err := client.GetFields(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get field metadata: %w", err)
+	}
+
+	histories, err := client.GetAllChangelog(ctx, issueKey)
+	if err != nil {
+		return err
+	}
+
+	var entries []historyEntry
+	for _, h := range histories {
+		if !sinceTime.IsZero() {
+			if created, err := h.CreatedTime(); err == nil && created.Before(sinceTime) {
+				continue
+			}
+		}
+
+		author := ""
+		if h.Author != nil {
+			author = h.Author.DisplayName
+		}
+
+		for _, item := range h.Items {
+			name := changeFieldName(allFields, item)
+			if len(fieldFilters) > 0 && !matchesFieldFilter(allFields, fieldFilters, item, name) {
+				continue
+			}
+			entries = append(entries, historyEntry{
+				ID:      h.ID,
+				Created: h.Created,
+				Author:  author,
+				Field:   name,
+				FieldID: item.FieldID,
+				From:    changeValue(item.FromString, item.From),
+				To:      changeValue(item.ToString, item.To),
+			})
+		}
+	}
+
+	if view.IsStructured(opts.Output) {
+		if entries == nil {
+			entries = []historyEntry{}
+		}
+		return v.JSON(entries)
+	}
+
+	if len(entries) == 0 {
+		v.Info("No changes found for %s", issueKey)
+		return nil
+	}
+
+	headers := []string{"DATE", "AUTHOR", "FIELD", "FROM", "TO"}
+	rows := make([][]string, len(entries))
+	for i, e := range entries {
+		rows[i] = []string{
+			formatChangeTime(e.Created),
+			e.Author,
+			e.Field,
+			view.Truncate(oneLine(e.From), 40),
+			view.Truncate(oneLine(e.To), 40),
+		}
+	}
+
+	return v.Table(headers, rows)
+}
+
+// changeFieldName returns the display name of a changed field. Changelog
+// items name some custom fields by ID, so those are looked up.
+func changeFieldName(allFields []api.Field, item api.ChangeItem) string {
+	if item.FieldID != "" {
+		if f := api.FindFieldByID(allFields, item.FieldID); f != nil {
+			return f.Name
+		}
+	}
+	if f := api.FindFieldByID(allFields, item.Field); f != nil {
+		return f.Name
+	}
+	return item.Field
+}
+
+// matchesFieldFilter reports whether a change is to one of the fields
+// given with --field, by name or ID
+func matchesFieldFilter(allFields []api.Field, filters []string, item api.ChangeItem, name string) bool {
+	for _, filter := range filters {
+		if strings.EqualFold(filter, name) || strings.EqualFold(filter, item.Field) ||
+			(item.FieldID != "" && strings.EqualFold(filter, item.FieldID)) {
+			return true
+		}
+		if id, err := api.ResolveFieldID(allFields, filter); err == nil && (id == item.FieldID || id == item.Field) {
+			return true
+		}
+	}
+	return false
+}
+
+// changeValue prefers the display form of a changed value
+func changeValue(display, raw string) string {
+	if display != "" {
+		return display
+	}
+	return raw
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// formatChangeTime shortens a changelog time for display
+func formatChangeTime(created string) string {
+	t, err := time.Parse(api.TimeFormat, created)
+	if err != nil {
+		return created
+	}
+	return t.Format("2006-01-02 15:04")
+}
@@ -0,0 +1,89 @@
+package issues
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+func newHistoryServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			json.NewEncoder(w).Encode([]api.Field{
+				{ID: "status", Name: "Status"},
+				{ID: "customfield_10016", Name: "Story Points", Custom: true},
+			})
+		case "/rest/api/3/issue/PROJ-1/changelog":
+			fmt.Fprint(w, `{"startAt": 0, "total": 3, "isLast": true, "values": [
+				{"id": "1", "created": "2026-09-20T09:30:00.000+0000", "author": {"displayName": "Jane"},
+					"items": [{"field": "status", "fieldId": "status", "from": "1", "fromString": "To Do", "to": "3", "toString": "In Progress"}]},
+				{"id": "2", "created": "2026-10-02T10:00:00.000+0000", "author": {"displayName": "Raj"},
+					"items": [
+						{"field": "customfield_10016", "fieldtype": "custom", "fieldId": "customfield_10016", "to": "5"},
+						{"field": "description", "fieldId": "description", "fromString": "Old\ntext", "toString": "New text"}
+					]},
+				{"id": "3", "created": "2026-10-05T16:45:00.000+0000",
+					"items": [{"field": "status", "fieldId": "status", "from": "3", "fromString": "In Progress", "to": "4", "toString": "Done"}]}
+			]}`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+}
+
+func newHistoryOptions(t *testing.T, output string) (*root.Options, *bytes.Buffer) {
+	server := newHistoryServer(t)
+	t.Cleanup(server.Close)
+
+	client, err := api.New(api.ClientConfig{URL: server.URL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{Output: output, Stdout: &stdout, Stderr: &bytes.Buffer{}}
+	opts.SetAPIClient(client)
+	return opts, &stdout
+}
+
+func TestRunHistory(t *testing.T) {
+	opts, stdout := newHistoryOptions(t, "table")
+
+	require.NoError(t, runHistory(context.Background(), opts, "PROJ-1", nil, ""))
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.Len(t, lines, 5)
+	assert.Contains(t, lines[1], "2026-09-20 09:30")
+	assert.Contains(t, lines[1], "Jane")
+	assert.Contains(t, lines[2], "Story Points")
+	assert.Contains(t, lines[3], "Old text")
+	assert.Contains(t, lines[4], "Done")
+}
+
+func TestRunHistory_FieldAndSince(t *testing.T) {
+	opts, stdout := newHistoryOptions(t, "json")
+
+	require.NoError(t, runHistory(context.Background(), opts, "PROJ-1", []string{"status", "story points"}, "2026-10-01"))
+
+	var entries []historyEntry
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &entries))
+	assert.Equal(t, []historyEntry{
+		{ID: "2", Created: "2026-10-02T10:00:00.000+0000", Author: "Raj", Field: "Story Points", FieldID: "customfield_10016", To: "5"},
+		{ID: "3", Created: "2026-10-05T16:45:00.000+0000", Field: "Status", FieldID: "status", From: "In Progress", To: "Done"},
+	}, entries)
+}
+
+func TestRunHistory_InvalidSince(t *testing.T) {
+	err := runHistory(context.Background(), &root.Options{}, "PROJ-1", nil, "last week")
+	assert.ErrorContains(t, err, "invalid --since")
+}
@@ -0,0 +1,518 @@
+package issues
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// epicLinkSchema is the custom type of the classic Epic Link field
+const epicLinkSchema = "com.pyxis.greenhopper.jira:gh-epic-link"
+
+func newImportCmd(opts *root.Options) *cobra.Command {
+	var file, format, project, issueType string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Create issues from a CSV, YAML or JSON file",
+		Long: `Create many issues at once from a CSV, YAML or JSON file.
+
+Each row (CSV) or list item (YAML, JSON) is one issue. Columns are field names
+or IDs, resolved like --field in 'jtk issues create'. A few columns are special:
+
+  ref       A name for the row, for later rows to refer to
+  project   Project key (default --project)
+  type      Issue type (default --type)
+  parent    Parent issue: an issue key or the ref of an earlier row
+
+The Epic Link field also accepts the ref of an earlier row. Array fields such
+as labels and components take several values as a YAML or JSON list, or as
+comma-separated text.
+
+Issues are created with Jira's bulk endpoint, 50 at a time; rows that refer to
+earlier rows are created after them. Issues that were created stay created if
+later rows fail. Use --dry-run to check the file against the fields each
+project and issue type allow, without creating anything.`,
+		Example: `  # Check a plan, then import it
+  jtk issues import --file plan.csv --project PROJ --dry-run
+  jtk issues import --file plan.csv --project PROJ
+
+  # plan.csv
+  ref,type,summary,parent,labels
+  login,Epic,Login revamp,,
+  ,Story,SSO support,login,"auth,sso"
+  ,Story,Remember me,login,auth
+
+  # plan.yaml
+  - ref: login
+    type: Epic
+    summary: Login revamp
+  - type: Story
+    summary: SSO support
+    parent: login
+    labels: [auth, sso]`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImport(cmd.Context(), opts, file, format, project, issueType, dryRun)
+		},
+	}
+
+	cmd.Flags().StringVar(&file, "file", "", "File to import, or - for stdin (required)")
+	cmd.Flags().StringVar(&format, "format", "", "File format: csv, yaml or json (default from the file extension)")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Project key for rows without a project column")
+	cmd.Flags().StringVarP(&issueType, "type", "t", "Task", "Issue type for rows without a type column")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the file without creating issues")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+// plannedIssue is an import row resolved to issue fields
+type plannedIssue struct {
+	Row     int
+	Ref     string
+	Project string
+	Type    string
+	Summary string
+	Fields  map[string]interface{}
+	// Values holds the raw values of Fields, for validation
+	Values map[string][]string
+	// Links are fields set to the key of an earlier row once it is created
+	Links []plannedLink
+	// Level orders creation: rows are created after the rows they link to
+	Level int
+	Key   string
+	Err   error
+}
+
+// plannedLink sets a field to the key of another planned issue
+type plannedLink struct {
+	Field *api.Field
+	Issue *plannedIssue
+}
+
+// importResult is the outcome of one row of an import
+type importResult struct {
+	Row     int    `json:"row"`
+	Ref     string `json:"ref,omitempty"`
+	Key     string `json:"key,omitempty"`
+	Summary string `json:"summary"`
+	Status  string `json:"status"`
+	Error   string `json:"error,omitempty"`
+}
+
+func runImport(ctx context.Context, opts *root.Options, file, format, project, issueType string, dryRun bool) error {
+	v := opts.View()
+
+	rows, err := readImportFile(file, format)
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		v.Info("No issues to import in %s", file)
+		return nil
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	allFields, err := client.GetFields(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get field metadata: %w", err)
+	}
+
+	planned, err := planImport(rows, allFields, project, issueType)
+	if err != nil {
+		return err
+	}
+
+	invalid := countFailed(planned)
+	if dryRun {
+		if invalid == 0 {
+			validateImport(ctx, client, planned)
+		}
+		return reportImport(opts, planned, true)
+	}
+	if invalid > 0 {
+		// Nothing is created from a file with mistakes in it
+		return reportImport(opts, planned, true)
+	}
+
+	createPlanned(ctx, client, planned)
+	return reportImport(opts, planned, false)
+}
+
+// planImport resolves the columns of every row to fields. Problems with
+// the file as a whole are returned as an error; problems with one row are
+// recorded on the row.
+func planImport(rows []importRow, allFields []api.Field, defaultProject, defaultType string) ([]*plannedIssue, error) {
+	resolved := map[string]*api.Field{}
+	resolveColumn := func(column string) (*api.Field, error) {
+		if field, ok := resolved[column]; ok {
+			return field, nil
+		}
+		var field *api.Field
+		if id, err := api.ResolveFieldID(allFields, column); err == nil {
+			field = api.FindFieldByID(allFields, id)
+		} else if strings.EqualFold(column, "parent") {
+			field = &api.Field{ID: "parent", Name: "Parent"}
+		} else {
+			return nil, fmt.Errorf("unknown column %q: no field with that name or ID", column)
+		}
+		resolved[column] = field
+		return field, nil
+	}
+
+	planned := make([]*plannedIssue, 0, len(rows))
+	refs := map[string]*plannedIssue{}
+	// Refs of every row, to tell a reference to a later row from an issue key
+	laterRefs := map[string]int{}
+	for _, row := range rows {
+		for _, f := range row.Fields {
+			if strings.EqualFold(f.Column, "ref") {
+				laterRefs[f.Values[0]] = row.Number
+			}
+		}
+	}
+
+	for _, row := range rows {
+		p := &plannedIssue{
+			Row:     row.Number,
+			Project: defaultProject,
+			Type:    defaultType,
+			Fields:  map[string]interface{}{},
+			Values:  map[string][]string{},
+		}
+		planned = append(planned, p)
+
+		var problems []string
+		for _, f := range row.Fields {
+			switch strings.ToLower(f.Column) {
+			case "ref":
+				p.Ref = f.Values[0]
+				if other, ok := refs[p.Ref]; ok {
+					problems = append(problems, fmt.Sprintf("ref %q is already used by row %d", p.Ref, other.Row))
+				} else {
+					refs[p.Ref] = p
+				}
+				continue
+			case "project":
+				p.Project = f.Values[0]
+				continue
+			case "type", "issuetype", "issue type":
+				p.Type = f.Values[0]
+				continue
+			}
+
+			field, err := resolveColumn(f.Column)
+			if err != nil {
+				return nil, err
+			}
+
+			values := f.Values
+			if field.Schema.Type == "array" && len(values) == 1 {
+				values = splitList(values[0])
+			}
+
+			if field.ID == "parent" || field.Schema.Custom == epicLinkSchema {
+				if target, ok := refs[values[0]]; ok {
+					p.Links = append(p.Links, plannedLink{Field: field, Issue: target})
+					if target.Level >= p.Level {
+						p.Level = target.Level + 1
+					}
+					continue
+				}
+				if later, ok := laterRefs[values[0]]; ok {
+					problems = append(problems, fmt.Sprintf("%s refers to row %d, which must come before this row", field.Name, later))
+					continue
+				}
+			}
+
+			value, err := api.FormatFieldValues(field, values)
+			if err != nil {
+				problems = append(problems, fmt.Sprintf("%s: %v", f.Column, err))
+				continue
+			}
+			p.Fields[field.ID] = value
+			p.Values[field.ID] = values
+		}
+
+		if summary, ok := p.Fields["summary"].(string); ok {
+			p.Summary = summary
+		} else {
+			problems = append(problems, "summary is required")
+		}
+		if p.Project == "" {
+			problems = append(problems, "project is required (add a project column or use --project)")
+		}
+		if p.Type == "" {
+			problems = append(problems, "issue type is required (add a type column or use --type)")
+		}
+		if err := api.CheckADFFields(p.Fields); err != nil {
+			problems = append(problems, err.Error())
+		}
+
+		if len(problems) > 0 {
+			p.Err = fmt.Errorf("%s", strings.Join(problems, "; "))
+		}
+	}
+
+	return planned, nil
+}
+
+// splitList splits comma-separated values for array fields
+func splitList(s string) []string {
+	var values []string
+	for _, value := range strings.Split(s, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// createPlanned creates the planned issues level by level, in bulk
+// requests of up to api.BulkCreateLimit issues, filling in their keys or
+// errors
+func createPlanned(ctx context.Context, client *api.Client, planned []*plannedIssue) {
+	maxLevel := 0
+	for _, p := range planned {
+		if p.Level > maxLevel {
+			maxLevel = p.Level
+		}
+	}
+
+	for level := 0; level <= maxLevel; level++ {
+		var batch []*plannedIssue
+		for _, p := range planned {
+			if p.Level != level || p.Err != nil {
+				continue
+			}
+			if err := resolveLinks(p); err != nil {
+				p.Err = err
+				continue
+			}
+			batch = append(batch, p)
+		}
+
+		for start := 0; start < len(batch); start += api.BulkCreateLimit {
+			chunk := batch[start:min(start+api.BulkCreateLimit, len(batch))]
+			reqs := make([]*api.CreateIssueRequest, len(chunk))
+			for i, p := range chunk {
+				reqs[i] = buildImportRequest(p)
+			}
+
+			results, err := client.BulkCreateIssues(ctx, reqs)
+			for i, p := range chunk {
+				switch {
+				case err != nil:
+					p.Err = err
+				case results[i].Err != nil:
+					p.Err = results[i].Err
+				default:
+					p.Key = results[i].Issue.Key
+				}
+			}
+		}
+	}
+}
+
+// resolveLinks sets the fields that refer to earlier rows to their keys
+func resolveLinks(p *plannedIssue) error {
+	for _, link := range p.Links {
+		if link.Issue.Key == "" {
+			return fmt.Errorf("%s row %d was not created", strings.ToLower(link.Field.Name), link.Issue.Row)
+		}
+		p.Fields[link.Field.ID] = api.FormatFieldValue(link.Field, link.Issue.Key)
+	}
+	return nil
+}
+
+// buildImportRequest builds the create request for a planned issue
+func buildImportRequest(p *plannedIssue) *api.CreateIssueRequest {
+	fields := map[string]interface{}{
+		"project":   map[string]string{"key": p.Project},
+		"issuetype": map[string]string{"name": p.Type},
+	}
+	for id, value := range p.Fields {
+		fields[id] = value
+	}
+	return &api.CreateIssueRequest{Fields: fields}
+}
+
+// validateImport checks planned issues against the create metadata of
+// their project and issue type, recording problems on the rows
+func validateImport(ctx context.Context, client *api.Client, planned []*plannedIssue) {
+	issueTypes := map[string][]api.CreateMetaIssueType{}
+	typeErrs := map[string]error{}
+	metaFields := map[string][]api.CreateMetaField{}
+	fieldErrs := map[string]error{}
+
+	for _, p := range planned {
+		if p.Err != nil {
+			continue
+		}
+
+		if _, ok := issueTypes[p.Project]; !ok && typeErrs[p.Project] == nil {
+			issueTypes[p.Project], typeErrs[p.Project] = client.GetCreateMetaIssueTypes(ctx, p.Project)
+		}
+		if err := typeErrs[p.Project]; err != nil {
+			p.Err = fmt.Errorf("project %s: %w", p.Project, err)
+			continue
+		}
+
+		issueType := api.FindCreateMetaIssueType(issueTypes[p.Project], p.Type)
+		if issueType == nil {
+			p.Err = fmt.Errorf("issue type %q cannot be created in %s", p.Type, p.Project)
+			continue
+		}
+
+		metaKey := p.Project + "/" + issueType.ID
+		if _, ok := metaFields[metaKey]; !ok && fieldErrs[metaKey] == nil {
+			metaFields[metaKey], fieldErrs[metaKey] = client.GetCreateMetaFields(ctx, p.Project, issueType.ID)
+		}
+		if err := fieldErrs[metaKey]; err != nil {
+			p.Err = fmt.Errorf("%s in %s: %w", issueType.Name, p.Project, err)
+			continue
+		}
+
+		if problems := checkCreateMeta(p, metaFields[metaKey]); len(problems) > 0 {
+			p.Err = fmt.Errorf("%s", strings.Join(problems, "; "))
+		}
+	}
+}
+
+// checkCreateMeta returns the problems creating a planned issue with the
+// given create metadata: fields that cannot be set, values that are not
+// allowed and required fields that are missing
+func checkCreateMeta(p *plannedIssue, meta []api.CreateMetaField) []string {
+	byID := make(map[string]*api.CreateMetaField, len(meta))
+	for i := range meta {
+		byID[meta[i].FieldID] = &meta[i]
+	}
+
+	set := make(map[string]bool, len(p.Fields)+len(p.Links))
+	for id := range p.Fields {
+		set[id] = true
+	}
+	for _, link := range p.Links {
+		set[link.Field.ID] = true
+	}
+
+	var problems []string
+	for _, id := range sortedFieldIDs(set) {
+		field, ok := byID[id]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("field %s cannot be set on a %s in %s", id, p.Type, p.Project))
+			continue
+		}
+		for _, value := range p.Values[id] {
+			if len(field.AllowedValues) > 0 && !allowedValue(field.AllowedValues, value) {
+				problems = append(problems, fmt.Sprintf("%s: %q is not an allowed value", field.Name, value))
+			}
+		}
+	}
+
+	for _, field := range meta {
+		if field.Required && !field.HasDefaultValue && !set[field.FieldID] &&
+			field.FieldID != "project" && field.FieldID != "issuetype" {
+			problems = append(problems, fmt.Sprintf("required field %s is missing", field.Name))
+		}
+	}
+
+	return problems
+}
+
+func allowedValue(allowed []api.FieldOption, value string) bool {
+	for _, option := range allowed {
+		if option.ID == value || strings.EqualFold(option.Name, value) || strings.EqualFold(option.Value, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedFieldIDs(set map[string]bool) []string {
+	ids := make([]string, 0, len(set))
+	for id := range set {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func countFailed(planned []*plannedIssue) int {
+	n := 0
+	for _, p := range planned {
+		if p.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// reportImport shows the outcome of every row and returns an error if any
+// row failed. checked reports whether the rows were only validated.
+func reportImport(opts *root.Options, planned []*plannedIssue, checked bool) error {
+	v := opts.View()
+
+	results := make([]importResult, len(planned))
+	for i, p := range planned {
+		r := importResult{Row: p.Row, Ref: p.Ref, Key: p.Key, Summary: p.Summary}
+		switch {
+		case p.Err != nil && checked:
+			r.Status = "invalid"
+		case p.Err != nil:
+			r.Status = "failed"
+		case checked:
+			r.Status = "ok"
+		default:
+			r.Status = "created"
+		}
+		if p.Err != nil {
+			r.Error = p.Err.Error()
+		}
+		results[i] = r
+	}
+
+	if view.IsStructured(opts.Output) {
+		if err := v.JSON(results); err != nil {
+			return err
+		}
+	} else {
+		headers := []string{"ROW", "REF", "KEY", "STATUS", "SUMMARY", "ERROR"}
+		rows := make([][]string, len(results))
+		for i, r := range results {
+			rows[i] = []string{strconv.Itoa(r.Row), r.Ref, r.Key, r.Status, view.Truncate(r.Summary, 50), r.Error}
+		}
+		if err := v.Table(headers, rows); err != nil {
+			return err
+		}
+	}
+
+	failed := countFailed(planned)
+	switch {
+	case failed > 0 && checked:
+		return fmt.Errorf("%d of %d row(s) are invalid; no issues were created", failed, len(planned))
+	case failed > 0:
+		return fmt.Errorf("%d of %d issue(s) could not be created", failed, len(planned))
+	case view.IsMachineReadable(opts.Output):
+	case checked:
+		v.Success("All %d row(s) are valid", len(planned))
+	default:
+		v.Success("Created %d issue(s)", len(planned))
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package issues
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// importRow is one issue to create, as read from an import file
+type importRow struct {
+	// Number is the position of the row among the issues, from 1. In CSV
+	// it counts the lines below the header, blank ones included.
+	Number int
+	Fields []importField
+}
+
+// importField is one column of an import row. Values holds more than one
+// value for lists in YAML or JSON.
+type importField struct {
+	Column string
+	Values []string
+}
+
+// readImportFile reads the rows of an import file. The format is taken
+// from the file extension unless given.
+func readImportFile(path, format string) ([]importRow, error) {
+	if format == "" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".csv":
+			format = "csv"
+		case ".yaml", ".yml":
+			format = "yaml"
+		case ".json":
+			format = "json"
+		default:
+			return nil, fmt.Errorf("cannot tell the format of %s from its extension; use --format csv, yaml or json", path)
+		}
+	}
+
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	switch format {
+	case "csv":
+		return parseImportCSV(r)
+	case "yaml", "json":
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		var records []map[string]interface{}
+		if format == "yaml" {
+			err = yaml.Unmarshal(data, &records)
+		} else {
+			err = json.Unmarshal(data, &records)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: expected a list of issues: %w", path, err)
+		}
+		return importRecords(records)
+	default:
+		return nil, fmt.Errorf("invalid format %q (expected csv, yaml or json)", format)
+	}
+}
+
+// parseImportCSV reads rows from CSV with a header row naming the fields.
+// Blank rows are skipped, but still counted in row numbers.
+func parseImportCSV(r io.Reader) ([]importRow, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff"))
+	}
+	headerLine, _ := reader.FieldPos(0)
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CSV: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := importRow{Number: line - headerLine}
+		for i, cell := range record {
+			cell = strings.TrimSpace(cell)
+			if cell == "" || header[i] == "" {
+				continue
+			}
+			row.Fields = append(row.Fields, importField{Column: header[i], Values: []string{cell}})
+		}
+		if len(row.Fields) > 0 {
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// importRecords converts decoded YAML or JSON issues to rows. Columns are
+// sorted, since maps do not keep the order of the file.
+func importRecords(records []map[string]interface{}) ([]importRow, error) {
+	rows := make([]importRow, 0, len(records))
+	for i, record := range records {
+		row := importRow{Number: i + 1}
+
+		columns := make([]string, 0, len(record))
+		for column := range record {
+			columns = append(columns, column)
+		}
+		sort.Strings(columns)
+
+		for _, column := range columns {
+			values, err := importValues(record[column])
+			if err != nil {
+				return nil, fmt.Errorf("issue %d, %s: %w", row.Number, column, err)
+			}
+			if len(values) > 0 {
+				row.Fields = append(row.Fields, importField{Column: column, Values: values})
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// importValues converts a scalar or a list of scalars to strings
+func importValues(value interface{}) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		var values []string
+		for _, item := range v {
+			switch item.(type) {
+			case nil:
+				continue
+			case []interface{}, map[string]interface{}:
+				return nil, fmt.Errorf("lists may only hold plain values")
+			}
+			if s := importScalar(item); s != "" {
+				values = append(values, s)
+			}
+		}
+		return values, nil
+	case map[string]interface{}:
+		return nil, fmt.Errorf("nested objects are not supported; give the value as text")
+	default:
+		s := importScalar(v)
+		if s == "" {
+			return nil, nil
+		}
+		return []string{s}, nil
+	}
+}
+
+// importScalar formats a plain YAML or JSON value as text. YAML reads
+// unquoted dates as times, which are written back as dates.
+func importScalar(value interface{}) string {
+	if t, ok := value.(time.Time); ok {
+		if t.Equal(t.Truncate(24 * time.Hour)) {
+			return t.Format("2006-01-02")
+		}
+		return t.Format(time.RFC3339)
+	}
+	return strings.TrimSpace(fmt.Sprint(value))
+}
@@ -0,0 +1,275 @@
+package issues
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+var importTestFields = []api.Field{
+	{ID: "summary", Name: "Summary", Schema: api.FieldSchema{Type: "string", System: "summary"}},
+	{ID: "description", Name: "Description", Schema: api.FieldSchema{Type: "string", System: "description"}},
+	{ID: "labels", Name: "Labels", Schema: api.FieldSchema{Type: "array", Items: "string", System: "labels"}},
+	{ID: "priority", Name: "Priority", Schema: api.FieldSchema{Type: "priority", System: "priority"}},
+	{ID: "customfield_10014", Name: "Epic Link", Custom: true, Schema: api.FieldSchema{Type: "any", Custom: epicLinkSchema}},
+}
+
+func writeImportFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestReadImportFile_CSV(t *testing.T) {
+	path := writeImportFile(t, "plan.csv", "\ufeffref,Summary,labels\nepic,Login revamp,\n\n,SSO support,\"auth, sso\"\n")
+
+	rows, err := readImportFile(path, "")
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, []importField{{Column: "ref", Values: []string{"epic"}}, {Column: "Summary", Values: []string{"Login revamp"}}}, rows[0].Fields)
+	assert.Equal(t, 1, rows[0].Number)
+	assert.Equal(t, 3, rows[1].Number, "the blank line counts as a row")
+	assert.Equal(t, importField{Column: "labels", Values: []string{"auth, sso"}}, rows[1].Fields[1])
+}
+
+func TestReadImportFile_YAML(t *testing.T) {
+	path := writeImportFile(t, "plan.yml", `
+- summary: SSO support
+  labels: [auth, "", sso]
+  ref: [""]
+  duedate: 2026-11-01
+  points: 3
+`)
+
+	rows, err := readImportFile(path, "")
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, []importField{
+		{Column: "duedate", Values: []string{"2026-11-01"}},
+		{Column: "labels", Values: []string{"auth", "sso"}},
+		{Column: "points", Values: []string{"3"}},
+		{Column: "summary", Values: []string{"SSO support"}},
+	}, rows[0].Fields)
+}
+
+func TestReadImportFile_Errors(t *testing.T) {
+	_, err := readImportFile(writeImportFile(t, "plan.txt", "summary\nx\n"), "")
+	assert.ErrorContains(t, err, "--format")
+
+	_, err = readImportFile(writeImportFile(t, "plan.json", `{"summary": "x"}`), "")
+	assert.ErrorContains(t, err, "expected a list of issues")
+
+	_, err = readImportFile(writeImportFile(t, "plan.json", `[{"summary": {"text": "x"}}]`), "")
+	assert.ErrorContains(t, err, "nested objects")
+}
+
+func TestPlanImport(t *testing.T) {
+	rows := []importRow{
+		{Number: 1, Fields: []importField{{Column: "ref", Values: []string{"epic"}}, {Column: "type", Values: []string{"Epic"}}, {Column: "summary", Values: []string{"Login revamp"}}}},
+		{Number: 2, Fields: []importField{{Column: "summary", Values: []string{"SSO"}}, {Column: "Epic Link", Values: []string{"epic"}}, {Column: "labels", Values: []string{"auth, sso"}}}},
+		{Number: 3, Fields: []importField{{Column: "summary", Values: []string{"Remember me"}}, {Column: "parent", Values: []string{"PROJ-5"}}}},
+		{Number: 4, Fields: []importField{{Column: "summary", Values: []string{"Too early"}}, {Column: "parent", Values: []string{"late"}}}},
+		{Number: 5, Fields: []importField{{Column: "ref", Values: []string{"late"}}, {Column: "priority", Values: []string{"High"}}}},
+	}
+
+	planned, err := planImport(rows, importTestFields, "PROJ", "Task")
+	require.NoError(t, err)
+	require.Len(t, planned, 5)
+
+	assert.Equal(t, "Epic", planned[0].Type)
+	assert.Equal(t, 0, planned[0].Level)
+	assert.NoError(t, planned[0].Err)
+
+	assert.Equal(t, 1, planned[1].Level)
+	require.Len(t, planned[1].Links, 1)
+	assert.Same(t, planned[0], planned[1].Links[0].Issue)
+	assert.Equal(t, []string{"auth", "sso"}, planned[1].Fields["labels"])
+
+	assert.Equal(t, map[string]string{"key": "PROJ-5"}, planned[2].Fields["parent"])
+	assert.Equal(t, 0, planned[2].Level)
+
+	assert.ErrorContains(t, planned[3].Err, "refers to row 5")
+	assert.ErrorContains(t, planned[4].Err, "summary is required")
+}
+
+func TestPlanImport_UnknownColumn(t *testing.T) {
+	rows := []importRow{{Number: 1, Fields: []importField{{Column: "Sprint Goal", Values: []string{"x"}}}}}
+
+	_, err := planImport(rows, importTestFields, "PROJ", "Task")
+	assert.ErrorContains(t, err, `unknown column "Sprint Goal"`)
+}
+
+func TestRunImport(t *testing.T) {
+	var batches [][]map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			json.NewEncoder(w).Encode(importTestFields)
+		case "/rest/api/3/issue/bulk":
+			var req struct {
+				IssueUpdates []struct {
+					Fields map[string]interface{} `json:"fields"`
+				} `json:"issueUpdates"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			var batch []map[string]interface{}
+			for _, u := range req.IssueUpdates {
+				batch = append(batch, u.Fields)
+			}
+			batches = append(batches, batch)
+
+			if len(batches) == 1 {
+				w.Write([]byte(`{"issues": [{"key": "PROJ-1"}], "errors": [{"failedElementNumber": 1, "elementErrors": {"errors": {"priority": "Priority is invalid"}}}]}`))
+				return
+			}
+			w.Write([]byte(`{"issues": [{"key": "PROJ-3"}]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := api.New(api.ClientConfig{URL: server.URL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{Output: "table", Stdout: &stdout, Stderr: &bytes.Buffer{}}
+	opts.SetAPIClient(client)
+
+	path := writeImportFile(t, "plan.csv", `ref,type,summary,parent,priority
+epic,Epic,Login revamp,,
+bad,Story,Broken,,Urgent
+,Story,SSO support,epic,
+,Story,Orphan,bad,
+`)
+
+	err = runImport(context.Background(), opts, path, "", "PROJ", "Task", false)
+	assert.EqualError(t, err, "2 of 4 issue(s) could not be created")
+
+	require.Len(t, batches, 2)
+	require.Len(t, batches[0], 2)
+	assert.Equal(t, map[string]interface{}{"key": "PROJ"}, batches[0][0]["project"])
+	assert.Equal(t, map[string]interface{}{"name": "Epic"}, batches[0][0]["issuetype"])
+	require.Len(t, batches[1], 1)
+	assert.Equal(t, map[string]interface{}{"key": "PROJ-1"}, batches[1][0]["parent"])
+
+	output := stdout.String()
+	assert.Contains(t, output, "PROJ-1")
+	assert.Contains(t, output, "priority: Priority is invalid")
+	assert.Contains(t, output, "PROJ-3")
+	assert.Contains(t, output, "parent row 2 was not created")
+}
+
+func TestRunImport_CSV(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			json.NewEncoder(w).Encode(importTestFields)
+		case "/rest/api/3/issue/bulk":
+			w.Write([]byte(`{"issues": [{"key": "PROJ-1"}, {"key": "PROJ-2"}]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := api.New(api.ClientConfig{URL: server.URL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{Output: "csv", Stdout: &stdout, Stderr: &bytes.Buffer{}}
+	opts.SetAPIClient(client)
+
+	path := writeImportFile(t, "plan.csv", "summary\nOne\nTwo\n")
+
+	require.NoError(t, runImport(context.Background(), opts, path, "", "PROJ", "Task", false))
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	assert.Len(t, lines, 3, "a header and a row per issue, without the summary")
+	assert.NotContains(t, stdout.String(), "Created")
+}
+
+func TestRunImport_InvalidRowsCreateNothing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/field" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(importTestFields)
+	}))
+	defer server.Close()
+
+	client, err := api.New(api.ClientConfig{URL: server.URL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{Output: "json", Stdout: &stdout, Stderr: &bytes.Buffer{}}
+	opts.SetAPIClient(client)
+
+	path := writeImportFile(t, "plan.json", `[{"summary": "Fine"}, {"labels": ["x"]}]`)
+
+	err = runImport(context.Background(), opts, path, "", "PROJ", "Task", false)
+	assert.EqualError(t, err, "1 of 2 row(s) are invalid; no issues were created")
+
+	var results []importResult
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &results))
+	require.Len(t, results, 2)
+	assert.Equal(t, "ok", results[0].Status)
+	assert.Equal(t, "invalid", results[1].Status)
+}
+
+func TestRunImport_DryRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			json.NewEncoder(w).Encode(importTestFields)
+		case "/rest/api/3/issue/createmeta/PROJ/issuetypes":
+			w.Write([]byte(`{"total": 1, "issueTypes": [{"id": "1", "name": "Task"}]}`))
+		case "/rest/api/3/issue/createmeta/PROJ/issuetypes/1":
+			w.Write([]byte(`{"total": 4, "fields": [
+				{"fieldId": "summary", "name": "Summary", "required": true},
+				{"fieldId": "priority", "name": "Priority", "allowedValues": [{"id": "2", "name": "High"}, {"id": "3", "name": "Medium"}]},
+				{"fieldId": "labels", "name": "Labels"},
+				{"fieldId": "components", "name": "Components", "required": true}
+			]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := api.New(api.ClientConfig{URL: server.URL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{Output: "table", Stdout: &stdout, Stderr: &bytes.Buffer{}}
+	opts.SetAPIClient(client)
+
+	path := writeImportFile(t, "plan.csv", `summary,priority,description,type
+One,high,Text,
+Two,Urgent,,
+Three,,,Bug
+`)
+
+	err = runImport(context.Background(), opts, path, "", "PROJ", "Task", true)
+	assert.EqualError(t, err, "3 of 3 row(s) are invalid; no issues were created")
+
+	lines := strings.Split(stdout.String(), "\n")
+	require.GreaterOrEqual(t, len(lines), 4)
+	assert.Contains(t, lines[1], "field description cannot be set")
+	assert.Contains(t, lines[1], "required field Components is missing")
+	assert.NotContains(t, lines[1], "Priority")
+	assert.Contains(t, lines[2], `Priority: "Urgent" is not an allowed value`)
+	assert.Contains(t, lines[3], `issue type "Bug" cannot be created in PROJ`)
+}
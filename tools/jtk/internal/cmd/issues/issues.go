@@ -16,9 +16,11 @@ func Register(parent *cobra.Command, opts *root.Options) {
 	}
 
 	cmd.AddCommand(newGetCmd(opts))
+	cmd.AddCommand(newHistoryCmd(opts))
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newSearchCmd(opts))
 	cmd.AddCommand(newCreateCmd(opts))
+	cmd.AddCommand(newImportCmd(opts))
 	cmd.AddCommand(newUpdateCmd(opts))
 	cmd.AddCommand(newDeleteCmd(opts))
 	cmd.AddCommand(newAssignCmd(opts))
@@ -1,10 +1,14 @@
 package issues
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
 
@@ -12,6 +16,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var project string
 	var sprint string
 	var maxResults int
+	var all bool
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -24,20 +29,27 @@ func newListCmd(opts *root.Options) *cobra.Command {
   jtk issues list --project MYPROJECT --sprint current
 
   # List issues with custom limit
-  jtk issues list --project MYPROJECT --max 100`,
+  jtk issues list --project MYPROJECT --max 100
+
+  # List every matching issue
+  jtk issues list --project MYPROJECT --all`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runList(opts, project, sprint, maxResults)
+			if all {
+				maxResults = 0
+			}
+			return runList(cmd.Context(), opts, project, sprint, maxResults)
 		},
 	}
 
 	cmd.Flags().StringVarP(&project, "project", "p", "", "Filter by project key")
 	cmd.Flags().StringVarP(&sprint, "sprint", "s", "", "Filter by sprint (use 'current' for active sprint)")
 	cmd.Flags().IntVarP(&maxResults, "max", "m", 50, "Maximum number of results")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch all results, ignoring --max")
 
 	return cmd
 }
 
-func runList(opts *root.Options, project, sprint string, maxResults int) error {
+func runList(ctx context.Context, opts *root.Options, project, sprint string, maxResults int) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -72,7 +84,17 @@ func runList(opts *root.Options, project, sprint string, maxResults int) error {
 		jql += " ORDER BY updated DESC"
 	}
 
-	issues, err := client.SearchAll(jql, maxResults)
+	// Columns beyond the built-in ones are issue fields, requested in the search
+	headers := []string{"KEY", "SUMMARY", "STATUS", "ASSIGNEE", "TYPE"}
+	var columns []api.FieldColumn
+	if !view.IsStructured(opts.Output) {
+		columns, err = client.ResolveFieldColumns(ctx, opts.Columns, headers)
+		if err != nil {
+			return err
+		}
+	}
+
+	issues, err := client.SearchAll(ctx, jql, maxResults, api.FieldColumnIDs(columns)...)
 	if err != nil {
 		return err
 	}
@@ -83,11 +105,13 @@ func runList(opts *root.Options, project, sprint string, maxResults int) error {
 	}
 
 	// For JSON output
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(issues)
 	}
 
-	headers := []string{"KEY", "SUMMARY", "STATUS", "ASSIGNEE", "TYPE"}
+	for _, col := range columns {
+		headers = append(headers, col.Name)
+	}
 	var rows [][]string
 
 	for _, issue := range issues {
@@ -106,7 +130,8 @@ func runList(opts *root.Options, project, sprint string, maxResults int) error {
 			issueType = issue.Fields.IssueType.Name
 		}
 
-		rows = append(rows, formatIssueRow(issue.Key, issue.Fields.Summary, status, assignee, issueType))
+		row := formatIssueRow(issue.Key, issue.Fields.Summary, status, assignee, issueType)
+		rows = append(rows, append(row, api.FieldColumnValues(issue, columns)...))
 	}
 
 	return v.Table(headers, rows)
@@ -0,0 +1,101 @@
+package issues
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+func newTestSearchServer(t *testing.T, gotFields *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/field":
+			_ = json.NewEncoder(w).Encode([]api.Field{
+				{ID: "summary", Name: "Summary"},
+				{ID: "customfield_10016", Name: "Story Points", Custom: true},
+			})
+		case "/rest/api/3/search/jql":
+			var req api.SearchRequest
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			*gotFields = req.Fields
+			_, _ = w.Write([]byte(`{"issues":[{"key":"PROJ-1","fields":{
+				"summary":"First","status":{"name":"Done"},"created":"2024-01-15T10:30:00.000+0000",
+				"customfield_10016":5}}],"isLast":true}`))
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func newListTestOptions(t *testing.T, serverURL string) (*root.Options, *bytes.Buffer) {
+	client, err := api.New(api.ClientConfig{
+		URL:      serverURL,
+		Email:    "test@example.com",
+		APIToken: "token",
+	})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{
+		Output: "table",
+		Stdout: &stdout,
+		Stderr: &bytes.Buffer{},
+	}
+	opts.SetAPIClient(client)
+	return opts, &stdout
+}
+
+func TestRunList_CustomFieldColumns(t *testing.T) {
+	var gotFields []string
+	server := newTestSearchServer(t, &gotFields)
+	defer server.Close()
+
+	opts, stdout := newListTestOptions(t, server.URL)
+	opts.Output = "csv"
+	opts.Columns = []string{"key", "Story Points", "status"}
+
+	err := runList(context.Background(), opts, "PROJ", "", 10)
+	require.NoError(t, err)
+
+	assert.Contains(t, gotFields, "customfield_10016")
+	assert.Contains(t, gotFields, "summary")
+	assert.Equal(t, "KEY,Story Points,STATUS\nPROJ-1,5,Done\n", stdout.String())
+}
+
+func TestRunList_UnknownColumn(t *testing.T) {
+	var gotFields []string
+	server := newTestSearchServer(t, &gotFields)
+	defer server.Close()
+
+	opts, _ := newListTestOptions(t, server.URL)
+	opts.Columns = []string{"KEY", "Velocity"}
+
+	err := runList(context.Background(), opts, "PROJ", "", 10)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown column "Velocity"`)
+}
+
+func TestRunSearch_Template(t *testing.T) {
+	var gotFields []string
+	server := newTestSearchServer(t, &gotFields)
+	defer server.Close()
+
+	opts, stdout := newListTestOptions(t, server.URL)
+	opts.Output = "template"
+	opts.Template = `{{range .}}{{.key}} {{.fields.status.name}} {{date "Jan 2" .fields.created}}{{end}}`
+
+	err := runSearch(context.Background(), opts, "project = PROJ", 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, "PROJ-1 Done Jan 15\n", stdout.String())
+}
@@ -1,14 +1,19 @@
 package issues
 
 import (
+	"context"
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
 
 func newSearchCmd(opts *root.Options) *cobra.Command {
 	var jql string
 	var maxResults int
+	var all bool
 
 	cmd := &cobra.Command{
 		Use:   "search",
@@ -21,20 +26,27 @@ func newSearchCmd(opts *root.Options) *cobra.Command {
   jtk issues search --jql "project = MYPROJECT AND updated >= -7d"
 
   # Search issues assigned to current user
-  jtk issues search --jql "assignee = currentUser() AND resolution = Unresolved"`,
+  jtk issues search --jql "assignee = currentUser() AND resolution = Unresolved"
+
+  # Fetch every match instead of the first page
+  jtk issues search --jql "project = MYPROJECT" --all`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runSearch(opts, jql, maxResults)
+			if all {
+				maxResults = 0
+			}
+			return runSearch(cmd.Context(), opts, jql, maxResults)
 		},
 	}
 
 	cmd.Flags().StringVar(&jql, "jql", "", "JQL query string (required)")
 	cmd.Flags().IntVarP(&maxResults, "max", "m", 50, "Maximum number of results")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch all results, ignoring --max")
 	_ = cmd.MarkFlagRequired("jql")
 
 	return cmd
 }
 
-func runSearch(opts *root.Options, jql string, maxResults int) error {
+func runSearch(ctx context.Context, opts *root.Options, jql string, maxResults int) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -42,7 +54,17 @@ func runSearch(opts *root.Options, jql string, maxResults int) error {
 		return err
 	}
 
-	issues, err := client.SearchAll(jql, maxResults)
+	// Columns beyond the built-in ones are issue fields, requested in the search
+	headers := []string{"KEY", "SUMMARY", "STATUS", "ASSIGNEE", "TYPE"}
+	var columns []api.FieldColumn
+	if !view.IsStructured(opts.Output) {
+		columns, err = client.ResolveFieldColumns(ctx, opts.Columns, headers)
+		if err != nil {
+			return err
+		}
+	}
+
+	issues, err := client.SearchAll(ctx, jql, maxResults, api.FieldColumnIDs(columns)...)
 	if err != nil {
 		return err
 	}
@@ -52,11 +74,13 @@ func runSearch(opts *root.Options, jql string, maxResults int) error {
 		return nil
 	}
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(issues)
 	}
 
-	headers := []string{"KEY", "SUMMARY", "STATUS", "ASSIGNEE", "TYPE"}
+	for _, col := range columns {
+		headers = append(headers, col.Name)
+	}
 	var rows [][]string
 
 	for _, issue := range issues {
@@ -75,7 +99,8 @@ func runSearch(opts *root.Options, jql string, maxResults int) error {
 			issueType = issue.Fields.IssueType.Name
 		}
 
-		rows = append(rows, formatIssueRow(issue.Key, issue.Fields.Summary, status, assignee, issueType))
+		row := formatIssueRow(issue.Key, issue.Fields.Summary, status, assignee, issueType)
+		rows = append(rows, append(row, api.FieldColumnValues(issue, columns)...))
 	}
 
 	return v.Table(headers, rows)
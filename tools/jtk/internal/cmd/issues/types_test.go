@@ -2,6 +2,7 @@ package issues
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
@@ -62,7 +63,7 @@ func TestRunTypes_Success(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runTypes(opts, "TEST")
+	err = runTypes(context.Background(), opts, "TEST")
 	require.NoError(t, err)
 
 	output := stdout.String()
@@ -93,7 +94,7 @@ func TestRunTypes_ProjectNotFound(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runTypes(opts, "INVALID")
+	err = runTypes(context.Background(), opts, "INVALID")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "not found")
 }
@@ -126,7 +127,7 @@ func TestRunTypes_EmptyIssueTypes(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runTypes(opts, "EMPTY")
+	err = runTypes(context.Background(), opts, "EMPTY")
 	require.NoError(t, err)
 	assert.Contains(t, stdout.String(), "No issue types found")
 }
@@ -162,7 +163,7 @@ func TestRunTypes_JSONOutput(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runTypes(opts, "TEST")
+	err = runTypes(context.Background(), opts, "TEST")
 	require.NoError(t, err)
 
 	// Verify JSON output
@@ -209,7 +210,7 @@ func TestRunTypes_DescriptionTruncation(t *testing.T) {
 	}
 	opts.SetAPIClient(client)
 
-	err = runTypes(opts, "TEST")
+	err = runTypes(context.Background(), opts, "TEST")
 	require.NoError(t, err)
 
 	output := stdout.String()
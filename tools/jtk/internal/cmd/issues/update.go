@@ -1,12 +1,15 @@
 package issues
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/bulk"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
 
@@ -14,11 +17,16 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
 	var summary string
 	var description string
 	var fields []string
+	var addLabels, removeLabels []string
+	var bulkFlags bulk.Flags
 
 	cmd := &cobra.Command{
 		Use:   "update <issue-key>",
 		Short: "Update an issue",
-		Long:  "Update fields on an existing Jira issue.",
+		Long: `Update fields on an existing Jira issue.
+
+With --jql, every matching issue is updated instead, after showing how many
+issues match and asking for confirmation.`,
 		Example: `  # Update summary
   jtk issues update PROJ-123 --summary "New summary"
 
@@ -26,21 +34,55 @@ func newUpdateCmd(opts *root.Options) *cobra.Command {
   jtk issues update PROJ-123 --description "Updated description"
 
   # Update custom fields
-  jtk issues update PROJ-123 --field priority=High --field "Story Points"=5`,
-		Args: cobra.ExactArgs(1),
+  jtk issues update PROJ-123 --field priority=High --field "Story Points"=5
+
+  # Add and remove labels, keeping the others
+  jtk issues update PROJ-123 --add-label backend --remove-label triage
+
+  # Label every open bug in a component
+  jtk issues update --jql 'project = PROJ AND component = API AND type = Bug' --add-label api-bug`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runUpdate(opts, args[0], summary, description, fields)
+			u := issueUpdate{
+				Summary:      summary,
+				Description:  description,
+				FieldArgs:    fields,
+				AddLabels:    addLabels,
+				RemoveLabels: removeLabels,
+			}
+			if bulkFlags.JQL != "" {
+				if len(args) > 0 {
+					return fmt.Errorf("give either an issue key or --jql, not both")
+				}
+				return runUpdateJQL(cmd.Context(), opts, bulkFlags, u)
+			}
+			if len(args) == 0 {
+				return fmt.Errorf("requires an issue key or --jql")
+			}
+			return runUpdate(cmd.Context(), opts, args[0], u)
 		},
 	}
 
 	cmd.Flags().StringVarP(&summary, "summary", "s", "", "New summary")
 	cmd.Flags().StringVarP(&description, "description", "d", "", "New description")
 	cmd.Flags().StringArrayVarP(&fields, "field", "f", nil, "Fields to update (key=value)")
+	cmd.Flags().StringArrayVar(&addLabels, "add-label", nil, "Label to add (can be repeated)")
+	cmd.Flags().StringArrayVar(&removeLabels, "remove-label", nil, "Label to remove (can be repeated)")
+	bulk.AddFlags(cmd, &bulkFlags)
 
 	return cmd
 }
 
-func runUpdate(opts *root.Options, issueKey, summary, description string, fieldArgs []string) error {
+// issueUpdate holds the changes requested by the update flags
+type issueUpdate struct {
+	Summary      string
+	Description  string
+	FieldArgs    []string
+	AddLabels    []string
+	RemoveLabels []string
+}
+
+func runUpdate(ctx context.Context, opts *root.Options, issueKey string, u issueUpdate) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -48,27 +90,58 @@ func runUpdate(opts *root.Options, issueKey, summary, description string, fieldA
 		return err
 	}
 
+	req, err := buildUpdate(ctx, client, u)
+	if err != nil {
+		return err
+	}
+
+	if err := client.UpdateIssue(ctx, issueKey, req); err != nil {
+		return err
+	}
+
+	v.Success("Updated issue %s", issueKey)
+	return nil
+}
+
+func runUpdateJQL(ctx context.Context, opts *root.Options, bulkFlags bulk.Flags, u issueUpdate) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	req, err := buildUpdate(ctx, client, u)
+	if err != nil {
+		return err
+	}
+
+	return bulk.Run(ctx, opts, bulkFlags, describeUpdate(req), func(ctx context.Context, issue *api.Issue) error {
+		return client.UpdateIssue(ctx, issue.Key, req)
+	})
+}
+
+// buildUpdate builds the update request for the requested changes
+func buildUpdate(ctx context.Context, client *api.Client, u issueUpdate) (*api.UpdateIssueRequest, error) {
 	fields := make(map[string]interface{})
 
-	if summary != "" {
-		fields["summary"] = summary
+	if u.Summary != "" {
+		fields["summary"] = u.Summary
 	}
 
-	if description != "" {
-		fields["description"] = api.NewADFDocument(description)
+	if u.Description != "" {
+		fields["description"] = api.NewADFDocument(u.Description)
 	}
 
 	// Parse additional fields
-	if len(fieldArgs) > 0 {
-		allFields, err := client.GetFields()
+	if len(u.FieldArgs) > 0 {
+		allFields, err := client.GetFields(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to get field metadata: %w", err)
+			return nil, fmt.Errorf("failed to get field metadata: %w", err)
 		}
 
-		for _, f := range fieldArgs {
+		for _, f := range u.FieldArgs {
 			parts := strings.SplitN(f, "=", 2)
 			if len(parts) != 2 {
-				return fmt.Errorf("invalid field format: %s (expected key=value)", f)
+				return nil, fmt.Errorf("invalid field format: %s (expected key=value)", f)
 			}
 
 			key, value := parts[0], parts[1]
@@ -91,16 +164,53 @@ func runUpdate(opts *root.Options, issueKey, summary, description string, fieldA
 		}
 	}
 
-	if len(fields) == 0 {
-		return fmt.Errorf("no fields specified to update")
+	// Labels are added and removed with update operations, so that the
+	// other labels of each issue are kept
+	var labelOps []map[string]string
+	for _, label := range u.AddLabels {
+		labelOps = append(labelOps, map[string]string{"add": label})
+	}
+	for _, label := range u.RemoveLabels {
+		labelOps = append(labelOps, map[string]string{"remove": label})
+	}
+	if labelOps != nil {
+		if _, ok := fields["labels"]; ok {
+			return nil, fmt.Errorf("cannot set labels with --field and use --add-label or --remove-label")
+		}
 	}
 
-	req := api.BuildUpdateRequest(fields)
+	if len(fields) == 0 && labelOps == nil {
+		return nil, fmt.Errorf("no fields specified to update")
+	}
 
-	if err := client.UpdateIssue(issueKey, req); err != nil {
-		return err
+	if err := api.CheckADFFields(fields); err != nil {
+		return nil, err
 	}
 
-	v.Success("Updated issue %s", issueKey)
-	return nil
+	req := api.BuildUpdateRequest(fields)
+	if labelOps != nil {
+		req.Update = map[string]interface{}{"labels": labelOps}
+	}
+	return req, nil
+}
+
+// describeUpdate summarises an update request for the confirmation prompt
+func describeUpdate(req *api.UpdateIssueRequest) string {
+	var parts []string
+	if len(req.Fields) > 0 {
+		ids := make([]string, 0, len(req.Fields))
+		for id := range req.Fields {
+			ids = append(ids, id)
+		}
+		sort.Strings(ids)
+		parts = append(parts, "set "+strings.Join(ids, ", "))
+	}
+	if ops, ok := req.Update["labels"].([]map[string]string); ok {
+		for _, op := range ops {
+			for verb, label := range op {
+				parts = append(parts, fmt.Sprintf("%s label %q", verb, label))
+			}
+		}
+	}
+	return strings.Join(parts, "; ")
 }
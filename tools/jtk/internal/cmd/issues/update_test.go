@@ -0,0 +1,39 @@
+package issues
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+)
+
+func TestBuildUpdate_Labels(t *testing.T) {
+	req, err := buildUpdate(context.Background(), nil, issueUpdate{
+		Summary:      "New summary",
+		AddLabels:    []string{"backend"},
+		RemoveLabels: []string{"triage"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]interface{}{"summary": "New summary"}, req.Fields)
+	assert.Equal(t, []map[string]string{{"add": "backend"}, {"remove": "triage"}}, req.Update["labels"])
+	assert.Equal(t, `set summary; add label "backend"; remove label "triage"`, describeUpdate(req))
+}
+
+func TestBuildUpdate_NothingToUpdate(t *testing.T) {
+	_, err := buildUpdate(context.Background(), nil, issueUpdate{})
+	assert.EqualError(t, err, "no fields specified to update")
+}
+
+func TestBuildUpdate_OnlyLabels(t *testing.T) {
+	req, err := buildUpdate(context.Background(), nil, issueUpdate{RemoveLabels: []string{"stale"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, &api.UpdateIssueRequest{
+		Fields: map[string]interface{}{},
+		Update: map[string]interface{}{"labels": []map[string]string{{"remove": "stale"}}},
+	}, req)
+}
@@ -0,0 +1,238 @@
+package links
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// Register registers the links commands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:     "links",
+		Aliases: []string{"link"},
+		Short:   "Manage issue links",
+		Long:    "Commands for listing, creating and deleting links between issues.",
+	}
+
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newAddCmd(opts))
+	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newTypesCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list <issue-key>",
+		Short:   "List links on an issue",
+		Long:    "List the links from an issue to other issues.",
+		Example: `  jtk links list PROJ-123`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(cmd.Context(), opts, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runList(ctx context.Context, opts *root.Options, issueKey string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	links, err := client.GetIssueLinks(ctx, issueKey)
+	if err != nil {
+		return err
+	}
+
+	if len(links) == 0 {
+		v.Info("No links on %s", issueKey)
+		return nil
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(links)
+	}
+
+	headers := []string{"ID", "LINK", "KEY", "SUMMARY", "STATUS"}
+	var rows [][]string
+
+	for i := range links {
+		desc, other := links[i].Describe()
+		if other == nil {
+			continue
+		}
+		status := ""
+		if other.Fields.Status != nil {
+			status = other.Fields.Status.Name
+		}
+		rows = append(rows, []string{links[i].ID, desc, other.Key, other.Fields.Summary, status})
+	}
+
+	return v.Table(headers, rows)
+}
+
+func newAddCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <from-key> <link-type> <to-key>",
+		Short: "Link two issues",
+		Long: `Link two issues. The link reads from left to right, as in "PROJ-1 blocks PROJ-2".
+
+The link type can be given by name (Blocks) or by the description of either
+direction (blocks, "is blocked by"), case-insensitively. The type name reads
+in the outward direction. Use 'jtk links types' to see the available types.`,
+		Example: `  # PROJ-1 blocks PROJ-2
+  jtk links add PROJ-1 Blocks PROJ-2
+
+  # The same link, written from the other side
+  jtk links add PROJ-2 "is blocked by" PROJ-1
+
+  jtk links add PROJ-1 relates PROJ-3`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAdd(cmd.Context(), opts, args[0], args[1], args[2])
+		},
+	}
+
+	return cmd
+}
+
+func runAdd(ctx context.Context, opts *root.Options, fromKey, typeName, toKey string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	types, err := client.GetIssueLinkTypes(ctx)
+	if err != nil {
+		return err
+	}
+
+	linkType, inward := api.FindLinkTypeByName(types, typeName)
+	if linkType == nil {
+		v.Error("Link type '%s' not found", typeName)
+		v.Info("Available link types:")
+		for _, t := range types {
+			v.Info("  %s (%s / %s)", t.Name, t.Outward, t.Inward)
+		}
+		return fmt.Errorf("link type not found: %s", typeName)
+	}
+
+	// The API always takes the link in the outward direction
+	inwardKey, outwardKey, desc := fromKey, toKey, linkType.Outward
+	if inward {
+		inwardKey, outwardKey, desc = toKey, fromKey, linkType.Inward
+	}
+
+	if err := client.CreateIssueLink(ctx, linkType.Name, inwardKey, outwardKey); err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(map[string]string{
+			"status":       "linked",
+			"type":         linkType.Name,
+			"inwardIssue":  inwardKey,
+			"outwardIssue": outwardKey,
+		})
+	}
+
+	v.Success("Linked %s %s %s", fromKey, desc, toKey)
+	return nil
+}
+
+func newDeleteCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete <link-id>",
+		Short:   "Delete an issue link",
+		Long:    "Delete a link between two issues. Use 'jtk links list' to find link IDs.",
+		Example: `  jtk links delete 10042`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDelete(cmd.Context(), opts, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runDelete(ctx context.Context, opts *root.Options, linkID string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteIssueLink(ctx, linkID); err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(map[string]string{"status": "deleted", "linkId": linkID})
+	}
+
+	v.Success("Deleted link %s", linkID)
+	return nil
+}
+
+func newTypesCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "types",
+		Short:   "List issue link types",
+		Long:    "List the issue link types available on the site.",
+		Example: `  jtk links types`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTypes(cmd.Context(), opts)
+		},
+	}
+
+	return cmd
+}
+
+func runTypes(ctx context.Context, opts *root.Options) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	types, err := client.GetIssueLinkTypes(ctx)
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(types)
+	}
+
+	if len(types) == 0 {
+		v.Info("No issue link types found")
+		return nil
+	}
+
+	headers := []string{"ID", "NAME", "OUTWARD", "INWARD"}
+	var rows [][]string
+
+	for _, t := range types {
+		rows = append(rows, []string{t.ID, t.Name, t.Outward, t.Inward})
+	}
+
+	return v.Table(headers, rows)
+}
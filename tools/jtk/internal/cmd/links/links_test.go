@@ -0,0 +1,117 @@
+package links
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/testutil"
+)
+
+const linkTypesJSON = `{"issueLinkTypes": [
+	{"id": "1", "name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+	{"id": "2", "name": "Relates", "inward": "relates to", "outward": "relates to"}
+]}`
+
+func TestRunList(t *testing.T) {
+	opts, stdout, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"key": "PROJ-1", "fields": {"issuelinks": [
+			{"id": "100", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+			 "outwardIssue": {"key": "PROJ-2", "fields": {"summary": "Ship it", "status": {"name": "To Do"}}}},
+			{"id": "101", "type": {"name": "Blocks", "inward": "is blocked by", "outward": "blocks"},
+			 "inwardIssue": {"key": "PROJ-3", "fields": {"summary": "Design", "status": {"name": "Done"}}}}
+		]}}`))
+	})
+
+	err := runList(context.Background(), opts, "PROJ-1")
+	require.NoError(t, err)
+
+	output := stdout.String()
+	assert.Regexp(t, `100\s+blocks\s+PROJ-2\s+Ship it\s+To Do`, output)
+	assert.Regexp(t, `101\s+is blocked by\s+PROJ-3\s+Design\s+Done`, output)
+}
+
+func TestRunAdd(t *testing.T) {
+	tests := []struct {
+		name        string
+		typeName    string
+		wantInward  string
+		wantOutward string
+		wantMessage string
+	}{
+		{
+			name:        "type name",
+			typeName:    "blocks",
+			wantInward:  "PROJ-1",
+			wantOutward: "PROJ-2",
+			wantMessage: "Linked PROJ-1 blocks PROJ-2",
+		},
+		{
+			name:        "inward description",
+			typeName:    "Is Blocked By",
+			wantInward:  "PROJ-2",
+			wantOutward: "PROJ-1",
+			wantMessage: "Linked PROJ-1 is blocked by PROJ-2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req api.CreateIssueLinkRequest
+			opts, stdout, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/rest/api/3/issueLinkType":
+					w.Write([]byte(linkTypesJSON))
+				case "/rest/api/3/issueLink":
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+					w.WriteHeader(http.StatusCreated)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+			})
+
+			err := runAdd(context.Background(), opts, "PROJ-1", tt.typeName, "PROJ-2")
+			require.NoError(t, err)
+
+			assert.Equal(t, "Blocks", req.Type.Name)
+			assert.Equal(t, tt.wantInward, req.InwardIssue.Key)
+			assert.Equal(t, tt.wantOutward, req.OutwardIssue.Key)
+			assert.Contains(t, stdout.String(), tt.wantMessage)
+		})
+	}
+}
+
+func TestRunAdd_UnknownType(t *testing.T) {
+	opts, stdout, stderr := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(linkTypesJSON))
+	})
+
+	err := runAdd(context.Background(), opts, "PROJ-1", "Clones", "PROJ-2")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "link type not found: Clones")
+	assert.Contains(t, stderr.String(), "Link type 'Clones' not found")
+	assert.Contains(t, stdout.String(), "Blocks (blocks / is blocked by)")
+}
+
+func TestRunTypes(t *testing.T) {
+	opts, stdout, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(linkTypesJSON))
+	})
+	opts.Output = "json"
+
+	err := runTypes(context.Background(), opts)
+	require.NoError(t, err)
+
+	var types []api.IssueLinkType
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &types))
+	assert.Len(t, types, 2)
+	assert.Equal(t, "is blocked by", types[0].Inward)
+}
@@ -0,0 +1,516 @@
+package report
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/bulk"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// Status category keys
+const (
+	categoryToDo       = "new"
+	categoryInProgress = "indeterminate"
+	categoryDone       = "done"
+)
+
+// flowGroupings are the accepted values of --by
+var flowGroupings = []string{"issue", "status", "week"}
+
+// flowPercentiles are the percentiles reported for cycle and lead time
+var flowPercentiles = []int{50, 85, 95}
+
+// flowReport holds the flow metrics of the issues matching a query
+type flowReport struct {
+	Issues     []issueFlow     `json:"issues"`
+	Statuses   []statusSummary `json:"statuses"`
+	Throughput []weekCount     `json:"throughput"`
+	CycleTime  durationSummary `json:"cycleTime"`
+	LeadTime   durationSummary `json:"leadTime"`
+}
+
+// issueFlow is the way one issue moved through its statuses. Cycle time
+// runs from first entering an in-progress status to being done; lead time
+// from creation to being done. Both are in days.
+type issueFlow struct {
+	Key           string       `json:"key"`
+	Summary       string       `json:"summary"`
+	Status        string       `json:"status"`
+	Created       time.Time    `json:"created"`
+	Started       *time.Time   `json:"started,omitempty"`
+	Done          *time.Time   `json:"done,omitempty"`
+	CycleTimeDays *float64     `json:"cycleTimeDays,omitempty"`
+	LeadTimeDays  *float64     `json:"leadTimeDays,omitempty"`
+	TimeInStatus  []statusTime `json:"timeInStatus"`
+}
+
+// statusTime is the time an issue spent in one status
+type statusTime struct {
+	Status   string  `json:"status"`
+	Category string  `json:"category,omitempty"`
+	Days     float64 `json:"days"`
+}
+
+// statusSummary is the time the issues spent in one status
+type statusSummary struct {
+	Status    string  `json:"status"`
+	Category  string  `json:"category,omitempty"`
+	Issues    int     `json:"issues"`
+	TotalDays float64 `json:"totalDays"`
+	MeanDays  float64 `json:"meanDays"`
+	P50Days   float64 `json:"p50Days"`
+	P85Days   float64 `json:"p85Days"`
+}
+
+// weekCount is the number of issues done in the week starting on Week
+type weekCount struct {
+	Week      string `json:"week"`
+	Completed int    `json:"completed"`
+}
+
+// durationSummary describes a set of durations, in days
+type durationSummary struct {
+	Count       int             `json:"count"`
+	MeanDays    float64         `json:"meanDays"`
+	Percentiles map[int]float64 `json:"percentiles"`
+}
+
+func newFlowCmd(opts *root.Options) *cobra.Command {
+	var jql, by string
+
+	cmd := &cobra.Command{
+		Use:   "flow",
+		Short: "Report cycle time, lead time and time in status",
+		Long: `Report flow metrics for the issues matching a JQL query, from their changelogs.
+
+  Time in status  how long each issue spent in each status
+  Cycle time      from first entering an in-progress status to being done
+  Lead time       from creation to being done
+  Throughput      issues done per week, from Monday
+
+Statuses count as to do, in progress or done by their status category in the
+issue's project. A reopened issue is not done until it is done again, and is
+then measured to that time. Issues not done have no cycle or lead time.
+
+--by chooses the table: issue (with cycle and lead time percentiles), status
+or week. JSON output always holds every part of the report.`,
+		Example: `  # Cycle and lead time of the last sprint
+  jtk report flow --jql "sprint = 42"
+
+  # Where issues wait, over the last quarter
+  jtk report flow --jql "project = PROJ AND resolved >= -90d" --by status
+
+  # Weekly throughput as CSV
+  jtk report flow --jql "project = PROJ AND resolved >= -90d" --by week -o csv`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFlow(cmd.Context(), opts, jql, by)
+		},
+	}
+
+	cmd.Flags().StringVar(&jql, "jql", "", "JQL query selecting the issues to report on (required)")
+	cmd.Flags().StringVar(&by, "by", "issue", "Table to show: issue, status or week")
+	_ = cmd.MarkFlagRequired("jql")
+
+	return cmd
+}
+
+func runFlow(ctx context.Context, opts *root.Options, jql, by string) error {
+	v := opts.View()
+
+	if !slices.Contains(flowGroupings, by) {
+		return fmt.Errorf("invalid --by value %q (expected issue, status or week)", by)
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	issues, err := client.SearchAll(ctx, jql, 0)
+	if err != nil {
+		return err
+	}
+
+	categories, err := statusCategories(ctx, client, issues)
+	if err != nil {
+		return err
+	}
+
+	index := make(map[string]int, len(issues))
+	for i, issue := range issues {
+		index[issue.Key] = i
+	}
+	changelogs := make([][]api.ChangeHistory, len(issues))
+	errs := bulk.Apply(ctx, issues, bulk.DefaultConcurrency, func(ctx context.Context, issue *api.Issue) error {
+		histories, err := client.GetAllChangelog(ctx, issue.Key)
+		if err != nil {
+			return err
+		}
+		changelogs[index[issue.Key]] = histories
+		return nil
+	})
+	for i, err := range errs {
+		if err != nil {
+			return fmt.Errorf("failed to get changelog for %s: %w", issues[i].Key, err)
+		}
+	}
+
+	report, err := buildFlowReport(issues, changelogs, categories, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(report)
+	}
+
+	if len(report.Issues) == 0 {
+		v.Info("No issues match %s", jql)
+		return nil
+	}
+
+	switch by {
+	case "status":
+		headers := []string{"STATUS", "CATEGORY", "ISSUES", "TOTAL_DAYS", "MEAN_DAYS", "P50_DAYS", "P85_DAYS"}
+		rows := make([][]string, len(report.Statuses))
+		for i, s := range report.Statuses {
+			rows[i] = []string{s.Status, s.Category, strconv.Itoa(s.Issues), days(s.TotalDays), days(s.MeanDays), days(s.P50Days), days(s.P85Days)}
+		}
+		return v.Table(headers, rows)
+	case "week":
+		headers := []string{"WEEK", "COMPLETED"}
+		rows := make([][]string, len(report.Throughput))
+		for i, w := range report.Throughput {
+			rows[i] = []string{w.Week, strconv.Itoa(w.Completed)}
+		}
+		return v.Table(headers, rows)
+	}
+
+	headers := []string{"KEY", "SUMMARY", "STATUS", "CREATED", "DONE", "CYCLE_DAYS", "LEAD_DAYS"}
+	rows := make([][]string, len(report.Issues))
+	for i, f := range report.Issues {
+		done := ""
+		if f.Done != nil {
+			done = f.Done.Format("2006-01-02")
+		}
+		rows[i] = []string{f.Key, view.Truncate(f.Summary, 40), f.Status, f.Created.Format("2006-01-02"), done, optionalDays(f.CycleTimeDays), optionalDays(f.LeadTimeDays)}
+	}
+	if err := v.Table(headers, rows); err != nil {
+		return err
+	}
+
+	if view.IsMachineReadable(opts.Output) {
+		return nil
+	}
+
+	v.Println("")
+	headers = []string{"METRIC", "ISSUES", "MEAN"}
+	for _, p := range flowPercentiles {
+		headers = append(headers, fmt.Sprintf("P%d", p))
+	}
+	rows = nil
+	for _, m := range []struct {
+		name    string
+		summary durationSummary
+	}{{"Cycle time (days)", report.CycleTime}, {"Lead time (days)", report.LeadTime}} {
+		row := []string{m.name, strconv.Itoa(m.summary.Count), days(m.summary.MeanDays)}
+		for _, p := range flowPercentiles {
+			row = append(row, days(m.summary.Percentiles[p]))
+		}
+		rows = append(rows, row)
+	}
+	return v.Table(headers, rows)
+}
+
+// statusCategories maps the status IDs of the projects of the issues to
+// their status category keys
+func statusCategories(ctx context.Context, client *api.Client, issues []api.Issue) (map[string]string, error) {
+	categories := map[string]string{}
+	seen := map[string]bool{}
+	for _, issue := range issues {
+		if issue.Fields.Project == nil || seen[issue.Fields.Project.Key] {
+			continue
+		}
+		seen[issue.Fields.Project.Key] = true
+
+		issueTypes, err := client.GetProjectStatuses(ctx, issue.Fields.Project.Key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get statuses of %s: %w", issue.Fields.Project.Key, err)
+		}
+		for _, t := range issueTypes {
+			for _, s := range t.Statuses {
+				categories[s.ID] = s.StatusCategory.Key
+			}
+		}
+	}
+	return categories, nil
+}
+
+// statusChange is a change of an issue's status
+type statusChange struct {
+	At               time.Time
+	FromID, FromName string
+	ToID, ToName     string
+}
+
+// buildFlowReport works out the flow metrics of issues from their
+// changelogs, counting time in the current status up to now
+func buildFlowReport(issues []api.Issue, changelogs [][]api.ChangeHistory, categories map[string]string, now time.Time) (*flowReport, error) {
+	report := &flowReport{Issues: []issueFlow{}, Statuses: []statusSummary{}, Throughput: []weekCount{}}
+
+	for i, issue := range issues {
+		f, err := buildIssueFlow(issue, changelogs[i], categories, now)
+		if err != nil {
+			return nil, err
+		}
+		report.Issues = append(report.Issues, f)
+	}
+
+	var cycle, lead []float64
+	for _, f := range report.Issues {
+		if f.CycleTimeDays != nil {
+			cycle = append(cycle, *f.CycleTimeDays)
+		}
+		if f.LeadTimeDays != nil {
+			lead = append(lead, *f.LeadTimeDays)
+		}
+	}
+	report.CycleTime = summarize(cycle)
+	report.LeadTime = summarize(lead)
+	report.Statuses = summarizeStatuses(report.Issues)
+	report.Throughput = throughput(report.Issues)
+
+	return report, nil
+}
+
+func buildIssueFlow(issue api.Issue, histories []api.ChangeHistory, categories map[string]string, now time.Time) (issueFlow, error) {
+	f := issueFlow{Key: issue.Key, Summary: issue.Fields.Summary, TimeInStatus: []statusTime{}}
+
+	created, err := time.Parse(api.TimeFormat, issue.Fields.Created)
+	if err != nil {
+		return f, fmt.Errorf("issue %s has an invalid created time %q", issue.Key, issue.Fields.Created)
+	}
+	f.Created = created
+
+	var changes []statusChange
+	for _, h := range histories {
+		at, err := h.CreatedTime()
+		if err != nil {
+			return f, fmt.Errorf("change %s on %s has an invalid time %q", h.ID, issue.Key, h.Created)
+		}
+		for _, item := range h.Items {
+			if item.FieldID == "status" || (item.FieldID == "" && item.Field == "status") {
+				changes = append(changes, statusChange{At: at, FromID: item.From, FromName: item.FromString, ToID: item.To, ToName: item.ToString})
+			}
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].At.Before(changes[j].At) })
+
+	// The issue starts in the status its first change moved it from
+	var currentID, currentName string
+	if issue.Fields.Status != nil {
+		currentID, currentName = issue.Fields.Status.ID, issue.Fields.Status.Name
+	}
+	if len(changes) > 0 {
+		currentID, currentName = changes[0].FromID, changes[0].FromName
+	}
+
+	spent := map[string]time.Duration{}
+	var order []string
+	addTime := func(name string, d time.Duration) {
+		if _, ok := spent[name]; !ok {
+			order = append(order, name)
+		}
+		spent[name] += d
+	}
+
+	since := created
+	statusCategory := map[string]string{}
+	for _, c := range changes {
+		addTime(currentName, c.At.Sub(since))
+		statusCategory[currentName] = categories[currentID]
+
+		switch categories[c.ToID] {
+		case categoryInProgress:
+			if f.Started == nil {
+				started := c.At
+				f.Started = &started
+			}
+			f.Done = nil
+		case categoryDone:
+			done := c.At
+			f.Done = &done
+		default:
+			f.Done = nil
+		}
+
+		currentID, currentName, since = c.ToID, c.ToName, c.At
+	}
+	statusCategory[currentName] = categories[currentID]
+	// Time in a done status is not time in the flow
+	if categories[currentID] != categoryDone {
+		addTime(currentName, now.Sub(since))
+	}
+	f.Status = currentName
+
+	for _, name := range order {
+		f.TimeInStatus = append(f.TimeInStatus, statusTime{Status: name, Category: statusCategory[name], Days: toDays(spent[name])})
+	}
+
+	if f.Done != nil {
+		lead := toDays(f.Done.Sub(created))
+		f.LeadTimeDays = &lead
+		if f.Started != nil && !f.Started.After(*f.Done) {
+			cycle := toDays(f.Done.Sub(*f.Started))
+			f.CycleTimeDays = &cycle
+		}
+	}
+
+	return f, nil
+}
+
+// summarizeStatuses adds up the time spent in each status, ordering the
+// statuses by category and then name
+func summarizeStatuses(flows []issueFlow) []statusSummary {
+	byStatus := map[string][]float64{}
+	category := map[string]string{}
+	for _, f := range flows {
+		for _, st := range f.TimeInStatus {
+			byStatus[st.Status] = append(byStatus[st.Status], st.Days)
+			if st.Category != "" {
+				category[st.Status] = st.Category
+			}
+		}
+	}
+
+	summaries := []statusSummary{}
+	for name, values := range byStatus {
+		total := 0.0
+		for _, d := range values {
+			total += d
+		}
+		summaries = append(summaries, statusSummary{
+			Status:    name,
+			Category:  category[name],
+			Issues:    len(values),
+			TotalDays: round(total),
+			MeanDays:  round(total / float64(len(values))),
+			P50Days:   percentile(values, 50),
+			P85Days:   percentile(values, 85),
+		})
+	}
+
+	rank := map[string]int{categoryToDo: 0, categoryInProgress: 1, categoryDone: 2}
+	sort.Slice(summaries, func(i, j int) bool {
+		ri, okI := rank[summaries[i].Category]
+		rj, okJ := rank[summaries[j].Category]
+		if !okI {
+			ri = len(rank)
+		}
+		if !okJ {
+			rj = len(rank)
+		}
+		if ri != rj {
+			return ri < rj
+		}
+		return summaries[i].Status < summaries[j].Status
+	})
+	return summaries
+}
+
+// throughput counts the issues done in each week, from the first week
+// with a done issue to the last, including weeks with none
+func throughput(flows []issueFlow) []weekCount {
+	counts := map[string]int{}
+	var first, last time.Time
+	for _, f := range flows {
+		if f.Done == nil {
+			continue
+		}
+		week := weekStart(*f.Done)
+		counts[week.Format("2006-01-02")]++
+		if first.IsZero() || week.Before(first) {
+			first = week
+		}
+		if week.After(last) {
+			last = week
+		}
+	}
+
+	weeks := []weekCount{}
+	if first.IsZero() {
+		return weeks
+	}
+	for week := first; !week.After(last); week = week.AddDate(0, 0, 7) {
+		key := week.Format("2006-01-02")
+		weeks = append(weeks, weekCount{Week: key, Completed: counts[key]})
+	}
+	return weeks
+}
+
+// weekStart returns the Monday starting the week of t
+func weekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+func summarize(values []float64) durationSummary {
+	s := durationSummary{Count: len(values), Percentiles: map[int]float64{}}
+	if len(values) == 0 {
+		return s
+	}
+	total := 0.0
+	for _, d := range values {
+		total += d
+	}
+	s.MeanDays = round(total / float64(len(values)))
+	for _, p := range flowPercentiles {
+		s.Percentiles[p] = percentile(values, p)
+	}
+	return s
+}
+
+// percentile returns the pth percentile of values by the nearest-rank
+// method
+func percentile(values []float64, p int) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := slices.Clone(values)
+	sort.Float64s(sorted)
+	rank := int(math.Ceil(float64(p) / 100 * float64(len(sorted))))
+	return sorted[max(rank-1, 0)]
+}
+
+func toDays(d time.Duration) float64 {
+	return round(d.Hours() / 24)
+}
+
+// round rounds days to two decimal places
+func round(d float64) float64 {
+	return math.Round(d*100) / 100
+}
+
+func days(d float64) string {
+	return strconv.FormatFloat(d, 'f', 1, 64)
+}
+
+func optionalDays(d *float64) string {
+	if d == nil {
+		return ""
+	}
+	return days(*d)
+}
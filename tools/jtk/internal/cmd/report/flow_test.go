@@ -0,0 +1,215 @@
+package report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+var testCategories = map[string]string{
+	"1": categoryToDo,
+	"3": categoryInProgress,
+	"4": categoryDone,
+}
+
+func statusHistory(id, created, from, fromName, to, toName string) api.ChangeHistory {
+	return api.ChangeHistory{ID: id, Created: created, Items: []api.ChangeItem{
+		{Field: "status", FieldID: "status", From: from, FromString: fromName, To: to, ToString: toName},
+	}}
+}
+
+func testIssue(key, created, statusID, statusName string) api.Issue {
+	return api.Issue{Key: key, Fields: api.IssueFields{
+		Summary: "Issue " + key,
+		Created: created,
+		Status:  &api.Status{ID: statusID, Name: statusName},
+		Project: &api.Project{Key: "PROJ"},
+	}}
+}
+
+func TestBuildIssueFlow(t *testing.T) {
+	issue := testIssue("PROJ-1", "2026-10-01T00:00:00.000+0000", "4", "Done")
+	histories := []api.ChangeHistory{
+		statusHistory("1", "2026-10-03T00:00:00.000+0000", "1", "To Do", "3", "In Progress"),
+		statusHistory("2", "2026-10-05T12:00:00.000+0000", "3", "In Progress", "4", "Done"),
+	}
+
+	f, err := buildIssueFlow(issue, histories, testCategories, time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Done", f.Status)
+	require.NotNil(t, f.CycleTimeDays)
+	require.NotNil(t, f.LeadTimeDays)
+	assert.Equal(t, 2.5, *f.CycleTimeDays)
+	assert.Equal(t, 4.5, *f.LeadTimeDays)
+	// Time since the issue was done is not counted
+	assert.Equal(t, []statusTime{
+		{Status: "To Do", Category: categoryToDo, Days: 2},
+		{Status: "In Progress", Category: categoryInProgress, Days: 2.5},
+	}, f.TimeInStatus)
+}
+
+func TestBuildIssueFlow_Reopened(t *testing.T) {
+	issue := testIssue("PROJ-1", "2026-10-01T00:00:00.000+0000", "3", "In Progress")
+	histories := []api.ChangeHistory{
+		statusHistory("1", "2026-10-02T00:00:00.000+0000", "1", "To Do", "3", "In Progress"),
+		statusHistory("2", "2026-10-03T00:00:00.000+0000", "3", "In Progress", "4", "Done"),
+		statusHistory("3", "2026-10-04T00:00:00.000+0000", "4", "Done", "3", "In Progress"),
+	}
+
+	f, err := buildIssueFlow(issue, histories, testCategories, time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	assert.Nil(t, f.Done)
+	assert.Nil(t, f.CycleTimeDays)
+	assert.Nil(t, f.LeadTimeDays)
+	require.NotNil(t, f.Started)
+	assert.Equal(t, "2026-10-02", f.Started.Format("2006-01-02"))
+	assert.Equal(t, []statusTime{
+		{Status: "To Do", Category: categoryToDo, Days: 1},
+		{Status: "In Progress", Category: categoryInProgress, Days: 3},
+		{Status: "Done", Category: categoryDone, Days: 1},
+	}, f.TimeInStatus)
+}
+
+func TestBuildIssueFlow_NoChanges(t *testing.T) {
+	issue := testIssue("PROJ-1", "2026-10-01T00:00:00.000+0000", "1", "To Do")
+
+	f, err := buildIssueFlow(issue, nil, testCategories, time.Date(2026, 10, 4, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	assert.Nil(t, f.Started)
+	assert.Equal(t, []statusTime{{Status: "To Do", Category: categoryToDo, Days: 3}}, f.TimeInStatus)
+}
+
+func TestPercentile(t *testing.T) {
+	values := []float64{5, 1, 4, 2, 3, 10, 8, 6, 7, 9}
+
+	assert.Equal(t, 5.0, percentile(values, 50))
+	assert.Equal(t, 9.0, percentile(values, 85))
+	assert.Equal(t, 10.0, percentile(values, 95))
+	assert.Equal(t, 0.0, percentile(nil, 50))
+}
+
+func TestThroughput(t *testing.T) {
+	at := func(s string) *time.Time {
+		d, err := time.Parse("2006-01-02", s)
+		require.NoError(t, err)
+		return &d
+	}
+	flows := []issueFlow{
+		{Key: "PROJ-1", Done: at("2026-09-28")}, // Monday
+		{Key: "PROJ-2", Done: at("2026-10-04")}, // Sunday of the same week
+		{Key: "PROJ-3", Done: at("2026-10-14")},
+		{Key: "PROJ-4"},
+	}
+
+	assert.Equal(t, []weekCount{
+		{Week: "2026-09-28", Completed: 2},
+		{Week: "2026-10-05", Completed: 0},
+		{Week: "2026-10-12", Completed: 1},
+	}, throughput(flows))
+}
+
+func newFlowOptions(t *testing.T, output string) (*root.Options, *bytes.Buffer) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/search/jql":
+			fmt.Fprint(w, `{"isLast": true, "issues": [
+				{"key": "PROJ-1", "fields": {"summary": "First", "created": "2026-10-01T00:00:00.000+0000",
+					"status": {"id": "4", "name": "Done"}, "project": {"key": "PROJ"}}},
+				{"key": "PROJ-2", "fields": {"summary": "Second", "created": "2026-10-02T00:00:00.000+0000",
+					"status": {"id": "3", "name": "In Progress"}, "project": {"key": "PROJ"}}}
+			]}`)
+		case "/rest/api/3/project/PROJ/statuses":
+			fmt.Fprint(w, `[{"id": "10001", "name": "Task", "statuses": [
+				{"id": "1", "name": "To Do", "statusCategory": {"key": "new"}},
+				{"id": "3", "name": "In Progress", "statusCategory": {"key": "indeterminate"}},
+				{"id": "4", "name": "Done", "statusCategory": {"key": "done"}}
+			]}]`)
+		case "/rest/api/3/issue/PROJ-1/changelog":
+			fmt.Fprint(w, `{"isLast": true, "values": [
+				{"id": "1", "created": "2026-10-02T00:00:00.000+0000",
+					"items": [{"field": "status", "fieldId": "status", "from": "1", "fromString": "To Do", "to": "3", "toString": "In Progress"}]},
+				{"id": "2", "created": "2026-10-06T00:00:00.000+0000",
+					"items": [{"field": "status", "fieldId": "status", "from": "3", "fromString": "In Progress", "to": "4", "toString": "Done"}]}
+			]}`)
+		case "/rest/api/3/issue/PROJ-2/changelog":
+			fmt.Fprint(w, `{"isLast": true, "values": [
+				{"id": "3", "created": "2026-10-03T00:00:00.000+0000",
+					"items": [{"field": "status", "fieldId": "status", "from": "1", "fromString": "To Do", "to": "3", "toString": "In Progress"}]}
+			]}`)
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := api.New(api.ClientConfig{URL: server.URL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{Output: output, Stdout: &stdout, Stderr: &bytes.Buffer{}}
+	opts.SetAPIClient(client)
+	return opts, &stdout
+}
+
+func TestRunFlow(t *testing.T) {
+	opts, stdout := newFlowOptions(t, "table")
+
+	require.NoError(t, runFlow(context.Background(), opts, "project = PROJ", "issue"))
+
+	out := stdout.String()
+	assert.Contains(t, out, "PROJ-1")
+	assert.Contains(t, out, "2026-10-06")
+	assert.Contains(t, out, "Cycle time (days)")
+	assert.Contains(t, out, "Lead time (days)")
+}
+
+func TestRunFlow_JSON(t *testing.T) {
+	opts, stdout := newFlowOptions(t, "json")
+
+	require.NoError(t, runFlow(context.Background(), opts, "project = PROJ", "week"))
+
+	var report flowReport
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &report))
+	require.Len(t, report.Issues, 2)
+	require.NotNil(t, report.Issues[0].CycleTimeDays)
+	assert.Equal(t, 4.0, *report.Issues[0].CycleTimeDays)
+	assert.Equal(t, 5.0, *report.Issues[0].LeadTimeDays)
+	assert.Nil(t, report.Issues[1].CycleTimeDays)
+	assert.Equal(t, 1, report.CycleTime.Count)
+	assert.Equal(t, []weekCount{{Week: "2026-10-05", Completed: 1}}, report.Throughput)
+	assert.Equal(t, "To Do", report.Statuses[0].Status)
+}
+
+func TestRunFlow_ByStatusCSV(t *testing.T) {
+	opts, stdout := newFlowOptions(t, "csv")
+
+	require.NoError(t, runFlow(context.Background(), opts, "project = PROJ", "status"))
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "STATUS,CATEGORY,ISSUES"))
+	assert.True(t, strings.HasPrefix(lines[1], "To Do,new,2,"))
+	assert.True(t, strings.HasPrefix(lines[2], "In Progress,indeterminate,2,"))
+}
+
+func TestRunFlow_InvalidBy(t *testing.T) {
+	opts, _ := newFlowOptions(t, "table")
+
+	err := runFlow(context.Background(), opts, "project = PROJ", "month")
+	assert.ErrorContains(t, err, "invalid --by")
+}
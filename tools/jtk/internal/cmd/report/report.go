@@ -0,0 +1,21 @@
+package report
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// Register registers the report commands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:     "report",
+		Aliases: []string{"reports"},
+		Short:   "Report on issues",
+		Long:    "Commands for reporting metrics across the issues matching a JQL query.",
+	}
+
+	cmd.AddCommand(newFlowCmd(opts))
+
+	parent.AddCommand(cmd)
+}
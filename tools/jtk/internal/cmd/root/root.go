@@ -1,11 +1,15 @@
 package root
 
 import (
+	"context"
 	"io"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/client"
 	"github.com/open-cli-collective/atlassian-go/version"
 	"github.com/open-cli-collective/atlassian-go/view"
 
@@ -15,15 +19,33 @@ import (
 
 // Options contains global options for commands
 type Options struct {
-	Output  string
-	NoColor bool
-	Verbose bool
-	Stdin   io.Reader
-	Stdout  io.Writer
-	Stderr  io.Writer
+	Output    string
+	NoColor   bool
+	Verbose   bool
+	Profile   string
+	Record    string
+	Replay    string
+	TraceFile string
+	Timeout   time.Duration
+	Template  string
+	Columns   []string
+	Query     string
+	Stdin     io.Reader
+	Stdout    io.Writer
+	Stderr    io.Writer
 
 	// testClient is used for testing; if set, APIClient() returns this instead
 	testClient *api.Client
+
+	// traceFile is the opened --trace-file, shared by all clients
+	traceFile client.TraceFile
+
+	// ctx is the running command's context, for the requests made while
+	// creating a client
+	ctx context.Context
+
+	// cachedConfig is the selected profile, loaded once by Config
+	cachedConfig *config.Config
 }
 
 // View returns a configured View instance
@@ -31,22 +53,70 @@ func (o *Options) View() *view.View {
 	v := view.NewWithFormat(o.Output, o.NoColor)
 	v.Out = o.Stdout
 	v.Err = o.Stderr
+	v.Template = o.Template
+	v.Columns = o.Columns
+	v.Query = o.Query
 	return v
 }
 
+// Config loads the selected profile, once per command, so that the
+// credential store is only opened once
+func (o *Options) Config() (*config.Config, error) {
+	if o.cachedConfig != nil {
+		return o.cachedConfig, nil
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	o.cachedConfig = cfg
+	return cfg, nil
+}
+
 // APIClient creates a new API client from config
 func (o *Options) APIClient() (*api.Client, error) {
 	if o.testClient != nil {
 		return o.testClient, nil
 	}
-	return api.New(api.ClientConfig{
-		URL:      config.GetURL(),
-		Email:    config.GetEmail(),
-		APIToken: config.GetAPIToken(),
-		Verbose:  o.Verbose,
+	cfg, err := o.Config()
+	if err != nil {
+		return nil, err
+	}
+	authn, err := cfg.Authenticator()
+	if err != nil {
+		return nil, err
+	}
+	transport, err := client.CassetteTransport(o.Record, o.Replay)
+	if err != nil {
+		return nil, err
+	}
+	traceOut, err := o.traceFile.Open(o.TraceFile)
+	if err != nil {
+		return nil, err
+	}
+	ctx := o.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return api.NewWithContext(ctx, api.ClientConfig{
+		URL:        cfg.GetURL(),
+		Email:      cfg.GetEmail(),
+		APIToken:   cfg.GetAPIToken(),
+		Verbose:    o.Verbose,
+		VerboseOut: o.Stderr,
+		Timeout:    o.Timeout,
+		Auth:       authn,
+		CloudID:    cfg.CloudID,
+		Transport:  transport,
+		TraceOut:   traceOut,
 	})
 }
 
+// Close closes the --trace-file, if it was opened
+func (o *Options) Close() error {
+	return o.traceFile.Close()
+}
+
 // SetAPIClient sets a test client (for testing only)
 func (o *Options) SetAPIClient(client *api.Client) {
 	o.testClient = client
@@ -65,17 +135,36 @@ func NewCmd() (*cobra.Command, *Options) {
 		Short:   "A CLI for managing Jira tickets",
 		Long:    "jtk is a command-line interface for managing Jira Cloud tickets.",
 		Version: version.Info(),
-		PersistentPreRun: func(cmd *cobra.Command, args []string) {
-			// Setup is done in flag binding
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			opts.ctx = cmd.Context()
+			config.SetProfile(opts.Profile)
+			output, err := view.ResolveFormat(opts.Output, cmd.Flags().Changed("output"), opts.Template, opts.Query)
+			if err != nil {
+				return err
+			}
+			opts.Output = output
+			return nil
 		},
 		SilenceUsage:  true,
 		SilenceErrors: true,
 	}
 
 	// Global flags - bound to opts struct
-	cmd.PersistentFlags().StringVarP(&opts.Output, "output", "o", "table", "Output format: table, json, plain")
+	cmd.PersistentFlags().StringVarP(&opts.Output, "output", "o", "table", "Output format: "+strings.Join(view.ValidFormats(), ", "))
+	cmd.PersistentFlags().StringVar(&opts.Template, "template", "", "Render output with a Go template over the fields shown by -o json")
+	cmd.PersistentFlags().StringVar(&opts.Query, "jq", "", "Filter JSON output with a jq expression (implies -o json)")
+	cmd.PersistentFlags().StringSliceVar(&opts.Columns, "columns", nil, "Comma-separated table columns to show, in order (custom fields by name on issue lists)")
 	cmd.PersistentFlags().BoolVar(&opts.NoColor, "no-color", false, "Disable colored output")
 	cmd.PersistentFlags().BoolVarP(&opts.Verbose, "verbose", "v", false, "Enable verbose output")
+	cmd.PersistentFlags().StringVar(&opts.Profile, "profile", "", "Config profile to use (default: JIRA_PROFILE, ATLASSIAN_PROFILE, or the configured default)")
+	cmd.PersistentFlags().StringVar(&opts.Record, "record", "", "Record API requests and responses to a cassette file, with credentials redacted")
+	cmd.PersistentFlags().StringVar(&opts.Replay, "replay", "", "Answer API requests from a recorded cassette file instead of the network")
+	cmd.PersistentFlags().DurationVar(&opts.Timeout, "timeout", client.DefaultTimeout, "Timeout for each API request")
+	cmd.PersistentFlags().StringVar(&opts.TraceFile, "trace-file", "", "Append a JSON line per API request, with timing, headers and bodies, to a file")
+
+	_ = cmd.RegisterFlagCompletionFunc("output", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return view.ValidFormats(), cobra.ShellCompDirectiveNoFileComp
+	})
 
 	return cmd, opts
 }
@@ -92,13 +181,34 @@ func GetOptions(cmd *cobra.Command) *Options {
 	output, _ := cmd.Root().PersistentFlags().GetString("output")
 	noColor, _ := cmd.Root().PersistentFlags().GetBool("no-color")
 	verbose, _ := cmd.Root().PersistentFlags().GetBool("verbose")
+	profile, _ := cmd.Root().PersistentFlags().GetString("profile")
+	record, _ := cmd.Root().PersistentFlags().GetString("record")
+	replay, _ := cmd.Root().PersistentFlags().GetString("replay")
+	traceFile, _ := cmd.Root().PersistentFlags().GetString("trace-file")
+	timeout, _ := cmd.Root().PersistentFlags().GetDuration("timeout")
+	template, _ := cmd.Root().PersistentFlags().GetString("template")
+	columns, _ := cmd.Root().PersistentFlags().GetStringSlice("columns")
+	query, _ := cmd.Root().PersistentFlags().GetString("jq")
+	if template != "" {
+		output = string(view.FormatTemplate)
+	} else if query != "" && !view.IsMachineReadable(output) {
+		output = string(view.FormatJSON)
+	}
 
 	return &Options{
-		Output:  output,
-		NoColor: noColor,
-		Verbose: verbose,
-		Stdin:   os.Stdin,
-		Stdout:  os.Stdout,
-		Stderr:  os.Stderr,
+		Output:    output,
+		NoColor:   noColor,
+		Verbose:   verbose,
+		Profile:   profile,
+		Record:    record,
+		Replay:    replay,
+		TraceFile: traceFile,
+		Timeout:   timeout,
+		Template:  template,
+		Columns:   columns,
+		Query:     query,
+		Stdin:     os.Stdin,
+		Stdout:    os.Stdout,
+		Stderr:    os.Stderr,
 	}
 }
@@ -0,0 +1,465 @@
+package sprints
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/timeflag"
+)
+
+// parseSprintDate parses the sprint date given to the named flag, if any
+func parseSprintDate(flag, s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := timeflag.Parse(flag, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func parseSprintID(s string) (int, error) {
+	id, err := strconv.Atoi(s)
+	if err != nil || id <= 0 {
+		return 0, fmt.Errorf("invalid sprint ID: %s", s)
+	}
+	return id, nil
+}
+
+// checkSprintDates returns an error if a sprint would end before it starts
+func checkSprintDates(start, end *time.Time) error {
+	if start != nil && end != nil && !end.After(*start) {
+		return fmt.Errorf("sprint end %s is not after its start %s", end.Format("2006-01-02 15:04"), start.Format("2006-01-02 15:04"))
+	}
+	return nil
+}
+
+func newCreateCmd(opts *root.Options) *cobra.Command {
+	var boardID int
+	var name, start, end, goal string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a sprint",
+		Long: `Create a future sprint on a board.
+
+Dates are in local time unless they carry an offset. A sprint can be created
+without dates, but needs an end date before it can be started.`,
+		Example: `  # Create a sprint with planned dates and a goal
+  jtk sprints create --board 123 --name "Sprint 43" --start 2026-10-19 --end 2026-11-02 --goal "Ship the importer"
+
+  # Create a sprint to plan into later
+  jtk sprints create --board 123 --name "Sprint 44"`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if boardID == 0 {
+				return fmt.Errorf("--board is required")
+			}
+			if name == "" {
+				return fmt.Errorf("--name is required")
+			}
+			req := &api.SprintRequest{Name: name, OriginBoardID: boardID}
+			if goal != "" {
+				req.Goal = &goal
+			}
+			return runCreate(cmd.Context(), opts, req, start, end)
+		},
+	}
+
+	cmd.Flags().IntVarP(&boardID, "board", "b", 0, "Board ID (required)")
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Sprint name (required)")
+	cmd.Flags().StringVar(&start, "start", "", "Planned start (YYYY-MM-DD, YYYY-MM-DD HH:MM or RFC 3339)")
+	cmd.Flags().StringVar(&end, "end", "", "Planned end (YYYY-MM-DD, YYYY-MM-DD HH:MM or RFC 3339)")
+	cmd.Flags().StringVarP(&goal, "goal", "g", "", "Sprint goal")
+
+	return cmd
+}
+
+func runCreate(ctx context.Context, opts *root.Options, req *api.SprintRequest, start, end string) error {
+	v := opts.View()
+
+	var err error
+	if req.StartDate, err = parseSprintDate("start", start); err != nil {
+		return err
+	}
+	if req.EndDate, err = parseSprintDate("end", end); err != nil {
+		return err
+	}
+	if err := checkSprintDates(req.StartDate, req.EndDate); err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	sprint, err := client.CreateSprint(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(sprint)
+	}
+
+	v.Success("Created sprint %d: %s", sprint.ID, sprint.Name)
+	return nil
+}
+
+func newStartCmd(opts *root.Options) *cobra.Command {
+	var start, end, goal string
+
+	cmd := &cobra.Command{
+		Use:   "start <sprint-id>",
+		Short: "Start a sprint",
+		Long: `Start a future sprint.
+
+The sprint starts now unless --start is given, and ends at its planned end
+unless --end is given. A sprint without a planned end needs --end.`,
+		Example: `  # Start a sprint on its planned dates
+  jtk sprints start 456
+
+  # Start a two-week sprint now, with a goal
+  jtk sprints start 456 --end 2026-10-31 --goal "Ship the importer"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sprintID, err := parseSprintID(args[0])
+			if err != nil {
+				return err
+			}
+			req := &api.SprintRequest{State: api.SprintStateActive}
+			if cmd.Flags().Changed("goal") {
+				req.Goal = &goal
+			}
+			return runStart(cmd.Context(), opts, sprintID, req, start, end)
+		},
+	}
+
+	cmd.Flags().StringVar(&start, "start", "", "Start (default now)")
+	cmd.Flags().StringVar(&end, "end", "", "End (default the planned end)")
+	cmd.Flags().StringVarP(&goal, "goal", "g", "", "Sprint goal")
+
+	return cmd
+}
+
+func runStart(ctx context.Context, opts *root.Options, sprintID int, req *api.SprintRequest, start, end string) error {
+	v := opts.View()
+
+	var err error
+	if req.StartDate, err = parseSprintDate("start", start); err != nil {
+		return err
+	}
+	if req.EndDate, err = parseSprintDate("end", end); err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	sprint, err := client.GetSprint(ctx, sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.State != api.SprintStateFuture {
+		return fmt.Errorf("sprint %d is %s, only future sprints can be started", sprintID, sprint.State)
+	}
+
+	if req.StartDate == nil {
+		now := time.Now()
+		req.StartDate = &now
+	}
+	if req.EndDate == nil {
+		if sprint.EndDate == nil {
+			return fmt.Errorf("sprint %d has no planned end, use --end", sprintID)
+		}
+		req.EndDate = sprint.EndDate
+	}
+	if err := checkSprintDates(req.StartDate, req.EndDate); err != nil {
+		return fmt.Errorf("%w, use --end", err)
+	}
+
+	sprint, err = client.UpdateSprint(ctx, sprintID, req)
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(sprint)
+	}
+
+	v.Success("Started sprint %d: %s (ends %s)", sprint.ID, sprint.Name, req.EndDate.Format("2006-01-02 15:04"))
+	return nil
+}
+
+func newUpdateCmd(opts *root.Options) *cobra.Command {
+	var name, start, end, goal string
+
+	cmd := &cobra.Command{
+		Use:   "update <sprint-id>",
+		Short: "Update a sprint",
+		Long: `Change the name, dates or goal of a sprint.
+
+Only the given fields are changed. Use --goal "" to clear the goal.`,
+		Example: `  # Change the goal
+  jtk sprints update 456 --goal "Ship the importer and the exporter"
+
+  # Extend a sprint by a few days
+  jtk sprints update 456 --end 2026-11-05`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sprintID, err := parseSprintID(args[0])
+			if err != nil {
+				return err
+			}
+			req := &api.SprintRequest{Name: name}
+			if cmd.Flags().Changed("goal") {
+				req.Goal = &goal
+			}
+			if name == "" && start == "" && end == "" && req.Goal == nil {
+				return fmt.Errorf("nothing to update: use --name, --start, --end or --goal")
+			}
+			return runUpdate(cmd.Context(), opts, sprintID, req, start, end)
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "New sprint name")
+	cmd.Flags().StringVar(&start, "start", "", "New start (YYYY-MM-DD, YYYY-MM-DD HH:MM or RFC 3339)")
+	cmd.Flags().StringVar(&end, "end", "", "New end (YYYY-MM-DD, YYYY-MM-DD HH:MM or RFC 3339)")
+	cmd.Flags().StringVarP(&goal, "goal", "g", "", "New sprint goal")
+
+	return cmd
+}
+
+func runUpdate(ctx context.Context, opts *root.Options, sprintID int, req *api.SprintRequest, start, end string) error {
+	v := opts.View()
+
+	var err error
+	if req.StartDate, err = parseSprintDate("start", start); err != nil {
+		return err
+	}
+	if req.EndDate, err = parseSprintDate("end", end); err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	// Check a new start or end against the date that is kept
+	if (req.StartDate == nil) != (req.EndDate == nil) {
+		sprint, err := client.GetSprint(ctx, sprintID)
+		if err != nil {
+			return err
+		}
+		startDate, endDate := req.StartDate, req.EndDate
+		if startDate == nil {
+			startDate = sprint.StartDate
+		}
+		if endDate == nil {
+			endDate = sprint.EndDate
+		}
+		if err := checkSprintDates(startDate, endDate); err != nil {
+			return err
+		}
+	} else if err := checkSprintDates(req.StartDate, req.EndDate); err != nil {
+		return err
+	}
+
+	sprint, err := client.UpdateSprint(ctx, sprintID, req)
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(sprint)
+	}
+
+	v.Success("Updated sprint %d: %s", sprint.ID, sprint.Name)
+	return nil
+}
+
+// completeResult is the outcome of completing a sprint
+type completeResult struct {
+	Sprint    *api.Sprint `json:"sprint"`
+	Completed []string    `json:"completed"`
+	Moved     []string    `json:"moved"`
+	MovedTo   string      `json:"movedTo"`
+}
+
+func newCompleteCmd(opts *root.Options) *cobra.Command {
+	var moveTo string
+
+	cmd := &cobra.Command{
+		Use:     "complete <sprint-id>",
+		Aliases: []string{"close"},
+		Short:   "Complete a sprint",
+		Long: `Complete an active sprint, first moving its open issues out of it.
+
+An issue is open if its status is not in the done category. Sub-tasks move
+with their parents, so a parent with an open sub-task is moved too.
+
+--move-open-to says where open issues go:
+  backlog     the backlog
+  next        the next future sprint on the sprint's board
+  <sprint-id> another sprint`,
+		Example: `  # Complete a sprint, returning open issues to the backlog
+  jtk sprints complete 456
+
+  # Carry open issues over to the next sprint
+  jtk sprints complete 456 --move-open-to next
+
+  # Move open issues to a given sprint
+  jtk sprints complete 456 --move-open-to 789`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			sprintID, err := parseSprintID(args[0])
+			if err != nil {
+				return err
+			}
+			return runComplete(cmd.Context(), opts, sprintID, moveTo)
+		},
+	}
+
+	cmd.Flags().StringVar(&moveTo, "move-open-to", "backlog", "Where to move open issues: backlog, next or a sprint ID")
+
+	return cmd
+}
+
+func runComplete(ctx context.Context, opts *root.Options, sprintID int, moveTo string) error {
+	v := opts.View()
+
+	targetID := 0
+	if moveTo != "backlog" && moveTo != "next" {
+		id, err := parseSprintID(moveTo)
+		if err != nil {
+			return fmt.Errorf("invalid --move-open-to %q (expected backlog, next or a sprint ID)", moveTo)
+		}
+		if id == sprintID {
+			return fmt.Errorf("cannot move open issues to the sprint being completed")
+		}
+		targetID = id
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	sprint, err := client.GetSprint(ctx, sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.State != api.SprintStateActive {
+		return fmt.Errorf("sprint %d is %s, only active sprints can be completed", sprintID, sprint.State)
+	}
+
+	issues, err := client.GetAllSprintIssues(ctx, sprintID)
+	if err != nil {
+		return err
+	}
+	open, completed := splitOpenIssues(issues)
+
+	result := &completeResult{Completed: completed, Moved: open, MovedTo: "backlog"}
+	if len(open) > 0 {
+		if moveTo == "next" {
+			next, err := nextSprint(ctx, client, sprint)
+			if err != nil {
+				return err
+			}
+			targetID = next.ID
+		}
+
+		if targetID != 0 {
+			result.MovedTo = strconv.Itoa(targetID)
+			err = client.MoveIssuesToSprint(ctx, targetID, open)
+		} else {
+			err = client.MoveIssuesToBacklog(ctx, open)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to move open issues, sprint %d was not completed: %w", sprintID, err)
+		}
+	}
+
+	result.Sprint, err = client.UpdateSprint(ctx, sprintID, &api.SprintRequest{State: api.SprintStateClosed})
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(result)
+	}
+
+	v.Success("Completed sprint %d: %s (%d issue(s) done)", sprintID, sprint.Name, len(completed))
+	if len(open) > 0 {
+		if targetID != 0 {
+			v.Info("Moved %d open issue(s) to sprint %d", len(open), targetID)
+		} else {
+			v.Info("Moved %d open issue(s) to the backlog", len(open))
+		}
+	}
+	return nil
+}
+
+// splitOpenIssues returns the keys of the issues to move out of a sprint
+// being completed, and of the done issues left in it. Sub-tasks are left
+// out: they follow their parents, which count as open if any of their
+// sub-tasks is.
+func splitOpenIssues(issues []api.Issue) (open, completed []string) {
+	openParents := map[string]bool{}
+	for _, issue := range issues {
+		if isSubtask(issue) && issue.Fields.Parent != nil && !isDone(issue) {
+			openParents[issue.Fields.Parent.Key] = true
+		}
+	}
+
+	open, completed = []string{}, []string{}
+	for _, issue := range issues {
+		if isSubtask(issue) {
+			continue
+		}
+		if isDone(issue) && !openParents[issue.Key] {
+			completed = append(completed, issue.Key)
+		} else {
+			open = append(open, issue.Key)
+		}
+	}
+	return open, completed
+}
+
+func isSubtask(issue api.Issue) bool {
+	return issue.Fields.IssueType != nil && issue.Fields.IssueType.Subtask
+}
+
+func isDone(issue api.Issue) bool {
+	return issue.Fields.Status != nil && issue.Fields.Status.StatusCategory.Key == "done"
+}
+
+// nextSprint returns the first future sprint on the board a sprint belongs to
+func nextSprint(ctx context.Context, client *api.Client, sprint *api.Sprint) (*api.Sprint, error) {
+	if sprint.OriginBoardID == 0 {
+		return nil, fmt.Errorf("sprint %d has no board, give a sprint ID to --move-open-to", sprint.ID)
+	}
+
+	future, err := client.ListAllSprints(ctx, sprint.OriginBoardID, api.SprintStateFuture)
+	if err != nil {
+		return nil, err
+	}
+	if len(future) == 0 {
+		return nil, fmt.Errorf("board %d has no future sprint, create one or use --move-open-to backlog", sprint.OriginBoardID)
+	}
+
+	return &future[0], nil
+}
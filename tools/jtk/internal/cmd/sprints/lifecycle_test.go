@@ -0,0 +1,167 @@
+package sprints
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// sprintServer fakes the agile API for one sprint, recording every change
+type sprintServer struct {
+	sprint  string
+	future  string
+	issues  string
+	changes []string
+}
+
+func (s *sprintServer) start(t *testing.T) *root.Options {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			body, _ := io.ReadAll(r.Body)
+			s.changes = append(s.changes, r.URL.Path+" "+string(body))
+		}
+		switch r.URL.Path {
+		case "/rest/agile/1.0/sprint/10":
+			fmt.Fprint(w, s.sprint)
+		case "/rest/agile/1.0/sprint/10/issue":
+			fmt.Fprint(w, s.issues)
+		case "/rest/agile/1.0/board/3/sprint":
+			assert.Equal(t, "future", r.URL.Query().Get("state"))
+			fmt.Fprint(w, s.future)
+		case "/rest/agile/1.0/sprint/11/issue", "/rest/agile/1.0/backlog/issue":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := api.New(api.ClientConfig{URL: server.URL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	opts := &root.Options{Output: "table", Stdout: &bytes.Buffer{}, Stderr: &bytes.Buffer{}}
+	opts.SetAPIClient(client)
+	return opts
+}
+
+const sprintIssues = `{"total": 4, "issues": [
+	{"key": "PROJ-1", "fields": {"status": {"statusCategory": {"key": "done"}}, "issuetype": {"name": "Story"}}},
+	{"key": "PROJ-2", "fields": {"status": {"statusCategory": {"key": "indeterminate"}}, "issuetype": {"name": "Story"}}},
+	{"key": "PROJ-3", "fields": {"status": {"statusCategory": {"key": "done"}}, "issuetype": {"name": "Story"}}},
+	{"key": "PROJ-4", "fields": {"status": {"statusCategory": {"key": "new"}}, "issuetype": {"name": "Sub-task", "subtask": true},
+		"parent": {"key": "PROJ-3"}}}
+]}`
+
+func TestRunComplete_Next(t *testing.T) {
+	s := &sprintServer{
+		sprint: `{"id": 10, "name": "Sprint 10", "state": "active", "originBoardId": 3}`,
+		future: `{"isLast": true, "values": [{"id": 11, "name": "Sprint 11", "state": "future"}]}`,
+		issues: sprintIssues,
+	}
+	opts := s.start(t)
+	opts.Output = "json"
+
+	require.NoError(t, runComplete(context.Background(), opts, 10, "next"))
+
+	// PROJ-3 is done but has an open sub-task, so it moves too
+	assert.Equal(t, []string{
+		`/rest/agile/1.0/sprint/11/issue {"issues":["PROJ-2","PROJ-3"]}`,
+		`/rest/agile/1.0/sprint/10 {"state":"closed"}`,
+	}, s.changes)
+
+	var result completeResult
+	require.NoError(t, json.Unmarshal(opts.Stdout.(*bytes.Buffer).Bytes(), &result))
+	assert.Equal(t, []string{"PROJ-1"}, result.Completed)
+	assert.Equal(t, "11", result.MovedTo)
+}
+
+func TestRunComplete_Backlog(t *testing.T) {
+	s := &sprintServer{
+		sprint: `{"id": 10, "name": "Sprint 10", "state": "active", "originBoardId": 3}`,
+		issues: sprintIssues,
+	}
+	opts := s.start(t)
+
+	require.NoError(t, runComplete(context.Background(), opts, 10, "backlog"))
+
+	assert.Equal(t, []string{
+		`/rest/agile/1.0/backlog/issue {"issues":["PROJ-2","PROJ-3"]}`,
+		`/rest/agile/1.0/sprint/10 {"state":"closed"}`,
+	}, s.changes)
+	assert.Contains(t, opts.Stdout.(*bytes.Buffer).String(), "Moved 2 open issue(s) to the backlog")
+}
+
+func TestRunComplete_NoFutureSprint(t *testing.T) {
+	s := &sprintServer{
+		sprint: `{"id": 10, "name": "Sprint 10", "state": "active", "originBoardId": 3}`,
+		future: `{"isLast": true, "values": []}`,
+		issues: sprintIssues,
+	}
+	opts := s.start(t)
+
+	err := runComplete(context.Background(), opts, 10, "next")
+	assert.ErrorContains(t, err, "no future sprint")
+	assert.Empty(t, s.changes)
+}
+
+func TestRunComplete_Invalid(t *testing.T) {
+	s := &sprintServer{sprint: `{"id": 10, "name": "Sprint 10", "state": "future"}`}
+	opts := s.start(t)
+
+	assert.ErrorContains(t, runComplete(context.Background(), opts, 10, "later"), "invalid --move-open-to")
+	assert.ErrorContains(t, runComplete(context.Background(), opts, 10, "10"), "being completed")
+	assert.ErrorContains(t, runComplete(context.Background(), opts, 10, "backlog"), "only active sprints")
+}
+
+func TestRunStart(t *testing.T) {
+	s := &sprintServer{sprint: `{"id": 10, "name": "Sprint 10", "state": "future", "endDate": "2026-10-31T17:00:00.000Z"}`}
+	opts := s.start(t)
+
+	req := &api.SprintRequest{State: api.SprintStateActive}
+	require.NoError(t, runStart(context.Background(), opts, 10, req, "2026-10-19T09:00:00Z", ""))
+
+	require.Len(t, s.changes, 1)
+	assert.Equal(t, `/rest/agile/1.0/sprint/10 {"state":"active","startDate":"2026-10-19T09:00:00Z","endDate":"2026-10-31T17:00:00Z"}`, s.changes[0])
+}
+
+func TestRunStart_NoEnd(t *testing.T) {
+	s := &sprintServer{sprint: `{"id": 10, "name": "Sprint 10", "state": "future"}`}
+	opts := s.start(t)
+
+	err := runStart(context.Background(), opts, 10, &api.SprintRequest{State: api.SprintStateActive}, "", "")
+	assert.ErrorContains(t, err, "use --end")
+	assert.Empty(t, s.changes)
+}
+
+func TestRunUpdate_EndBeforeStart(t *testing.T) {
+	s := &sprintServer{sprint: `{"id": 10, "name": "Sprint 10", "state": "active", "startDate": "2026-10-19T09:00:00.000Z"}`}
+	opts := s.start(t)
+
+	err := runUpdate(context.Background(), opts, 10, &api.SprintRequest{}, "", "2026-10-01")
+	assert.ErrorContains(t, err, "is not after its start")
+	assert.Empty(t, s.changes)
+}
+
+func TestParseSprintDate(t *testing.T) {
+	d, err := parseSprintDate("start", "2026-10-19")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-19 00:00", d.Format("2006-01-02 15:04"))
+
+	d, err = parseSprintDate("end", "")
+	require.NoError(t, err)
+	assert.Nil(t, d)
+
+	_, err = parseSprintDate("end", "next week")
+	assert.ErrorContains(t, err, "invalid --end")
+}
@@ -1,10 +1,14 @@
 package sprints
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
 
@@ -14,13 +18,17 @@ func Register(parent *cobra.Command, opts *root.Options) {
 		Use:     "sprints",
 		Aliases: []string{"sprint", "sp"},
 		Short:   "Manage sprints",
-		Long:    "Commands for viewing sprints and sprint issues.",
+		Long:    "Commands for managing sprints and sprint issues.",
 	}
 
 	cmd.AddCommand(newListCmd(opts))
 	cmd.AddCommand(newCurrentCmd(opts))
 	cmd.AddCommand(newIssuesCmd(opts))
 	cmd.AddCommand(newAddCmd(opts))
+	cmd.AddCommand(newCreateCmd(opts))
+	cmd.AddCommand(newStartCmd(opts))
+	cmd.AddCommand(newUpdateCmd(opts))
+	cmd.AddCommand(newCompleteCmd(opts))
 
 	parent.AddCommand(cmd)
 }
@@ -29,6 +37,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	var boardID int
 	var state string
 	var maxResults int
+	var all bool
 
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -38,23 +47,27 @@ func newListCmd(opts *root.Options) *cobra.Command {
   jtk sprints list --board 123
 
   # List only active sprints
-  jtk sprints list --board 123 --state active`,
+  jtk sprints list --board 123 --state active
+
+  # List every sprint on the board
+  jtk sprints list --board 123 --all`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if boardID == 0 {
 				return fmt.Errorf("--board is required")
 			}
-			return runList(opts, boardID, state, maxResults)
+			return runList(cmd.Context(), opts, boardID, state, maxResults, all)
 		},
 	}
 
 	cmd.Flags().IntVarP(&boardID, "board", "b", 0, "Board ID (required)")
 	cmd.Flags().StringVarP(&state, "state", "s", "", "Filter by state (active, closed, future)")
 	cmd.Flags().IntVarP(&maxResults, "max", "m", 50, "Maximum number of results")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch all results, ignoring --max")
 
 	return cmd
 }
 
-func runList(opts *root.Options, boardID int, state string, maxResults int) error {
+func runList(ctx context.Context, opts *root.Options, boardID int, state string, maxResults int, all bool) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -62,24 +75,33 @@ func runList(opts *root.Options, boardID int, state string, maxResults int) erro
 		return err
 	}
 
-	result, err := client.ListSprints(boardID, state, 0, maxResults)
+	var sprints []api.Sprint
+	if all {
+		sprints, err = client.ListAllSprints(ctx, boardID, state)
+	} else {
+		var result *api.SprintsResponse
+		result, err = client.ListSprints(ctx, boardID, state, 0, maxResults)
+		if result != nil {
+			sprints = result.Values
+		}
+	}
 	if err != nil {
 		return err
 	}
 
-	if len(result.Values) == 0 {
+	if len(sprints) == 0 {
 		v.Info("No sprints found")
 		return nil
 	}
 
-	if opts.Output == "json" {
-		return v.JSON(result.Values)
+	if view.IsStructured(opts.Output) {
+		return v.JSON(sprints)
 	}
 
 	headers := []string{"ID", "NAME", "STATE", "START", "END"}
 	var rows [][]string
 
-	for _, s := range result.Values {
+	for _, s := range sprints {
 		startDate := ""
 		if s.StartDate != nil {
 			startDate = s.StartDate.Format("2006-01-02")
@@ -113,7 +135,7 @@ func newCurrentCmd(opts *root.Options) *cobra.Command {
 			if boardID == 0 {
 				return fmt.Errorf("--board is required")
 			}
-			return runCurrent(opts, boardID)
+			return runCurrent(cmd.Context(), opts, boardID)
 		},
 	}
 
@@ -122,7 +144,7 @@ func newCurrentCmd(opts *root.Options) *cobra.Command {
 	return cmd
 }
 
-func runCurrent(opts *root.Options, boardID int) error {
+func runCurrent(ctx context.Context, opts *root.Options, boardID int) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -130,12 +152,12 @@ func runCurrent(opts *root.Options, boardID int) error {
 		return err
 	}
 
-	sprint, err := client.GetCurrentSprint(boardID)
+	sprint, err := client.GetCurrentSprint(ctx, boardID)
 	if err != nil {
 		return err
 	}
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(sprint)
 	}
 
@@ -157,28 +179,31 @@ func runCurrent(opts *root.Options, boardID int) error {
 
 func newIssuesCmd(opts *root.Options) *cobra.Command {
 	var maxResults int
+	var all bool
 
 	cmd := &cobra.Command{
-		Use:     "issues <sprint-id>",
-		Short:   "List issues in a sprint",
-		Long:    "List all issues in a specific sprint.",
-		Example: `  jtk sprints issues 456`,
-		Args:    cobra.ExactArgs(1),
+		Use:   "issues <sprint-id>",
+		Short: "List issues in a sprint",
+		Long:  "List all issues in a specific sprint.",
+		Example: `  jtk sprints issues 456
+  jtk sprints issues 456 --all`,
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			var sprintID int
 			if _, err := fmt.Sscanf(args[0], "%d", &sprintID); err != nil {
 				return fmt.Errorf("invalid sprint ID: %s", args[0])
 			}
-			return runIssues(opts, sprintID, maxResults)
+			return runIssues(cmd.Context(), opts, sprintID, maxResults, all)
 		},
 	}
 
 	cmd.Flags().IntVarP(&maxResults, "max", "m", 50, "Maximum number of results")
+	cmd.Flags().BoolVar(&all, "all", false, "Fetch all results, ignoring --max")
 
 	return cmd
 }
 
-func runIssues(opts *root.Options, sprintID int, maxResults int) error {
+func runIssues(ctx context.Context, opts *root.Options, sprintID int, maxResults int, all bool) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -186,24 +211,44 @@ func runIssues(opts *root.Options, sprintID int, maxResults int) error {
 		return err
 	}
 
-	result, err := client.GetSprintIssues(sprintID, 0, maxResults)
+	headers := []string{"KEY", "SUMMARY", "STATUS", "ASSIGNEE", "TYPE"}
+	var columns []api.FieldColumn
+	if !view.IsStructured(opts.Output) {
+		columns, err = client.ResolveFieldColumns(ctx, opts.Columns, headers)
+		if err != nil {
+			return err
+		}
+	}
+
+	var issues []api.Issue
+	if all {
+		issues, err = client.GetAllSprintIssues(ctx, sprintID)
+	} else {
+		var result *api.SearchResult
+		result, err = client.GetSprintIssues(ctx, sprintID, 0, maxResults)
+		if result != nil {
+			issues = result.Issues
+		}
+	}
 	if err != nil {
 		return err
 	}
 
-	if len(result.Issues) == 0 {
+	if len(issues) == 0 {
 		v.Info("No issues in sprint")
 		return nil
 	}
 
-	if opts.Output == "json" {
-		return v.JSON(result.Issues)
+	if view.IsStructured(opts.Output) {
+		return v.JSON(issues)
 	}
 
-	headers := []string{"KEY", "SUMMARY", "STATUS", "ASSIGNEE", "TYPE"}
+	for _, col := range columns {
+		headers = append(headers, col.Name)
+	}
 	var rows [][]string
 
-	for _, issue := range result.Issues {
+	for _, issue := range issues {
 		status := ""
 		if issue.Fields.Status != nil {
 			status = issue.Fields.Status.Name
@@ -224,13 +269,14 @@ func runIssues(opts *root.Options, sprintID int, maxResults int) error {
 			summary = summary[:50] + "..."
 		}
 
-		rows = append(rows, []string{
+		row := []string{
 			issue.Key,
 			summary,
 			status,
 			assignee,
 			issueType,
-		})
+		}
+		rows = append(rows, append(row, api.FieldColumnValues(issue, columns)...))
 	}
 
 	return v.Table(headers, rows)
@@ -252,14 +298,14 @@ func newAddCmd(opts *root.Options) *cobra.Command {
 			if _, err := fmt.Sscanf(args[0], "%d", &sprintID); err != nil {
 				return fmt.Errorf("invalid sprint ID: %s", args[0])
 			}
-			return runAdd(opts, sprintID, args[1:])
+			return runAdd(cmd.Context(), opts, sprintID, args[1:])
 		},
 	}
 
 	return cmd
 }
 
-func runAdd(opts *root.Options, sprintID int, issueKeys []string) error {
+func runAdd(ctx context.Context, opts *root.Options, sprintID int, issueKeys []string) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -267,7 +313,7 @@ func runAdd(opts *root.Options, sprintID int, issueKeys []string) error {
 		return err
 	}
 
-	if err := client.MoveIssuesToSprint(sprintID, issueKeys); err != nil {
+	if err := client.MoveIssuesToSprint(ctx, sprintID, issueKeys); err != nil {
 		return err
 	}
 
@@ -0,0 +1,281 @@
+package transitions
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// maxPathStatuses caps how many statuses the path search explores
+const maxPathStatuses = 50
+
+// transitionsFunc returns the transitions that can be taken from a status
+type transitionsFunc func(ctx context.Context, status api.Status) ([]api.Transition, error)
+
+// pathStep is one transition taken on the way to a status
+type pathStep struct {
+	Transition string `json:"transition"`
+	From       string `json:"from"`
+	To         string `json:"to"`
+}
+
+func runDoTo(ctx context.Context, opts *root.Options, issueKey, target string, fieldArgs []string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	issue, err := client.GetIssue(ctx, issueKey)
+	if err != nil {
+		return err
+	}
+	if issue.Fields.Status == nil {
+		return fmt.Errorf("issue %s has no status", issueKey)
+	}
+	current := *issue.Fields.Status
+	if statusMatches(current, target) {
+		v.Info("%s is already in %s", issueKey, current.Name)
+		return nil
+	}
+
+	given, err := parseFields(ctx, client, fieldArgs)
+	if err != nil {
+		return err
+	}
+
+	transitionsFrom, source := pathSource(ctx, client, issue)
+	path, err := findPath(ctx, current, target, transitionsFrom)
+	if err != nil {
+		return err
+	}
+
+	structured := view.IsStructured(opts.Output)
+	if !structured {
+		v.Info("Path from %s to %s (%s):", current.Name, path[len(path)-1].To.Name, source)
+		for i, t := range path {
+			v.Info("  %d. %s -> %s", i+1, t.Name, t.To.Name)
+		}
+	}
+
+	in := bufio.NewReader(opts.Stdin)
+	used := make(map[string]bool)
+	steps := make([]pathStep, 0, len(path))
+	for i, planned := range path {
+		stop := func(err error) error {
+			return fmt.Errorf("step %d of %d (%s) failed, leaving %s in %s: %w", i+1, len(path), planned.Name, issueKey, current.Name, err)
+		}
+
+		// The path is only a plan: check the transition is still there, and
+		// see which fields its screen asks for
+		transitions, err := client.GetTransitionsWithFields(ctx, issueKey, true)
+		if err != nil {
+			return stop(err)
+		}
+		t := matchStep(transitions, planned)
+		if t == nil {
+			return stop(fmt.Errorf("transition %q is not available", planned.Name))
+		}
+
+		fields, err := stepFields(opts.Stderr, in, t, given, used)
+		if err != nil {
+			return stop(err)
+		}
+
+		if err := client.DoTransition(ctx, issueKey, t.ID, fields); err != nil {
+			return stop(err)
+		}
+
+		steps = append(steps, pathStep{Transition: t.Name, From: current.Name, To: t.To.Name})
+		if !structured {
+			v.Success("%s: %s -> %s", issueKey, current.Name, t.To.Name)
+		}
+		current = t.To
+	}
+
+	for id := range given {
+		if !used[id] {
+			v.Warning("Field %s is not on any transition screen along the path and was not set", id)
+		}
+	}
+
+	if structured {
+		return v.JSON(map[string]interface{}{"key": issueKey, "status": current.Name, "steps": steps})
+	}
+	return nil
+}
+
+// pathSource returns how to find the transitions out of each status, and a
+// description of it. The workflow is used when it can be read; otherwise
+// transitions are looked up on an issue of the same project and type in
+// each status.
+func pathSource(ctx context.Context, client *api.Client, issue *api.Issue) (transitionsFunc, string) {
+	if workflow, err := client.GetIssueWorkflow(ctx, issue); err == nil {
+		return func(_ context.Context, status api.Status) ([]api.Transition, error) {
+			return workflow.TransitionsFrom(status.ID), nil
+		}, "workflow " + workflow.Name
+	}
+
+	return func(ctx context.Context, status api.Status) ([]api.Transition, error) {
+		if status.ID == issue.Fields.Status.ID {
+			return client.GetTransitions(ctx, issue.Key)
+		}
+		if issue.Fields.Project == nil || issue.Fields.IssueType == nil {
+			return nil, nil
+		}
+
+		jql := fmt.Sprintf("project = %q AND issuetype = %s AND status = %s",
+			issue.Fields.Project.Key, issue.Fields.IssueType.ID, status.ID)
+		samples, err := client.SearchAll(ctx, jql, 1)
+		if err != nil || len(samples) == 0 {
+			// Without an issue in this status its transitions are unknown
+			return nil, err
+		}
+		return client.GetTransitions(ctx, samples[0].Key)
+	}, "transitions of other issues"
+}
+
+// findPath does a breadth-first search for the shortest sequence of
+// transitions from a status to the target status, given by name or ID
+func findPath(ctx context.Context, from api.Status, target string, transitionsFrom transitionsFunc) ([]api.Transition, error) {
+	type node struct {
+		status api.Status
+		path   []api.Transition
+	}
+
+	queue := []node{{status: from}}
+	visited := map[string]bool{from.ID: true}
+	for len(queue) > 0 && len(visited) <= maxPathStatuses {
+		n := queue[0]
+		queue = queue[1:]
+
+		transitions, err := transitionsFrom(ctx, n.status)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get transitions from %s: %w", n.status.Name, err)
+		}
+
+		for _, t := range transitions {
+			if visited[t.To.ID] {
+				continue
+			}
+			path := append(slices.Clone(n.path), t)
+			if statusMatches(t.To, target) {
+				return path, nil
+			}
+			visited[t.To.ID] = true
+			queue = append(queue, node{status: t.To, path: path})
+		}
+	}
+
+	return nil, fmt.Errorf("no path found from %s to %s", from.Name, target)
+}
+
+func statusMatches(status api.Status, target string) bool {
+	return status.ID == target || strings.EqualFold(status.Name, target)
+}
+
+// matchStep finds the planned transition among those now available,
+// by ID or else by the status it leads to
+func matchStep(transitions []api.Transition, planned api.Transition) *api.Transition {
+	for i := range transitions {
+		if transitions[i].ID == planned.ID && transitions[i].To.ID == planned.To.ID {
+			return &transitions[i]
+		}
+	}
+	for i := range transitions {
+		if transitions[i].To.ID == planned.To.ID {
+			return &transitions[i]
+		}
+	}
+	return nil
+}
+
+// stepFields returns the fields to send with a transition: the given
+// fields that are on its screen, and a value asked for on in for every
+// other required field. Given fields that are sent are marked in used.
+func stepFields(w io.Writer, in *bufio.Reader, t *api.Transition, given map[string]interface{}, used map[string]bool) (map[string]interface{}, error) {
+	ids := make([]string, 0, len(t.Fields))
+	for id := range t.Fields {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	fields := make(map[string]interface{})
+	for _, id := range ids {
+		f := t.Fields[id]
+		if value, ok := given[id]; ok {
+			fields[id] = value
+			used[id] = true
+			continue
+		}
+		if !f.Required || f.HasDefaultValue {
+			continue
+		}
+
+		value, err := promptField(w, in, t.Name, f)
+		if err != nil {
+			return nil, err
+		}
+		fields[id] = api.FormatFieldValue(&api.Field{ID: id, Name: f.Name, Schema: f.Schema}, value)
+	}
+
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return fields, nil
+}
+
+// promptField asks for the value of a field required by a transition
+// screen. A value must be one of the allowed values, if there are any.
+func promptField(w io.Writer, in *bufio.Reader, transition string, f api.TransitionField) (string, error) {
+	labels := make([]string, len(f.AllowedValues))
+	for i, option := range f.AllowedValues {
+		labels[i] = optionLabel(option)
+	}
+
+	_, _ = fmt.Fprintf(w, "%s requires %s", transition, f.Name)
+	if len(labels) > 0 {
+		_, _ = fmt.Fprintf(w, " (%s)", strings.Join(labels, ", "))
+	}
+	_, _ = fmt.Fprint(w, ": ")
+
+	line, err := in.ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("failed to read %s: %w", f.Name, err)
+	}
+	value := strings.TrimSpace(line)
+	if value == "" {
+		return "", fmt.Errorf("no value given for %s", f.Name)
+	}
+
+	if len(f.AllowedValues) == 0 {
+		return value, nil
+	}
+	for _, option := range f.AllowedValues {
+		if option.ID == value || strings.EqualFold(optionLabel(option), value) {
+			return optionLabel(option), nil
+		}
+	}
+	return "", fmt.Errorf("%q is not an allowed value for %s", value, f.Name)
+}
+
+func optionLabel(option api.FieldOption) string {
+	if option.Name != "" {
+		return option.Name
+	}
+	if option.Value != "" {
+		return option.Value
+	}
+	return option.ID
+}
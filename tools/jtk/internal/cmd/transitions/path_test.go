@@ -0,0 +1,206 @@
+package transitions
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+var (
+	statusToDo       = api.Status{ID: "1", Name: "To Do"}
+	statusInProgress = api.Status{ID: "2", Name: "In Progress"}
+	statusReview     = api.Status{ID: "3", Name: "Review"}
+	statusDone       = api.Status{ID: "4", Name: "Done"}
+	statusBlocked    = api.Status{ID: "5", Name: "Blocked"}
+)
+
+// testWorkflow maps status IDs to the transitions out of them
+var testWorkflow = map[string][]api.Transition{
+	"1": {{ID: "11", Name: "Start", To: statusInProgress}, {ID: "51", Name: "Block", To: statusBlocked}},
+	"2": {{ID: "21", Name: "Submit", To: statusReview}, {ID: "12", Name: "Stop", To: statusToDo}},
+	"3": {{ID: "31", Name: "Approve", To: statusDone, Fields: map[string]api.TransitionField{
+		"resolution": {Required: true, Name: "Resolution", Schema: api.FieldSchema{Type: "resolution"}, AllowedValues: []api.FieldOption{{ID: "1", Name: "Fixed"}, {ID: "2", Name: "Won't Fix"}}},
+	}}},
+	"5": {{ID: "52", Name: "Unblock", To: statusToDo}},
+}
+
+func TestFindPath(t *testing.T) {
+	var explored []string
+	transitionsFrom := func(_ context.Context, status api.Status) ([]api.Transition, error) {
+		explored = append(explored, status.Name)
+		return testWorkflow[status.ID], nil
+	}
+
+	path, err := findPath(context.Background(), statusToDo, "done", transitionsFrom)
+	require.NoError(t, err)
+	var names []string
+	for _, t := range path {
+		names = append(names, t.Name)
+	}
+	assert.Equal(t, []string{"Start", "Submit", "Approve"}, names)
+	assert.Equal(t, []string{"To Do", "In Progress", "Blocked", "Review"}, explored)
+
+	path, err = findPath(context.Background(), statusBlocked, "2", transitionsFrom)
+	require.NoError(t, err)
+	assert.Len(t, path, 2)
+
+	_, err = findPath(context.Background(), statusDone, "To Do", transitionsFrom)
+	assert.EqualError(t, err, "no path found from Done to To Do")
+}
+
+// fakeJira serves one issue moving through testWorkflow. With workflow
+// set, the workflow API is available; otherwise it is forbidden and
+// transitions come from a sample issue in each status.
+type fakeJira struct {
+	t        *testing.T
+	status   api.Status
+	workflow bool
+	// removed transitions are not offered when the issue gets there
+	removed map[string]bool
+	posts   []string
+}
+
+func (f *fakeJira) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == "/rest/api/3/issue/PROJ-1" && r.Method == http.MethodGet:
+		json.NewEncoder(w).Encode(api.Issue{Key: "PROJ-1", Fields: api.IssueFields{
+			Status:    &f.status,
+			Project:   &api.Project{ID: "10000", Key: "PROJ"},
+			IssueType: &api.IssueType{ID: "10001", Name: "Task"},
+		}})
+	case r.URL.Path == "/rest/api/3/field":
+		json.NewEncoder(w).Encode([]api.Field{{ID: "resolution", Name: "Resolution", Schema: api.FieldSchema{Type: "resolution"}}})
+	case r.URL.Path == "/rest/api/3/workflowscheme/project":
+		if !f.workflow {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errorMessages": ["Forbidden"]}`))
+			return
+		}
+		w.Write([]byte(`{"values": [{"workflowScheme": {"defaultWorkflow": "Dev flow"}}]}`))
+	case r.URL.Path == "/rest/api/3/workflow/search":
+		wf := map[string]interface{}{
+			"id":       map[string]string{"name": "Dev flow"},
+			"statuses": []api.Status{statusToDo, statusInProgress, statusReview, statusDone, statusBlocked},
+		}
+		var transitions []map[string]interface{}
+		for from, ts := range testWorkflow {
+			for _, t := range ts {
+				transitions = append(transitions, map[string]interface{}{"id": t.ID, "name": t.Name, "from": []string{from}, "to": t.To.ID, "type": "directed"})
+			}
+		}
+		wf["transitions"] = transitions
+		json.NewEncoder(w).Encode(map[string]interface{}{"values": []interface{}{wf}})
+	case r.URL.Path == "/rest/api/3/search/jql":
+		var req struct {
+			JQL string `json:"jql"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		statusID := req.JQL[strings.LastIndex(req.JQL, " ")+1:]
+		assert.Contains(f.t, req.JQL, `project = "PROJ" AND issuetype = 10001`)
+		json.NewEncoder(w).Encode(map[string]interface{}{"issues": []api.Issue{{Key: "SAMPLE-" + statusID}}, "isLast": true})
+	case strings.HasPrefix(r.URL.Path, "/rest/api/3/issue/SAMPLE-"):
+		statusID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/rest/api/3/issue/SAMPLE-"), "/transitions")
+		json.NewEncoder(w).Encode(api.TransitionsResponse{Transitions: testWorkflow[statusID]})
+	case r.URL.Path == "/rest/api/3/issue/PROJ-1/transitions" && r.Method == http.MethodGet:
+		var offered []api.Transition
+		for _, t := range testWorkflow[f.status.ID] {
+			if !f.removed[t.ID] {
+				offered = append(offered, t)
+			}
+		}
+		json.NewEncoder(w).Encode(api.TransitionsResponse{Transitions: offered})
+	case r.URL.Path == "/rest/api/3/issue/PROJ-1/transitions" && r.Method == http.MethodPost:
+		var req struct {
+			Transition api.TransitionID       `json:"transition"`
+			Fields     map[string]interface{} `json:"fields"`
+		}
+		json.NewDecoder(r.Body).Decode(&req)
+		for _, t := range testWorkflow[f.status.ID] {
+			if t.ID == req.Transition.ID {
+				f.status = t.To
+			}
+		}
+		fields, _ := json.Marshal(req.Fields)
+		f.posts = append(f.posts, fmt.Sprintf("%s %s", req.Transition.ID, fields))
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		f.t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	}
+}
+
+func newPathTestOptions(t *testing.T, f *fakeJira, stdin string) (*root.Options, *bytes.Buffer, *bytes.Buffer) {
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	client, err := api.New(api.ClientConfig{URL: server.URL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	var stdout, stderr bytes.Buffer
+	opts := &root.Options{Output: "table", Stdin: strings.NewReader(stdin), Stdout: &stdout, Stderr: &stderr}
+	opts.SetAPIClient(client)
+	return opts, &stdout, &stderr
+}
+
+func TestRunDoTo_Workflow(t *testing.T) {
+	f := &fakeJira{t: t, status: statusToDo, workflow: true}
+	opts, stdout, stderr := newPathTestOptions(t, f, "won't fix\n")
+
+	err := runDoTo(context.Background(), opts, "PROJ-1", "Done", nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, statusDone, f.status)
+	assert.Equal(t, []string{"11 null", "21 null", `31 {"resolution":{"name":"Won't Fix"}}`}, f.posts)
+	assert.Contains(t, stdout.String(), "Path from To Do to Done (workflow Dev flow):")
+	assert.Contains(t, stdout.String(), "3. Approve -> Done")
+	assert.Contains(t, stderr.String(), "Approve requires Resolution (Fixed, Won't Fix): ")
+}
+
+func TestRunDoTo_SampleIssues(t *testing.T) {
+	f := &fakeJira{t: t, status: statusToDo}
+	opts, stdout, stderr := newPathTestOptions(t, f, "")
+
+	err := runDoTo(context.Background(), opts, "PROJ-1", "Done", []string{"resolution=Fixed"})
+	require.NoError(t, err)
+
+	assert.Equal(t, statusDone, f.status)
+	assert.Equal(t, []string{"11 null", "21 null", `31 {"resolution":{"name":"Fixed"}}`}, f.posts)
+	assert.Contains(t, stdout.String(), "(transitions of other issues)")
+	assert.NotContains(t, stderr.String(), "requires")
+}
+
+func TestRunDoTo_StopsWhenStepUnavailable(t *testing.T) {
+	f := &fakeJira{t: t, status: statusToDo, workflow: true, removed: map[string]bool{"21": true}}
+	opts, _, _ := newPathTestOptions(t, f, "")
+
+	err := runDoTo(context.Background(), opts, "PROJ-1", "Done", nil)
+	assert.EqualError(t, err, `step 2 of 3 (Submit) failed, leaving PROJ-1 in In Progress: transition "Submit" is not available`)
+	assert.Equal(t, []string{"11 null"}, f.posts)
+}
+
+func TestRunDoTo_MissingRequiredField(t *testing.T) {
+	f := &fakeJira{t: t, status: statusReview, workflow: true}
+	opts, _, _ := newPathTestOptions(t, f, "\n")
+
+	err := runDoTo(context.Background(), opts, "PROJ-1", "done", nil)
+	assert.EqualError(t, err, "step 1 of 1 (Approve) failed, leaving PROJ-1 in Review: no value given for Resolution")
+	assert.Empty(t, f.posts)
+}
+
+func TestRunDoTo_AlreadyThere(t *testing.T) {
+	f := &fakeJira{t: t, status: statusDone}
+	opts, stdout, _ := newPathTestOptions(t, f, "")
+
+	require.NoError(t, runDoTo(context.Background(), opts, "PROJ-1", "Done", nil))
+	assert.Contains(t, stdout.String(), "PROJ-1 is already in Done")
+}
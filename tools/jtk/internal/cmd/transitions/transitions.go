@@ -1,12 +1,16 @@
 package transitions
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/open-cli-collective/atlassian-go/view"
+
 	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/bulk"
 	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
 
@@ -39,7 +43,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
   jtk transitions list PROJ-123 --fields`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runList(opts, args[0], showFields)
+			return runList(cmd.Context(), opts, args[0], showFields)
 		},
 	}
 
@@ -48,7 +52,7 @@ func newListCmd(opts *root.Options) *cobra.Command {
 	return cmd
 }
 
-func runList(opts *root.Options, issueKey string, showFields bool) error {
+func runList(ctx context.Context, opts *root.Options, issueKey string, showFields bool) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -56,7 +60,7 @@ func runList(opts *root.Options, issueKey string, showFields bool) error {
 		return err
 	}
 
-	transitions, err := client.GetTransitionsWithFields(issueKey, showFields)
+	transitions, err := client.GetTransitionsWithFields(ctx, issueKey, showFields)
 	if err != nil {
 		return err
 	}
@@ -66,7 +70,7 @@ func runList(opts *root.Options, issueKey string, showFields bool) error {
 		return nil
 	}
 
-	if opts.Output == "json" {
+	if view.IsStructured(opts.Output) {
 		return v.JSON(transitions)
 	}
 
@@ -108,13 +112,25 @@ func getRequiredFields(t api.Transition) string {
 
 func newDoCmd(opts *root.Options) *cobra.Command {
 	var fields []string
+	var toStatus string
+	var bulkFlags bulk.Flags
 
 	cmd := &cobra.Command{
 		Use:   "do <issue-key> <transition>",
 		Short: "Perform a transition",
 		Long: `Perform a workflow transition on an issue. The transition can be specified by name or ID.
 
-Some transitions require additional fields to be set. Use --field to provide them.`,
+Some transitions require additional fields to be set. Use --field to provide them.
+
+With --jql, the transition is performed on every matching issue instead, after
+showing how many issues match and asking for confirmation. Give the transition
+by name, since IDs differ between workflows.
+
+With --to, jtk finds the shortest way through the workflow to a status and
+performs each transition in turn. The path comes from the workflow, which
+needs Jira admin permission to read; otherwise it is pieced together from the
+transitions of other issues of the same project and type. Fields required by
+a transition screen along the way are taken from --field or asked for.`,
 		Example: `  # Transition by name
   jtk transitions do PROJ-123 "In Progress"
 
@@ -123,19 +139,42 @@ Some transitions require additional fields to be set. Use --field to provide the
 
   # Transition with required fields
   jtk transitions do PROJ-123 "In Progress" --field resolution=Done
-  jtk transitions do PROJ-123 "Done" --field customfield_10001="some value"`,
-		Args: cobra.ExactArgs(2),
+  jtk transitions do PROJ-123 "Done" --field customfield_10001="some value"
+
+  # Walk the workflow to a status
+  jtk transitions do PROJ-123 --to Done --field resolution=Fixed
+
+  # Move stale reviews back to To Do
+  jtk transitions do --jql 'project = PROJ AND status = Review AND updated < -30d' "To Do"`,
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runDo(opts, args[0], args[1], fields)
+			if toStatus != "" {
+				if bulkFlags.JQL != "" || len(args) != 1 {
+					return fmt.Errorf("with --to, give only the issue key")
+				}
+				return runDoTo(cmd.Context(), opts, args[0], toStatus, fields)
+			}
+			if bulkFlags.JQL != "" {
+				if len(args) != 1 {
+					return fmt.Errorf("with --jql, give only the transition")
+				}
+				return runDoJQL(cmd.Context(), opts, bulkFlags, args[0], fields)
+			}
+			if len(args) != 2 {
+				return fmt.Errorf("requires an issue key and a transition")
+			}
+			return runDo(cmd.Context(), opts, args[0], args[1], fields)
 		},
 	}
 
 	cmd.Flags().StringArrayVarP(&fields, "field", "f", nil, "Fields to set during transition (key=value)")
+	cmd.Flags().StringVar(&toStatus, "to", "", "Status to reach, through as many transitions as needed")
+	bulk.AddFlags(cmd, &bulkFlags)
 
 	return cmd
 }
 
-func runDo(opts *root.Options, issueKey, transitionNameOrID string, fieldArgs []string) error {
+func runDo(ctx context.Context, opts *root.Options, issueKey, transitionNameOrID string, fieldArgs []string) error {
 	v := opts.View()
 
 	client, err := opts.APIClient()
@@ -144,30 +183,13 @@ func runDo(opts *root.Options, issueKey, transitionNameOrID string, fieldArgs []
 	}
 
 	// Get available transitions
-	transitions, err := client.GetTransitions(issueKey)
+	transitions, err := client.GetTransitions(ctx, issueKey)
 	if err != nil {
 		return err
 	}
 
-	// Find the transition
-	var transitionID string
-
-	// First try by ID
-	for _, t := range transitions {
-		if t.ID == transitionNameOrID {
-			transitionID = t.ID
-			break
-		}
-	}
-
-	// Then try by name
-	if transitionID == "" {
-		if t := api.FindTransitionByName(transitions, transitionNameOrID); t != nil {
-			transitionID = t.ID
-		}
-	}
-
-	if transitionID == "" {
+	transition := findTransition(transitions, transitionNameOrID)
+	if transition == nil {
 		v.Error("Transition '%s' not found", transitionNameOrID)
 		v.Info("Available transitions:")
 		for _, t := range transitions {
@@ -176,47 +198,94 @@ func runDo(opts *root.Options, issueKey, transitionNameOrID string, fieldArgs []
 		return fmt.Errorf("transition not found: %s", transitionNameOrID)
 	}
 
-	// Parse fields if provided
-	var fields map[string]interface{}
-	if len(fieldArgs) > 0 {
-		fields = make(map[string]interface{})
+	fields, err := parseFields(ctx, client, fieldArgs)
+	if err != nil {
+		return err
+	}
 
-		// Get field metadata for name resolution and type detection
-		allFields, err := client.GetFields()
+	if err := client.DoTransition(ctx, issueKey, transition.ID, fields); err != nil {
+		return err
+	}
+
+	v.Success("Transitioned %s", issueKey)
+	return nil
+}
+
+func runDoJQL(ctx context.Context, opts *root.Options, bulkFlags bulk.Flags, transitionNameOrID string, fieldArgs []string) error {
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	fields, err := parseFields(ctx, client, fieldArgs)
+	if err != nil {
+		return err
+	}
+
+	change := fmt.Sprintf("transition %q", transitionNameOrID)
+	return bulk.Run(ctx, opts, bulkFlags, change, func(ctx context.Context, issue *api.Issue) error {
+		// Each issue may be in a different workflow, with its own transitions
+		transitions, err := client.GetTransitions(ctx, issue.Key)
 		if err != nil {
-			return fmt.Errorf("failed to get field metadata: %w", err)
+			return err
+		}
+		transition := findTransition(transitions, transitionNameOrID)
+		if transition == nil {
+			return fmt.Errorf("transition %q is not available", transitionNameOrID)
 		}
+		return client.DoTransition(ctx, issue.Key, transition.ID, fields)
+	})
+}
 
-		for _, f := range fieldArgs {
-			parts := strings.SplitN(f, "=", 2)
-			if len(parts) != 2 {
-				return fmt.Errorf("invalid field format: %s (expected key=value)", f)
-			}
+// findTransition finds a transition by ID, then by name (case-insensitive)
+func findTransition(transitions []api.Transition, nameOrID string) *api.Transition {
+	for i := range transitions {
+		if transitions[i].ID == nameOrID {
+			return &transitions[i]
+		}
+	}
+	return api.FindTransitionByName(transitions, nameOrID)
+}
 
-			key, value := parts[0], parts[1]
-
-			// Try to resolve field name to ID and get field info
-			var fieldID string
-			var field *api.Field
-			if resolved := api.FindFieldByName(allFields, key); resolved != nil {
-				fieldID = resolved.ID
-				field = resolved
-			} else if resolved := api.FindFieldByID(allFields, key); resolved != nil {
-				fieldID = resolved.ID
-				field = resolved
-			} else {
-				fieldID = key
-			}
+// parseFields converts key=value arguments to transition fields, resolving
+// field names to IDs
+func parseFields(ctx context.Context, client *api.Client, fieldArgs []string) (map[string]interface{}, error) {
+	if len(fieldArgs) == 0 {
+		return nil, nil
+	}
 
-			// Format value based on field type
-			fields[fieldID] = api.FormatFieldValue(field, value)
-		}
+	fields := make(map[string]interface{})
+
+	// Get field metadata for name resolution and type detection
+	allFields, err := client.GetFields(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get field metadata: %w", err)
 	}
 
-	if err := client.DoTransition(issueKey, transitionID, fields); err != nil {
-		return err
+	for _, f := range fieldArgs {
+		parts := strings.SplitN(f, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid field format: %s (expected key=value)", f)
+		}
+
+		key, value := parts[0], parts[1]
+
+		// Try to resolve field name to ID and get field info
+		var fieldID string
+		var field *api.Field
+		if resolved := api.FindFieldByName(allFields, key); resolved != nil {
+			fieldID = resolved.ID
+			field = resolved
+		} else if resolved := api.FindFieldByID(allFields, key); resolved != nil {
+			fieldID = resolved.ID
+			field = resolved
+		} else {
+			fieldID = key
+		}
+
+		// Format value based on field type
+		fields[fieldID] = api.FormatFieldValue(field, value)
 	}
 
-	v.Success("Transitioned %s", issueKey)
-	return nil
+	return fields, nil
 }
@@ -1,11 +1,21 @@
 package transitions
 
 import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/bulk"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
 )
 
 func TestFormatFieldValue(t *testing.T) {
@@ -195,3 +205,43 @@ func TestGetRequiredFields(t *testing.T) {
 		})
 	}
 }
+
+func TestRunDoJQL(t *testing.T) {
+	var transitioned []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/rest/api/3/search/jql":
+			w.Write([]byte(`{"issues": [{"key": "PROJ-1"}, {"key": "PROJ-2"}], "isLast": true}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/PROJ-1/transitions":
+			w.Write([]byte(`{"transitions": [{"id": "11", "name": "To Do"}]}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/rest/api/3/issue/PROJ-2/transitions":
+			w.Write([]byte(`{"transitions": [{"id": "31", "name": "Done"}]}`))
+		case r.Method == http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			mu.Lock()
+			transitioned = append(transitioned, r.URL.Path+" "+string(body))
+			mu.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	client, err := api.New(api.ClientConfig{URL: server.URL, Email: "test@example.com", APIToken: "token"})
+	require.NoError(t, err)
+
+	var stdout bytes.Buffer
+	opts := &root.Options{Output: "table", Stdin: strings.NewReader("y\n"), Stdout: &stdout, Stderr: &bytes.Buffer{}}
+	opts.SetAPIClient(client)
+
+	flags := bulk.Flags{JQL: "status = Review", Concurrency: 2}
+	err = runDoJQL(context.Background(), opts, flags, "to do", nil)
+	assert.EqualError(t, err, "1 of 2 issue(s) failed")
+
+	require.Len(t, transitioned, 1)
+	assert.Contains(t, transitioned[0], "/rest/api/3/issue/PROJ-1/transitions")
+	assert.Contains(t, transitioned[0], `"id":"11"`)
+	assert.Contains(t, stdout.String(), `transition "to do" is not available`)
+}
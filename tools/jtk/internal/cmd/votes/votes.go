@@ -0,0 +1,81 @@
+package votes
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// Register registers the vote and unvote commands
+func Register(parent *cobra.Command, opts *root.Options) {
+	parent.AddCommand(newVoteCmd(opts))
+	parent.AddCommand(newUnvoteCmd(opts))
+}
+
+func newVoteCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vote <issue-key>",
+		Short:   "Vote for an issue",
+		Long:    "Add your vote to an issue. You cannot vote for issues you reported.",
+		Example: `  jtk vote PROJ-123`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVote(cmd.Context(), opts, args[0], true)
+		},
+	}
+
+	return cmd
+}
+
+func newUnvoteCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unvote <issue-key>",
+		Short:   "Remove your vote from an issue",
+		Long:    "Withdraw your vote for an issue.",
+		Example: `  jtk unvote PROJ-123`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVote(cmd.Context(), opts, args[0], false)
+		},
+	}
+
+	return cmd
+}
+
+func runVote(ctx context.Context, opts *root.Options, issueKey string, vote bool) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	if vote {
+		err = client.AddVote(ctx, issueKey)
+	} else {
+		err = client.RemoveVote(ctx, issueKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	votes, err := client.GetVotes(ctx, issueKey)
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(votes)
+	}
+
+	if vote {
+		v.Success("Voted for %s (%d vote(s))", issueKey, votes.Votes)
+	} else {
+		v.Success("Removed your vote from %s (%d vote(s))", issueKey, votes.Votes)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package votes
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+func TestRunVote(t *testing.T) {
+	tests := []struct {
+		name        string
+		vote        bool
+		wantMethod  string
+		wantMessage string
+	}{
+		{name: "vote", vote: true, wantMethod: http.MethodPost, wantMessage: "Voted for PROJ-1 (4 vote(s))"},
+		{name: "unvote", vote: false, wantMethod: http.MethodDelete, wantMessage: "Removed your vote from PROJ-1 (4 vote(s))"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var methods []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/rest/api/3/issue/PROJ-1/votes", r.URL.Path)
+				methods = append(methods, r.Method)
+				if r.Method == http.MethodGet {
+					w.Write([]byte(`{"votes": 4}`))
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			}))
+			defer server.Close()
+
+			client, err := api.New(api.ClientConfig{
+				URL:      server.URL,
+				Email:    "test@example.com",
+				APIToken: "token",
+			})
+			require.NoError(t, err)
+
+			var stdout bytes.Buffer
+			opts := &root.Options{Output: "table", Stdout: &stdout, Stderr: &bytes.Buffer{}}
+			opts.SetAPIClient(client)
+
+			err = runVote(context.Background(), opts, "PROJ-1", tt.vote)
+			require.NoError(t, err)
+			assert.Equal(t, []string{tt.wantMethod, http.MethodGet}, methods)
+			assert.Contains(t, stdout.String(), tt.wantMessage)
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package watchers
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// Register registers the watchers commands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:     "watchers",
+		Aliases: []string{"watcher", "watch"},
+		Short:   "Manage issue watchers",
+		Long:    "Commands for listing, adding and removing the watchers of an issue.",
+	}
+
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newAddCmd(opts))
+	cmd.AddCommand(newRemoveCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list <issue-key>",
+		Short:   "List watchers of an issue",
+		Long:    "List the users watching an issue.",
+		Example: `  jtk watchers list PROJ-123`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(cmd.Context(), opts, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runList(ctx context.Context, opts *root.Options, issueKey string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	watchers, err := client.GetWatchers(ctx, issueKey)
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(watchers)
+	}
+
+	if len(watchers.Watchers) == 0 {
+		v.Info("No watchers on %s", issueKey)
+		return nil
+	}
+
+	headers := []string{"ACCOUNT_ID", "NAME", "EMAIL"}
+	var rows [][]string
+
+	for _, u := range watchers.Watchers {
+		rows = append(rows, []string{u.AccountID, u.DisplayName, u.EmailAddress})
+	}
+
+	return v.Table(headers, rows)
+}
+
+func newAddCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add <issue-key> [user]",
+		Short: "Add a watcher to an issue",
+		Long: `Add a user to the watchers of an issue. Without a user, you start watching the issue.
+
+The user can be given by email address or display name. A name that matches
+more than one user is rejected; use the email address instead.`,
+		Example: `  # Watch an issue yourself
+  jtk watchers add PROJ-123
+
+  # Add someone else
+  jtk watchers add PROJ-123 jane@example.com
+  jtk watchers add PROJ-123 "Jane Smith"`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAdd(cmd.Context(), opts, args[0], optionalArg(args, 1))
+		},
+	}
+
+	return cmd
+}
+
+func runAdd(ctx context.Context, opts *root.Options, issueKey, query string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	user, err := resolveUser(ctx, client, query)
+	if err != nil {
+		return err
+	}
+
+	if err := client.AddWatcher(ctx, issueKey, user.AccountID); err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(map[string]string{"status": "added", "accountId": user.AccountID})
+	}
+
+	v.Success("Added %s as a watcher of %s", user.DisplayName, issueKey)
+	return nil
+}
+
+func newRemoveCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove <issue-key> [user]",
+		Aliases: []string{"rm"},
+		Short:   "Remove a watcher from an issue",
+		Long:    "Remove a user, given by email address or display name, from the watchers of an issue. Without a user, you stop watching the issue.",
+		Example: `  # Stop watching an issue
+  jtk watchers remove PROJ-123
+
+  # Remove someone else
+  jtk watchers remove PROJ-123 jane@example.com`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRemove(cmd.Context(), opts, args[0], optionalArg(args, 1))
+		},
+	}
+
+	return cmd
+}
+
+func runRemove(ctx context.Context, opts *root.Options, issueKey, query string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	user, err := resolveUser(ctx, client, query)
+	if err != nil {
+		return err
+	}
+
+	if err := client.RemoveWatcher(ctx, issueKey, user.AccountID); err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(map[string]string{"status": "removed", "accountId": user.AccountID})
+	}
+
+	v.Success("Removed %s from the watchers of %s", user.DisplayName, issueKey)
+	return nil
+}
+
+// resolveUser returns the user matching query, or the current user if
+// query is empty
+func resolveUser(ctx context.Context, client *api.Client, query string) (*api.User, error) {
+	if query == "" {
+		return client.GetCurrentUser(ctx)
+	}
+	return client.ResolveUser(ctx, query)
+}
+
+func optionalArg(args []string, i int) string {
+	if len(args) > i {
+		return args[i]
+	}
+	return ""
+}
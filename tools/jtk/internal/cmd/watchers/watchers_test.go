@@ -0,0 +1,82 @@
+package watchers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/internal/testutil"
+)
+
+const usersJSON = `[
+	{"accountId": "1", "displayName": "Jane Smith", "emailAddress": "jane@example.com"},
+	{"accountId": "2", "displayName": "Jane Smith", "emailAddress": "jane@example.org"}
+]`
+
+func TestRunAdd_ByEmail(t *testing.T) {
+	var added string
+	opts, stdout, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/user/search":
+			w.Write([]byte(usersJSON))
+		case "/rest/api/3/issue/PROJ-1/watchers":
+			body, _ := io.ReadAll(r.Body)
+			added = string(body)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	err := runAdd(context.Background(), opts, "PROJ-1", "jane@example.org")
+	require.NoError(t, err)
+	assert.Equal(t, `"2"`, added)
+	assert.Contains(t, stdout.String(), "Added Jane Smith as a watcher of PROJ-1")
+}
+
+func TestRunAdd_AmbiguousName(t *testing.T) {
+	opts, _, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/api/3/user/search" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Write([]byte(usersJSON))
+	})
+
+	err := runAdd(context.Background(), opts, "PROJ-1", "Jane Smith")
+	assert.ErrorContains(t, err, `"Jane Smith" matches 2 users`)
+}
+
+func TestRunRemove_CurrentUser(t *testing.T) {
+	var removed string
+	opts, stdout, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/myself":
+			w.Write([]byte(`{"accountId": "me", "displayName": "Me"}`))
+		case "/rest/api/3/issue/PROJ-1/watchers":
+			assert.Equal(t, http.MethodDelete, r.Method)
+			removed = r.URL.Query().Get("accountId")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	err := runRemove(context.Background(), opts, "PROJ-1", "")
+	require.NoError(t, err)
+	assert.Equal(t, "me", removed)
+	assert.Contains(t, stdout.String(), "Removed Me from the watchers of PROJ-1")
+}
+
+func TestRunList(t *testing.T) {
+	opts, stdout, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"watchCount": 1, "watchers": [{"accountId": "1", "displayName": "Jane Smith", "emailAddress": "jane@example.com"}]}`))
+	})
+
+	err := runList(context.Background(), opts, "PROJ-1")
+	require.NoError(t, err)
+	assert.Regexp(t, `1\s+Jane Smith\s+jane@example.com`, stdout.String())
+}
@@ -0,0 +1,235 @@
+package worklog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/timeflag"
+)
+
+// reportGroupings are the accepted values of --by
+var reportGroupings = []string{"user", "issue", "day"}
+
+// report is the time logged on the issues matching a query, grouped by
+// user, issue or day
+type report struct {
+	By           string        `json:"by"`
+	Since        string        `json:"since,omitempty"`
+	Until        string        `json:"until,omitempty"`
+	Groups       []reportGroup `json:"groups"`
+	TotalSeconds int           `json:"totalSeconds"`
+	Total        string        `json:"total"`
+}
+
+// reportGroup is the time logged by one user, on one issue or on one day
+type reportGroup struct {
+	// Key is the account ID, issue key or date
+	Key string `json:"key"`
+	// Name is the user's display name or the issue summary
+	Name             string `json:"name,omitempty"`
+	Worklogs         int    `json:"worklogs"`
+	TimeSpentSeconds int    `json:"timeSpentSeconds"`
+	TimeSpent        string `json:"timeSpent"`
+}
+
+func newReportCmd(opts *root.Options) *cobra.Command {
+	var jql, since, until, by string
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report time logged on issues",
+		Long: `Add up the time logged on the issues matching a JQL query, grouped by user,
+issue or day.
+
+--since and --until limit the report to work started in that period; a date
+given to --until includes the whole day. Adding worklogDate to the query
+keeps Jira from returning issues with no work in the period.`,
+		Example: `  # Time per person on a project this month
+  jtk worklog report --jql "project = PROJ AND worklogDate >= 2026-10-01" --since 2026-10-01
+
+  # Time per issue for one person's work
+  jtk worklog report --jql "worklogAuthor = currentUser()" --since 2026-10-01 --by issue
+
+  # Daily totals for a sprint, as JSON
+  jtk worklog report --jql "sprint = 42" --by day -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReport(cmd.Context(), opts, jql, since, until, by)
+		},
+	}
+
+	cmd.Flags().StringVar(&jql, "jql", "", "JQL query selecting the issues to report on (required)")
+	cmd.Flags().StringVar(&since, "since", "", "Only count work started on or after this date")
+	cmd.Flags().StringVar(&until, "until", "", "Only count work started on or before this date")
+	cmd.Flags().StringVar(&by, "by", "user", "Group time by user, issue or day")
+	_ = cmd.MarkFlagRequired("jql")
+
+	return cmd
+}
+
+func runReport(ctx context.Context, opts *root.Options, jql, since, until, by string) error {
+	v := opts.View()
+
+	if !slices.Contains(reportGroupings, by) {
+		return fmt.Errorf("invalid --by value %q (expected user, issue or day)", by)
+	}
+
+	var sinceTime, untilTime time.Time
+	var err error
+	if since != "" {
+		if sinceTime, err = timeflag.Parse("since", since); err != nil {
+			return err
+		}
+	}
+	if until != "" {
+		if untilTime, err = timeflag.Parse("until", until); err != nil {
+			return err
+		}
+		// A date on its own means the end of that day
+		if len(until) == len("2006-01-02") {
+			untilTime = untilTime.AddDate(0, 0, 1)
+		} else {
+			untilTime = untilTime.Add(time.Nanosecond)
+		}
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	issues, err := client.SearchAll(ctx, jql, 0)
+	if err != nil {
+		return err
+	}
+
+	groups := map[string]*reportGroup{}
+	var order []string
+	total := 0
+
+	for _, issue := range issues {
+		worklogs, err := client.GetAllWorklogs(ctx, issue.Key, sinceTime)
+		if err != nil {
+			return fmt.Errorf("failed to get worklogs for %s: %w", issue.Key, err)
+		}
+
+		for _, w := range worklogs {
+			started, err := time.Parse(api.TimeFormat, w.Started)
+			if err != nil {
+				return fmt.Errorf("worklog %s on %s has an invalid start time %q", w.ID, issue.Key, w.Started)
+			}
+			if started.Before(sinceTime) || (!untilTime.IsZero() && !started.Before(untilTime)) {
+				continue
+			}
+
+			key, name := groupKey(by, issue, w, started)
+			g, ok := groups[key]
+			if !ok {
+				g = &reportGroup{Key: key, Name: name}
+				groups[key] = g
+				order = append(order, key)
+			}
+			g.Worklogs++
+			g.TimeSpentSeconds += w.TimeSpentSeconds
+			total += w.TimeSpentSeconds
+		}
+	}
+
+	result := report{By: by, Since: since, Until: until, Groups: []reportGroup{}, TotalSeconds: total, Total: api.FormatSeconds(total)}
+	for _, key := range order {
+		g := groups[key]
+		g.TimeSpent = api.FormatSeconds(g.TimeSpentSeconds)
+		result.Groups = append(result.Groups, *g)
+	}
+	sortGroups(by, result.Groups)
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(result)
+	}
+
+	if len(result.Groups) == 0 {
+		v.Info("No time logged on %d matching issue(s)", len(issues))
+		return nil
+	}
+
+	var headers []string
+	switch by {
+	case "user":
+		headers = []string{"USER", "WORKLOGS", "TIME", "HOURS"}
+	case "issue":
+		headers = []string{"ISSUE", "SUMMARY", "WORKLOGS", "TIME", "HOURS"}
+	case "day":
+		headers = []string{"DAY", "WORKLOGS", "TIME", "HOURS"}
+	}
+
+	var rows [][]string
+	for _, g := range result.Groups {
+		var row []string
+		switch by {
+		case "user":
+			row = []string{g.Name}
+		case "issue":
+			row = []string{g.Key, view.Truncate(g.Name, 50)}
+		case "day":
+			row = []string{g.Key}
+		}
+		rows = append(rows, append(row, strconv.Itoa(g.Worklogs), g.TimeSpent, hours(g.TimeSpentSeconds)))
+	}
+
+	if err := v.Table(headers, rows); err != nil {
+		return err
+	}
+
+	if !view.IsMachineReadable(opts.Output) {
+		v.Println("")
+		v.Println("Total: %s (%s hours)", result.Total, hours(total))
+	}
+	return nil
+}
+
+// groupKey returns the key and display name of the group a worklog
+// belongs to
+func groupKey(by string, issue api.Issue, w api.Worklog, started time.Time) (string, string) {
+	switch by {
+	case "issue":
+		return issue.Key, issue.Fields.Summary
+	case "day":
+		// The day in the worklog's own time zone, as its author saw it
+		return started.Format("2006-01-02"), ""
+	default:
+		if w.Author == nil {
+			return "", "(unknown)"
+		}
+		key := w.Author.AccountID
+		if key == "" {
+			key = w.Author.DisplayName
+		}
+		return key, w.Author.DisplayName
+	}
+}
+
+// sortGroups orders users by name and days by date. Issues keep the order
+// of the search results.
+func sortGroups(by string, groups []reportGroup) {
+	switch by {
+	case "user":
+		sort.SliceStable(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+	case "day":
+		sort.SliceStable(groups, func(i, j int) bool { return groups[i].Key < groups[j].Key })
+	}
+}
+
+// hours formats seconds as decimal hours, as used on timesheets
+func hours(seconds int) string {
+	return strconv.FormatFloat(float64(seconds)/3600, 'f', 2, 64)
+}
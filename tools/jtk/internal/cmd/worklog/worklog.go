@@ -0,0 +1,341 @@
+package worklog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/open-cli-collective/atlassian-go/view"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/timeflag"
+)
+
+// Register registers the worklog commands
+func Register(parent *cobra.Command, opts *root.Options) {
+	cmd := &cobra.Command{
+		Use:     "worklog",
+		Aliases: []string{"worklogs", "wl"},
+		Short:   "Manage issue worklogs",
+		Long:    "Commands for logging time against issues and reporting on logged time.",
+	}
+
+	cmd.AddCommand(newAddCmd(opts))
+	cmd.AddCommand(newListCmd(opts))
+	cmd.AddCommand(newEditCmd(opts))
+	cmd.AddCommand(newDeleteCmd(opts))
+	cmd.AddCommand(newReportCmd(opts))
+
+	parent.AddCommand(cmd)
+}
+
+// adjustFlags holds the estimate adjustment flags shared by add, edit and
+// delete
+type adjustFlags struct {
+	mode        string
+	newEstimate string
+	amount      string
+}
+
+// register adds the flags to cmd. manualFlag names the flag for the amount
+// in manual mode, or is empty if the command does not support it.
+func (f *adjustFlags) register(cmd *cobra.Command, manualFlag, manualUsage string) {
+	modes := "auto, new or leave"
+	if manualFlag != "" {
+		modes = "auto, new, leave or manual"
+		cmd.Flags().StringVar(&f.amount, manualFlag, "", manualUsage)
+	}
+	cmd.Flags().StringVar(&f.mode, "adjust-estimate", "", "How to adjust the remaining estimate: "+modes+" (default auto)")
+	cmd.Flags().StringVar(&f.newEstimate, "new-estimate", "", "Remaining estimate to set with --adjust-estimate new (e.g. 4h)")
+}
+
+// adjustment builds the estimate adjustment from the flags
+func (f *adjustFlags) adjustment() (api.EstimateAdjustment, error) {
+	adjust := api.EstimateAdjustment{Mode: f.mode}
+	var err error
+	if f.newEstimate != "" {
+		if adjust.NewEstimate, err = api.NormalizeDuration(f.newEstimate); err != nil {
+			return adjust, err
+		}
+	}
+	if f.amount != "" {
+		if adjust.Amount, err = api.NormalizeDuration(f.amount); err != nil {
+			return adjust, err
+		}
+	}
+	return adjust, nil
+}
+
+// formatStarted shortens a worklog start time for display
+func formatStarted(started string) string {
+	t, err := time.Parse(api.TimeFormat, started)
+	if err != nil {
+		return started
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+func newAddCmd(opts *root.Options) *cobra.Command {
+	var started, comment string
+	var adjust adjustFlags
+
+	cmd := &cobra.Command{
+		Use:   "add <issue-key> <time-spent>",
+		Short: "Log time against an issue",
+		Long: `Log time against an issue.
+
+Time is given in Jira's duration format, such as 1h30m, 45m, 2d or "1d 4h".
+Days and weeks are converted using the site's time tracking settings.`,
+		Example: `  # Log an hour and a half now
+  jtk worklog add PROJ-123 1h30m
+
+  # Log time for earlier work, with a comment
+  jtk worklog add PROJ-123 2h --started "2026-10-16 09:00" --comment "Code review"
+
+  # Set the remaining estimate explicitly
+  jtk worklog add PROJ-123 1h --adjust-estimate new --new-estimate 3h`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAdd(cmd.Context(), opts, args[0], args[1], started, comment, adjust)
+		},
+	}
+
+	cmd.Flags().StringVar(&started, "started", "", "When the work started (YYYY-MM-DD, YYYY-MM-DD HH:MM or RFC 3339; default now)")
+	cmd.Flags().StringVarP(&comment, "comment", "c", "", "Worklog comment")
+	adjust.register(cmd, "reduce-by", "Amount to reduce the remaining estimate by with --adjust-estimate manual")
+
+	return cmd
+}
+
+func runAdd(ctx context.Context, opts *root.Options, issueKey, timeSpent, started, comment string, adjustFlags adjustFlags) error {
+	v := opts.View()
+
+	req, err := buildRequest(timeSpent, started, comment)
+	if err != nil {
+		return err
+	}
+	if req.Started == "" {
+		req.Started = time.Now().Format(api.TimeFormat)
+	}
+	adjust, err := adjustFlags.adjustment()
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	worklog, err := client.AddWorklog(ctx, issueKey, req, adjust)
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(worklog)
+	}
+
+	v.Success("Logged %s on %s (worklog %s)", req.TimeSpent, issueKey, worklog.ID)
+	return nil
+}
+
+// buildRequest builds a worklog request from command line values, leaving
+// empty values unset
+func buildRequest(timeSpent, started, comment string) (*api.WorklogRequest, error) {
+	req := &api.WorklogRequest{}
+
+	if timeSpent != "" {
+		normalized, err := api.NormalizeDuration(timeSpent)
+		if err != nil {
+			return nil, err
+		}
+		req.TimeSpent = normalized
+	}
+
+	if started != "" {
+		t, err := timeflag.Parse("started", started)
+		if err != nil {
+			return nil, err
+		}
+		req.Started = t.Format(api.TimeFormat)
+	}
+
+	if comment != "" {
+		req.Comment = api.NewADFDocument(comment)
+		if err := api.CheckADF("worklog comment", req.Comment); err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+func newListCmd(opts *root.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list <issue-key>",
+		Short:   "List worklogs on an issue",
+		Long:    "List the time logged against an issue.",
+		Example: `  jtk worklog list PROJ-123`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runList(cmd.Context(), opts, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runList(ctx context.Context, opts *root.Options, issueKey string) error {
+	v := opts.View()
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	worklogs, err := client.GetAllWorklogs(ctx, issueKey, time.Time{})
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(worklogs)
+	}
+
+	if len(worklogs) == 0 {
+		v.Info("No worklogs on %s", issueKey)
+		return nil
+	}
+
+	headers := []string{"ID", "AUTHOR", "STARTED", "TIME SPENT", "COMMENT"}
+	var rows [][]string
+
+	total := 0
+	for _, w := range worklogs {
+		author := ""
+		if w.Author != nil {
+			author = w.Author.DisplayName
+		}
+		comment := ""
+		if w.Comment != nil {
+			comment = view.Truncate(strings.TrimSpace(w.Comment.ToPlainText()), 60)
+		}
+		rows = append(rows, []string{w.ID, author, formatStarted(w.Started), api.FormatSeconds(w.TimeSpentSeconds), comment})
+		total += w.TimeSpentSeconds
+	}
+
+	if err := v.Table(headers, rows); err != nil {
+		return err
+	}
+
+	v.Println("")
+	v.Println("Total: %s in %d worklog(s)", api.FormatSeconds(total), len(worklogs))
+	return nil
+}
+
+func newEditCmd(opts *root.Options) *cobra.Command {
+	var timeSpent, started, comment string
+	var adjust adjustFlags
+
+	cmd := &cobra.Command{
+		Use:   "edit <issue-key> <worklog-id>",
+		Short: "Edit a worklog",
+		Long:  "Change the time spent, start time or comment of a worklog. Only the given values are changed.",
+		Example: `  jtk worklog edit PROJ-123 10042 --time 2h
+  jtk worklog edit PROJ-123 10042 --comment "Pairing on the parser"`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runEdit(cmd.Context(), opts, args[0], args[1], timeSpent, started, comment, adjust)
+		},
+	}
+
+	cmd.Flags().StringVarP(&timeSpent, "time", "t", "", "New time spent (e.g. 1h30m)")
+	cmd.Flags().StringVar(&started, "started", "", "New start time (YYYY-MM-DD, YYYY-MM-DD HH:MM or RFC 3339)")
+	cmd.Flags().StringVarP(&comment, "comment", "c", "", "New worklog comment")
+	adjust.register(cmd, "", "")
+
+	return cmd
+}
+
+func runEdit(ctx context.Context, opts *root.Options, issueKey, worklogID, timeSpent, started, comment string, adjustFlags adjustFlags) error {
+	v := opts.View()
+
+	if timeSpent == "" && started == "" && comment == "" {
+		return fmt.Errorf("nothing to change: specify --time, --started or --comment")
+	}
+
+	req, err := buildRequest(timeSpent, started, comment)
+	if err != nil {
+		return err
+	}
+	adjust, err := adjustFlags.adjustment()
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	worklog, err := client.UpdateWorklog(ctx, issueKey, worklogID, req, adjust)
+	if err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(worklog)
+	}
+
+	v.Success("Updated worklog %s on %s", worklogID, issueKey)
+	return nil
+}
+
+func newDeleteCmd(opts *root.Options) *cobra.Command {
+	var adjust adjustFlags
+
+	cmd := &cobra.Command{
+		Use:     "delete <issue-key> <worklog-id>",
+		Short:   "Delete a worklog",
+		Long:    "Delete a worklog from an issue.",
+		Example: `  jtk worklog delete PROJ-123 10042`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDelete(cmd.Context(), opts, args[0], args[1], adjust)
+		},
+	}
+
+	adjust.register(cmd, "increase-by", "Amount to increase the remaining estimate by with --adjust-estimate manual")
+
+	return cmd
+}
+
+func runDelete(ctx context.Context, opts *root.Options, issueKey, worklogID string, adjustFlags adjustFlags) error {
+	v := opts.View()
+
+	adjust, err := adjustFlags.adjustment()
+	if err != nil {
+		return err
+	}
+
+	client, err := opts.APIClient()
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteWorklog(ctx, issueKey, worklogID, adjust); err != nil {
+		return err
+	}
+
+	if view.IsStructured(opts.Output) {
+		return v.JSON(map[string]string{"status": "deleted", "worklogId": worklogID})
+	}
+
+	v.Success("Deleted worklog %s from %s", worklogID, issueKey)
+	return nil
+}
@@ -0,0 +1,152 @@
+package worklog
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/testutil"
+)
+
+func TestRunAdd(t *testing.T) {
+	var req api.WorklogRequest
+	opts, stdout, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/3/issue/PROJ-1/worklog", r.URL.Path)
+		assert.Equal(t, "leave", r.URL.Query().Get("adjustEstimate"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": "200", "timeSpentSeconds": 5400}`))
+	})
+
+	err := runAdd(context.Background(), opts, "PROJ-1", "1h30m", "2026-10-16T14:00:00Z", "Code *review*", adjustFlags{mode: "leave"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "1h 30m", req.TimeSpent)
+	assert.Equal(t, "2026-10-16T14:00:00.000+0000", req.Started)
+	require.NotNil(t, req.Comment)
+	assert.Equal(t, "Code review", strings.TrimSpace(req.Comment.ToPlainText()))
+	assert.Contains(t, stdout.String(), "Logged 1h 30m on PROJ-1 (worklog 200)")
+}
+
+func TestRunAdd_InvalidDuration(t *testing.T) {
+	opts, _, _ := testutil.NewOptions(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	err := runAdd(context.Background(), opts, "PROJ-1", "90", "", "", adjustFlags{})
+	assert.ErrorContains(t, err, "invalid duration")
+
+	err = runAdd(context.Background(), opts, "PROJ-1", "1h", "", "", adjustFlags{mode: "new"})
+	assert.ErrorContains(t, err, "new estimate is required")
+}
+
+func TestRunEdit_NothingToChange(t *testing.T) {
+	err := runEdit(context.Background(), &root.Options{}, "PROJ-1", "200", "", "", "", adjustFlags{})
+	assert.ErrorContains(t, err, "nothing to change")
+}
+
+func newReportServer(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/rest/api/3/search/jql":
+			w.Write([]byte(`{"issues": [
+				{"key": "PROJ-2", "fields": {"summary": "Second"}},
+				{"key": "PROJ-1", "fields": {"summary": "First"}}
+			], "isLast": true}`))
+		case "/rest/api/3/issue/PROJ-1/worklog":
+			w.Write([]byte(`{"total": 2, "worklogs": [
+				{"id": "1", "author": {"accountId": "b", "displayName": "Bob"}, "started": "2026-10-02T09:00:00.000+0000", "timeSpentSeconds": 3600},
+				{"id": "2", "author": {"accountId": "a", "displayName": "Alice"}, "started": "2026-10-01T09:00:00.000+0000", "timeSpentSeconds": 1800}
+			]}`))
+		case "/rest/api/3/issue/PROJ-2/worklog":
+			w.Write([]byte(`{"total": 2, "worklogs": [
+				{"id": "3", "author": {"accountId": "a", "displayName": "Alice"}, "started": "2026-10-02T15:00:00.000+0000", "timeSpentSeconds": 7200},
+				{"id": "4", "author": {"accountId": "a", "displayName": "Alice"}, "started": "2026-10-07T09:00:00.000+0000", "timeSpentSeconds": 900}
+			]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}
+}
+
+func TestRunReport(t *testing.T) {
+	tests := []struct {
+		by   string
+		want []reportGroup
+	}{
+		{
+			by: "user",
+			want: []reportGroup{
+				{Key: "a", Name: "Alice", Worklogs: 2, TimeSpentSeconds: 9000, TimeSpent: "2h 30m"},
+				{Key: "b", Name: "Bob", Worklogs: 1, TimeSpentSeconds: 3600, TimeSpent: "1h"},
+			},
+		},
+		{
+			by: "issue",
+			want: []reportGroup{
+				{Key: "PROJ-2", Name: "Second", Worklogs: 1, TimeSpentSeconds: 7200, TimeSpent: "2h"},
+				{Key: "PROJ-1", Name: "First", Worklogs: 2, TimeSpentSeconds: 5400, TimeSpent: "1h 30m"},
+			},
+		},
+		{
+			by: "day",
+			want: []reportGroup{
+				{Key: "2026-10-01", Worklogs: 1, TimeSpentSeconds: 1800, TimeSpent: "30m"},
+				{Key: "2026-10-02", Worklogs: 2, TimeSpentSeconds: 10800, TimeSpent: "3h"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.by, func(t *testing.T) {
+			opts, stdout, _ := testutil.NewOptions(t, newReportServer(t))
+			opts.Output = "json"
+
+			// --until excludes the worklog on 2026-10-07
+			err := runReport(context.Background(), opts, "project = PROJ", "", "2026-10-04", tt.by)
+			require.NoError(t, err)
+
+			var got report
+			require.NoError(t, json.Unmarshal(stdout.Bytes(), &got))
+			assert.Equal(t, tt.want, got.Groups)
+			assert.Equal(t, 12600, got.TotalSeconds)
+			assert.Equal(t, "3h 30m", got.Total)
+		})
+	}
+}
+
+func TestRunReport_Table(t *testing.T) {
+	opts, stdout, _ := testutil.NewOptions(t, newReportServer(t))
+
+	err := runReport(context.Background(), opts, "project = PROJ", "", "", "user")
+	require.NoError(t, err)
+
+	output := stdout.String()
+	assert.Regexp(t, `Alice\s+3\s+2h 45m\s+2.75`, output)
+	assert.Regexp(t, `Bob\s+1\s+1h\s+1.00`, output)
+	assert.Contains(t, output, "Total: 3h 45m (3.75 hours)")
+}
+
+func TestRunReport_CSV(t *testing.T) {
+	opts, stdout, _ := testutil.NewOptions(t, newReportServer(t))
+	opts.Output = "csv"
+
+	err := runReport(context.Background(), opts, "project = PROJ", "", "", "user")
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(stdout.String()), "\n")
+	assert.Len(t, lines, 3, "a header and a row per user, without the total")
+	assert.NotContains(t, stdout.String(), "Total:")
+}
+
+func TestRunReport_InvalidGrouping(t *testing.T) {
+	err := runReport(context.Background(), &root.Options{}, "project = PROJ", "", "", "week")
+	assert.ErrorContains(t, err, `invalid --by value "week"`)
+}
@@ -7,6 +7,8 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
+	sharedconfig "github.com/open-cli-collective/atlassian-go/config"
 	"github.com/open-cli-collective/atlassian-go/url"
 )
 
@@ -15,15 +17,19 @@ const (
 	configFileName = "config.json"
 	configFileMode = 0600
 	configDirMode  = 0700
+
+	// credentialService is the service name API tokens are stored under.
+	credentialService = configDirName
 )
 
-// Config holds the CLI configuration
+// Config holds the settings of one profile
 type Config struct {
 	URL            string `json:"url,omitempty"`
 	Domain         string `json:"domain,omitempty"` // Deprecated: use URL instead
 	Email          string `json:"email"`
-	APIToken       string `json:"api_token"`
 	DefaultProject string `json:"default_project,omitempty"`
+
+	sharedconfig.Auth
 }
 
 // configPath returns the path to the config file
@@ -35,60 +41,294 @@ func configPath() (string, error) {
 	return filepath.Join(configDir, configDirName, configFileName), nil
 }
 
-// Load loads the configuration from file
+// credentials keeps the API tokens of the profiles in the config at path
+func credentials(path string) sharedconfig.Credentials {
+	return sharedconfig.Credentials{Service: credentialService, ConfigPath: path}
+}
+
+// credential returns where the API token for cfg is stored
+func credential(cfg *Config) sharedconfig.Credential {
+	site := cfg.URL
+	if site == "" && cfg.Domain != "" {
+		site = cfg.Domain + ".atlassian.net"
+	}
+	return sharedconfig.Credential{
+		Backend: cfg.CredentialStore,
+		Account: auth.Account(url.NormalizeURL(site), cfg.Email),
+	}
+}
+
+// profileOverride is the profile selected with the --profile flag
+var profileOverride string
+
+// SetProfile selects the profile used by Load, Save and the Get* functions,
+// taking precedence over JIRA_PROFILE, ATLASSIAN_PROFILE and the default
+// profile in the config file
+func SetProfile(name string) {
+	profileOverride = name
+}
+
+// File is the config file: named profiles, one of which is the default
+type File = sharedconfig.Profiles[Config]
+
+// legacyFile is the config file as written before profiles existed, with
+// a single set of settings at the top level
+type legacyFile struct {
+	File
+	Config
+}
+
+// activeProfile returns the name of the profile selected in f
+func activeProfile(f *File) string {
+	return sharedconfig.SelectProfile(profileOverride, "JIRA_PROFILE", f.Default)
+}
+
+// readFile reads the config file without consulting the credential store.
+// The boolean reports whether the file exists.
+func readFile(path string) (*File, bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return &File{}, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	var raw legacyFile
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, false, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	f := raw.File
+	if len(f.Profiles) == 0 && raw.Config != (Config{}) {
+		cfg := raw.Config
+		f.Set(sharedconfig.DefaultProfile, &cfg)
+	}
+
+	return &f, true, nil
+}
+
+// writeFile writes the config file, leaving out API tokens. Tokens not yet
+// in the credential store, such as plaintext tokens of any profile left by
+// an older version, are moved there first; a token that cannot be stored
+// stays in the file rather than being lost.
+func writeFile(path string, f *File) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, configDirMode); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	onDisk := File{}
+	onDisk.Default = f.Default
+	for name, cfg := range f.Profiles {
+		c := *cfg
+		if storeToken(cfg, path) == nil {
+			c.APIToken = ""
+		}
+		onDisk.Set(name, &c)
+	}
+
+	data, err := json.MarshalIndent(&onDisk, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, configFileMode); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	return nil
+}
+
+// storeToken moves the API token of cfg into the credential store, unless
+// it was loaded from or saved to the store already
+func storeToken(cfg *Config, path string) error {
+	if cfg.APIToken == "" || cfg.TokenStore != "" {
+		return nil
+	}
+	store, err := credentials(path).Set(credential(cfg), cfg.APIToken)
+	if err != nil {
+		return err
+	}
+	cfg.TokenStore = store
+	return nil
+}
+
+// LoadFile loads every profile from the config file, without API tokens
+func LoadFile() (*File, error) {
+	path, err := configPath()
+	if err != nil {
+		return nil, err
+	}
+	f, _, err := readFile(path)
+	return f, err
+}
+
+// ActiveProfile returns the name of the selected profile.
+// Precedence: --profile → JIRA_PROFILE → ATLASSIAN_PROFILE → default profile in config → "default"
+func ActiveProfile() string {
+	f, err := LoadFile()
+	if err != nil {
+		f = &File{}
+	}
+	return activeProfile(f)
+}
+
+// Load loads the selected profile from the config file and its API token
+// from the credential store. Without a config file it returns an empty
+// Config, so that the environment alone can configure jtk. The credential
+// store is not read when JIRA_API_TOKEN or ATLASSIAN_API_TOKEN is set, as
+// that token is used instead, except with OAuth.
+//
+// Opening the credential store may be slow, so commands load the profile
+// once and use the Config methods rather than the Get* functions.
+//
+// A plaintext api_token left by an older version is moved into the
+// credential store; if that fails it is used as is.
 func Load() (*Config, error) {
 	path, err := configPath()
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := os.ReadFile(path)
+	f, exists, err := readFile(path)
 	if err != nil {
-		if errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	name := activeProfile(f)
+	cfg, ok := f.Get(name)
+	if !ok {
+		if !exists {
 			return &Config{}, nil
 		}
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+		return nil, fmt.Errorf("profile %q not found", name)
 	}
 
-	var cfg Config
-	if err := json.Unmarshal(data, &cfg); err != nil {
-		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	if cfg.APIToken == "" && envAPIToken() != "" && cfg.GetAuthMethod() != auth.MethodOAuth {
+		return cfg, nil
 	}
 
-	return &cfg, nil
+	if cfg.APIToken != "" {
+		// Rewriting the file moves the plaintext tokens into the store
+		_ = writeFile(path, f)
+		return cfg, nil
+	}
+
+	token, store, err := credentials(path).Get(credential(cfg))
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		cfg.APIToken = token
+		cfg.TokenStore = store
+	}
+
+	return cfg, nil
 }
 
-// Save saves the configuration to file
+// Save saves cfg as the selected profile
 func Save(cfg *Config) error {
+	return SaveProfile(ActiveProfile(), cfg)
+}
+
+// SaveProfile saves cfg as the named profile, creating it if needed, and
+// its API token to the credential store
+func SaveProfile(name string, cfg *Config) error {
+	if err := sharedconfig.ValidateProfileName(name); err != nil {
+		return err
+	}
+
 	path, err := configPath()
 	if err != nil {
 		return err
 	}
 
-	dir := filepath.Dir(path)
-	if err := os.MkdirAll(dir, configDirMode); err != nil {
-		return fmt.Errorf("failed to create config directory: %w", err)
+	f, _, err := readFile(path)
+	if err != nil {
+		return err
 	}
 
-	data, err := json.MarshalIndent(cfg, "", "  ")
+	if cfg.APIToken != "" {
+		store, err := credentials(path).Set(credential(cfg), cfg.APIToken)
+		if err != nil {
+			return err
+		}
+		cfg.TokenStore = store
+	}
+
+	f.Set(name, cfg)
+	return writeFile(path, f)
+}
+
+// UseProfile makes the named profile the default
+func UseProfile(name string) error {
+	path, err := configPath()
 	if err != nil {
-		return fmt.Errorf("failed to marshal config: %w", err)
+		return err
 	}
 
-	if err := os.WriteFile(path, data, configFileMode); err != nil {
-		return fmt.Errorf("failed to write config file: %w", err)
+	f, _, err := readFile(path)
+	if err != nil {
+		return err
+	}
+	if err := f.Use(name); err != nil {
+		return err
 	}
+	return writeFile(path, f)
+}
 
-	return nil
+// RemoveProfile removes the named profile and its API token, unless another
+// profile uses the same account
+func RemoveProfile(name string) error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+
+	f, _, err := readFile(path)
+	if err != nil {
+		return err
+	}
+	cfg, ok := f.Get(name)
+	if !ok {
+		return fmt.Errorf("profile %q not found", name)
+	}
+	f.Remove(name)
+
+	if err := deleteToken(f, cfg, path); err != nil {
+		return err
+	}
+	return writeFile(path, f)
 }
 
-// Clear removes the configuration file
+// deleteToken removes the API token of cfg from the credential store, unless
+// a profile remaining in f shares it
+func deleteToken(f *File, cfg *Config, path string) error {
+	remaining := make([]sharedconfig.Credential, 0, len(f.Profiles))
+	for _, other := range f.Profiles {
+		remaining = append(remaining, credential(other))
+	}
+	return credentials(path).Delete(credential(cfg), remaining)
+}
+
+// Clear removes the configuration file and the API tokens of every profile
 func Clear() error {
 	path, err := configPath()
 	if err != nil {
 		return err
 	}
 
+	// An unreadable config cannot name its tokens, but should still be removable
+	if f, _, err := readFile(path); err == nil {
+		for _, name := range f.Names() {
+			cfg, _ := f.Get(name)
+			f.Remove(name)
+			if err := deleteToken(f, cfg, path); err != nil {
+				return err
+			}
+		}
+	}
+
 	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
 		return fmt.Errorf("failed to remove config file: %w", err)
 	}
@@ -96,28 +336,39 @@ func Clear() error {
 	return nil
 }
 
+// loadQuietly loads the selected profile for the Get* functions, which
+// fall back to the environment alone when it cannot be loaded
+func loadQuietly() *Config {
+	cfg, err := Load()
+	if err != nil {
+		return &Config{}
+	}
+	return cfg
+}
+
 // GetURL returns the Jira URL from config or environment.
 // Precedence: JIRA_URL → ATLASSIAN_URL → config url → JIRA_DOMAIN (legacy) → config domain (legacy)
 func GetURL() string {
+	return loadQuietly().GetURL()
+}
+
+// GetURL returns the Jira URL of the profile, as GetURL does
+func (c *Config) GetURL() string {
 	if v := os.Getenv("JIRA_URL"); v != "" {
 		return url.NormalizeURL(v)
 	}
 	if v := os.Getenv("ATLASSIAN_URL"); v != "" {
 		return url.NormalizeURL(v)
 	}
-	cfg, err := Load()
-	if err != nil {
-		return ""
-	}
-	if cfg.URL != "" {
-		return url.NormalizeURL(cfg.URL)
+	if c.URL != "" {
+		return url.NormalizeURL(c.URL)
 	}
 	// Backwards compatibility: construct URL from domain
 	if v := os.Getenv("JIRA_DOMAIN"); v != "" {
 		return "https://" + v + ".atlassian.net"
 	}
-	if cfg.Domain != "" {
-		return "https://" + cfg.Domain + ".atlassian.net"
+	if c.Domain != "" {
+		return "https://" + c.Domain + ".atlassian.net"
 	}
 	return ""
 }
@@ -128,61 +379,163 @@ func GetDomain() string {
 	if v := os.Getenv("JIRA_DOMAIN"); v != "" {
 		return v
 	}
-	cfg, err := Load()
-	if err != nil {
-		return ""
-	}
-	return cfg.Domain
+	return loadQuietly().Domain
 }
 
 // GetEmail returns the email from config or environment.
 // Precedence: JIRA_EMAIL → ATLASSIAN_EMAIL → config email
 func GetEmail() string {
+	return loadQuietly().GetEmail()
+}
+
+// GetEmail returns the email of the profile, as GetEmail does
+func (c *Config) GetEmail() string {
 	if v := os.Getenv("JIRA_EMAIL"); v != "" {
 		return v
 	}
 	if v := os.Getenv("ATLASSIAN_EMAIL"); v != "" {
 		return v
 	}
-	cfg, err := Load()
-	if err != nil {
-		return ""
+	return c.Email
+}
+
+// envAPIToken returns the API token set in the environment, if any
+func envAPIToken() string {
+	if v := os.Getenv("JIRA_API_TOKEN"); v != "" {
+		return v
 	}
-	return cfg.Email
+	return os.Getenv("ATLASSIAN_API_TOKEN")
 }
 
 // GetAPIToken returns the API token from config or environment.
 // Precedence: JIRA_API_TOKEN → ATLASSIAN_API_TOKEN → config api_token
 func GetAPIToken() string {
-	if v := os.Getenv("JIRA_API_TOKEN"); v != "" {
+	return loadQuietly().GetAPIToken()
+}
+
+// GetAPIToken returns the API token of the profile, as GetAPIToken does
+func (c *Config) GetAPIToken() string {
+	if v := envAPIToken(); v != "" {
+		return v
+	}
+	return c.APIToken
+}
+
+// GetAuthMethod returns the authentication method from config or environment.
+// Precedence: JIRA_AUTH_METHOD → ATLASSIAN_AUTH_METHOD → config auth_method → basic
+func GetAuthMethod() string {
+	return loadQuietly().GetAuthMethod()
+}
+
+// GetAuthMethod returns the authentication method of the profile, as
+// GetAuthMethod does
+func (c *Config) GetAuthMethod() string {
+	if v := os.Getenv("JIRA_AUTH_METHOD"); v != "" {
 		return v
 	}
-	if v := os.Getenv("ATLASSIAN_API_TOKEN"); v != "" {
+	if v := os.Getenv("ATLASSIAN_AUTH_METHOD"); v != "" {
 		return v
 	}
+	if c.AuthMethod == "" {
+		return auth.MethodBasic
+	}
+	return c.AuthMethod
+}
+
+// GetCloudID returns the cloud ID of the site from config, if known
+func GetCloudID() string {
+	return loadQuietly().CloudID
+}
+
+// Authenticator returns the authenticator for the selected profile; see
+// Config.Authenticator
+func Authenticator() (auth.Authenticator, error) {
 	cfg, err := Load()
 	if err != nil {
-		return ""
+		return nil, err
+	}
+	return cfg.Authenticator()
+}
+
+// Authenticator returns the authenticator for the auth method of the
+// profile, or nil for Basic authentication with GetEmail and GetAPIToken.
+// OAuth tokens are refreshed as needed and written back to the credential
+// store.
+func (c *Config) Authenticator() (auth.Authenticator, error) {
+	switch method := c.GetAuthMethod(); method {
+	case auth.MethodBasic:
+		return nil, nil
+	case auth.MethodBearer:
+		token := c.GetAPIToken()
+		if token == "" {
+			return nil, errors.New("personal access token is required (run 'jtk init')")
+		}
+		return &auth.Bearer{Token: token}, nil
+	case auth.MethodOAuth:
+		if c.APIToken == "" || c.OAuthClientID == "" {
+			return nil, errors.New("OAuth is not set up for this profile (run 'jtk init --auth oauth')")
+		}
+		creds, err := auth.ParseOAuthCredentials(c.APIToken)
+		if err != nil {
+			return nil, err
+		}
+
+		authn := auth.NewOAuth(&auth.OAuthConfig{
+			ClientID:     c.OAuthClientID,
+			ClientSecret: creds.ClientSecret,
+		}, &creds.Token)
+		authn.OnRefresh = func(token *auth.Token) error {
+			creds.Token = *token
+			secret, err := creds.Encode()
+			if err != nil {
+				return err
+			}
+			return SaveToken(c, secret)
+		}
+		return authn, nil
+	default:
+		return nil, auth.ValidateMethod(method)
+	}
+}
+
+// SaveToken replaces the secret of cfg in the credential store, leaving the
+// config file untouched
+func SaveToken(cfg *Config, secret string) error {
+	path, err := configPath()
+	if err != nil {
+		return err
+	}
+	store, err := credentials(path).Set(credential(cfg), secret)
+	if err != nil {
+		return err
 	}
-	return cfg.APIToken
+	cfg.APIToken = secret
+	cfg.TokenStore = store
+	return nil
 }
 
 // IsConfigured returns true if all required config values are set
 func IsConfigured() bool {
-	return GetURL() != "" && GetEmail() != "" && GetAPIToken() != ""
+	cfg := loadQuietly()
+	if cfg.GetURL() == "" || cfg.GetAPIToken() == "" {
+		return false
+	}
+	return cfg.GetEmail() != "" || cfg.GetAuthMethod() != auth.MethodBasic
 }
 
 // GetDefaultProject returns the default project from config or environment.
 // Precedence: JIRA_DEFAULT_PROJECT → config default_project
 func GetDefaultProject() string {
+	return loadQuietly().GetDefaultProject()
+}
+
+// GetDefaultProject returns the default project of the profile, as
+// GetDefaultProject does
+func (c *Config) GetDefaultProject() string {
 	if v := os.Getenv("JIRA_DEFAULT_PROJECT"); v != "" {
 		return v
 	}
-	cfg, err := Load()
-	if err != nil {
-		return ""
-	}
-	return cfg.DefaultProject
+	return c.DefaultProject
 }
 
 // Path returns the path to the config file
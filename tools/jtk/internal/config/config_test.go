@@ -4,10 +4,13 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/open-cli-collective/atlassian-go/auth"
+	sharedconfig "github.com/open-cli-collective/atlassian-go/config"
 	"github.com/open-cli-collective/atlassian-go/url"
 )
 
@@ -33,6 +36,18 @@ func setupTestConfig(t *testing.T) (string, func()) {
 	t.Setenv("ATLASSIAN_EMAIL", "")
 	t.Setenv("ATLASSIAN_API_TOKEN", "")
 
+	t.Setenv("JIRA_AUTH_METHOD", "")
+	t.Setenv("ATLASSIAN_AUTH_METHOD", "")
+
+	t.Setenv("JIRA_PROFILE", "")
+	t.Setenv("ATLASSIAN_PROFILE", "")
+	SetProfile("")
+	t.Cleanup(func() { SetProfile("") })
+
+	// Keep tokens out of the developer's real keychain
+	t.Setenv(auth.EnvCredentialStore, auth.BackendFile)
+	t.Setenv(auth.EnvCredentialPassphrase, "")
+
 	// Create macOS-style dir as well for fallback
 	libDir := filepath.Join(tempDir, "Library", "Application Support")
 	err := os.MkdirAll(libDir, 0700)
@@ -47,9 +62,9 @@ func TestConfig_SaveAndLoad(t *testing.T) {
 	defer cleanup()
 
 	cfg := &Config{
-		URL:      "https://example.atlassian.net",
-		Email:    "test@example.com",
-		APIToken: "secret-token",
+		URL:   "https://example.atlassian.net",
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "secret-token"},
 	}
 
 	// Save config
@@ -65,6 +80,80 @@ func TestConfig_SaveAndLoad(t *testing.T) {
 	assert.Equal(t, cfg.APIToken, loaded.APIToken)
 }
 
+func TestConfig_Save_TokenNotInConfigFile(t *testing.T) {
+	_, cleanup := setupTestConfig(t)
+	defer cleanup()
+
+	cfg := &Config{
+		URL:   "https://example.atlassian.net",
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "secret-token"},
+	}
+	require.NoError(t, Save(cfg))
+	assert.Equal(t, auth.BackendFile, cfg.TokenStore)
+
+	data, err := os.ReadFile(Path())
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), "api_token")
+
+	loaded, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "secret-token", loaded.APIToken)
+	assert.Equal(t, auth.BackendFile, loaded.TokenStore)
+}
+
+func TestConfig_Load_MigratesPlaintextToken(t *testing.T) {
+	_, cleanup := setupTestConfig(t)
+	defer cleanup()
+
+	legacy := `{"url": "https://example.atlassian.net", "email": "test@example.com", "api_token": "legacy-token"}`
+	require.NoError(t, os.MkdirAll(filepath.Dir(Path()), 0700))
+	require.NoError(t, os.WriteFile(Path(), []byte(legacy), 0600))
+
+	loaded, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-token", loaded.APIToken)
+	assert.Equal(t, "https://example.atlassian.net", loaded.URL)
+
+	data, err := os.ReadFile(Path())
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "legacy-token")
+
+	reloaded, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "legacy-token", reloaded.APIToken)
+	assert.Equal(t, auth.BackendFile, reloaded.TokenStore)
+}
+
+func TestConfig_Load_MigratesPlaintextTokensOfEveryProfile(t *testing.T) {
+	_, cleanup := setupTestConfig(t)
+	defer cleanup()
+
+	legacy := `{"profiles": {
+		"default": {"url": "https://example.atlassian.net", "email": "me@example.com", "api_token": "default-token"},
+		"sandbox": {"url": "https://sandbox.atlassian.net", "email": "me@example.com", "api_token": "sandbox-token"}
+	}}`
+	require.NoError(t, os.MkdirAll(filepath.Dir(Path()), 0700))
+	require.NoError(t, os.WriteFile(Path(), []byte(legacy), 0600))
+
+	// Rewriting the file for another reason must not drop either token
+	require.NoError(t, UseProfile("default"))
+
+	data, err := os.ReadFile(Path())
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "default-token")
+	assert.NotContains(t, string(data), "sandbox-token")
+
+	for name, want := range map[string]string{"default": "default-token", "sandbox": "sandbox-token"} {
+		SetProfile(name)
+		loaded, err := Load()
+		require.NoError(t, err)
+		assert.Equal(t, want, loaded.APIToken, name)
+		assert.Equal(t, auth.BackendFile, loaded.TokenStore, name)
+	}
+}
+
 func TestConfig_Load_NotExists(t *testing.T) {
 	_, cleanup := setupTestConfig(t)
 	defer cleanup()
@@ -84,9 +173,9 @@ func TestConfig_Clear(t *testing.T) {
 
 	// Save config first
 	cfg := &Config{
-		URL:      "https://example.atlassian.net",
-		Email:    "test@example.com",
-		APIToken: "secret-token",
+		URL:   "https://example.atlassian.net",
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "secret-token"},
 	}
 	err := Save(cfg)
 	require.NoError(t, err)
@@ -99,6 +188,12 @@ func TestConfig_Clear(t *testing.T) {
 	loaded, err := Load()
 	require.NoError(t, err)
 	assert.Empty(t, loaded.URL)
+
+	// The token should be gone from the credential store too
+	require.NoError(t, Save(&Config{URL: cfg.URL, Email: cfg.Email}))
+	loaded, err = Load()
+	require.NoError(t, err)
+	assert.Empty(t, loaded.APIToken)
 }
 
 func TestConfig_Clear_NotExists(t *testing.T) {
@@ -115,9 +210,9 @@ func TestConfig_FilePermissions(t *testing.T) {
 	defer cleanup()
 
 	cfg := &Config{
-		URL:      "https://example.atlassian.net",
-		Email:    "test@example.com",
-		APIToken: "secret-token",
+		URL:   "https://example.atlassian.net",
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "secret-token"},
 	}
 	err := Save(cfg)
 	require.NoError(t, err)
@@ -247,7 +342,7 @@ func TestGetAPIToken_EnvOverride(t *testing.T) {
 	defer cleanup()
 
 	// Save config
-	cfg := &Config{APIToken: "config-token"}
+	cfg := &Config{Auth: sharedconfig.Auth{APIToken: "config-token"}}
 	err := Save(cfg)
 	require.NoError(t, err)
 
@@ -275,9 +370,9 @@ func TestIsConfigured(t *testing.T) {
 
 	// Fully configured with URL
 	cfg = &Config{
-		URL:      "https://test.atlassian.net",
-		Email:    "test@example.com",
-		APIToken: "token",
+		URL:   "https://test.atlassian.net",
+		Email: "test@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "token"},
 	}
 	Save(cfg)
 	assert.True(t, IsConfigured())
@@ -289,9 +384,9 @@ func TestIsConfigured_LegacyDomain(t *testing.T) {
 
 	// Fully configured with legacy domain
 	cfg := &Config{
-		Domain:   "test",
-		Email:    "test@example.com",
-		APIToken: "token",
+		Domain: "test",
+		Email:  "test@example.com",
+		Auth:   sharedconfig.Auth{APIToken: "token"},
 	}
 	Save(cfg)
 	assert.True(t, IsConfigured())
@@ -420,3 +515,198 @@ func TestGetURL_FullPrecedenceChain(t *testing.T) {
 	t.Setenv("JIRA_URL", "https://jira-url.atlassian.net")
 	assert.Equal(t, "https://jira-url.atlassian.net", GetURL())
 }
+
+func TestProfiles_SaveAndLoad(t *testing.T) {
+	_, cleanup := setupTestConfig(t)
+	defer cleanup()
+
+	require.NoError(t, SaveProfile("default", &Config{
+		URL:   "https://prod.atlassian.net",
+		Email: "me@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "prod-token"},
+	}))
+	require.NoError(t, SaveProfile("sandbox", &Config{
+		URL:   "https://sandbox.atlassian.net",
+		Email: "me@example.com",
+		Auth:  sharedconfig.Auth{APIToken: "sandbox-token"},
+	}))
+
+	assert.Equal(t, "default", ActiveProfile())
+	assert.Equal(t, "https://prod.atlassian.net", GetURL())
+	assert.Equal(t, "prod-token", GetAPIToken())
+
+	SetProfile("sandbox")
+	assert.Equal(t, "sandbox", ActiveProfile())
+	assert.Equal(t, "https://sandbox.atlassian.net", GetURL())
+	assert.Equal(t, "sandbox-token", GetAPIToken())
+
+	// Environment variables still override the selected profile
+	t.Setenv("JIRA_URL", "https://override.atlassian.net")
+	assert.Equal(t, "https://override.atlassian.net", GetURL())
+}
+
+func TestProfiles_Selection(t *testing.T) {
+	_, cleanup := setupTestConfig(t)
+	defer cleanup()
+
+	require.NoError(t, SaveProfile("default", &Config{URL: "https://prod.atlassian.net", Email: "me@example.com"}))
+	require.NoError(t, SaveProfile("sandbox", &Config{URL: "https://sandbox.atlassian.net", Email: "me@example.com"}))
+	require.NoError(t, SaveProfile("staging", &Config{URL: "https://staging.atlassian.net", Email: "me@example.com"}))
+
+	require.NoError(t, UseProfile("sandbox"))
+	assert.Equal(t, "sandbox", ActiveProfile())
+
+	t.Setenv("ATLASSIAN_PROFILE", "staging")
+	assert.Equal(t, "staging", ActiveProfile())
+
+	t.Setenv("JIRA_PROFILE", "default")
+	assert.Equal(t, "default", ActiveProfile())
+
+	SetProfile("sandbox")
+	assert.Equal(t, "sandbox", ActiveProfile())
+
+	assert.Error(t, UseProfile("missing"))
+}
+
+func TestProfiles_LegacyConfigBecomesDefaultProfile(t *testing.T) {
+	_, cleanup := setupTestConfig(t)
+	defer cleanup()
+
+	legacy := `{"url": "https://example.atlassian.net", "email": "test@example.com", "default_project": "PROJ"}`
+	require.NoError(t, os.MkdirAll(filepath.Dir(Path()), 0700))
+	require.NoError(t, os.WriteFile(Path(), []byte(legacy), 0600))
+
+	f, err := LoadFile()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default"}, f.Names())
+	assert.Equal(t, "PROJ", GetDefaultProject())
+
+	// Adding a profile rewrites the file in the profiles format
+	require.NoError(t, SaveProfile("sandbox", &Config{URL: "https://sandbox.atlassian.net", Email: "test@example.com"}))
+	data, err := os.ReadFile(Path())
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"profiles"`)
+	assert.Equal(t, "https://example.atlassian.net", GetURL())
+}
+
+func TestProfiles_Remove(t *testing.T) {
+	_, cleanup := setupTestConfig(t)
+	defer cleanup()
+
+	shared := Config{URL: "https://prod.atlassian.net", Email: "me@example.com", Auth: sharedconfig.Auth{APIToken: "shared-token"}}
+	first, second := shared, shared
+	require.NoError(t, SaveProfile("default", &first))
+	require.NoError(t, SaveProfile("alias", &second))
+	require.NoError(t, UseProfile("alias"))
+
+	// The token is kept while another profile uses the same account
+	require.NoError(t, RemoveProfile("alias"))
+	assert.Equal(t, "default", ActiveProfile())
+	assert.Equal(t, "shared-token", GetAPIToken())
+
+	require.NoError(t, RemoveProfile("default"))
+	assert.Error(t, RemoveProfile("default"))
+
+	require.NoError(t, SaveProfile("default", &Config{URL: shared.URL, Email: shared.Email}))
+	assert.Empty(t, GetAPIToken())
+}
+
+func TestAuthenticator(t *testing.T) {
+	_, cleanup := setupTestConfig(t)
+	defer cleanup()
+
+	t.Run("basic", func(t *testing.T) {
+		require.NoError(t, Save(&Config{URL: "https://test.atlassian.net", Email: "me@example.com", Auth: sharedconfig.Auth{APIToken: "token"}}))
+		authn, err := Authenticator()
+		require.NoError(t, err)
+		assert.Nil(t, authn)
+		assert.True(t, IsConfigured())
+	})
+
+	t.Run("bearer", func(t *testing.T) {
+		require.NoError(t, Save(&Config{URL: "https://jira.example.com", Auth: sharedconfig.Auth{AuthMethod: auth.MethodBearer, APIToken: "pat"}}))
+		authn, err := Authenticator()
+		require.NoError(t, err)
+		assert.Equal(t, &auth.Bearer{Token: "pat"}, authn)
+		assert.True(t, IsConfigured(), "bearer profiles need no email")
+	})
+
+	t.Run("env overrides method", func(t *testing.T) {
+		t.Setenv("JIRA_AUTH_METHOD", "kerberos")
+		_, err := Authenticator()
+		assert.ErrorContains(t, err, "unknown auth method")
+	})
+
+	t.Run("oauth", func(t *testing.T) {
+		creds := &auth.OAuthCredentials{
+			ClientSecret: "secret",
+			Token:        auth.Token{AccessToken: "access", RefreshToken: "refresh", Expiry: time.Now().Add(time.Hour)},
+		}
+		secret, err := creds.Encode()
+		require.NoError(t, err)
+		require.NoError(t, Save(&Config{
+			URL: "https://test.atlassian.net",
+			Auth: sharedconfig.Auth{
+				AuthMethod:    auth.MethodOAuth,
+				CloudID:       "cloud-1",
+				OAuthClientID: "client",
+				APIToken:      secret,
+			},
+		}))
+
+		authn, err := Authenticator()
+		require.NoError(t, err)
+		oauth, ok := authn.(*auth.OAuth)
+		require.True(t, ok)
+		assert.Equal(t, "client", oauth.Config.ClientID)
+		assert.Equal(t, "secret", oauth.Config.ClientSecret)
+		assert.Equal(t, "cloud-1", GetCloudID())
+
+		// A refreshed token replaces the stored one
+		require.NoError(t, oauth.OnRefresh(&auth.Token{AccessToken: "new", RefreshToken: "refresh-2"}))
+		cfg, err := Load()
+		require.NoError(t, err)
+		stored, err := auth.ParseOAuthCredentials(cfg.APIToken)
+		require.NoError(t, err)
+		assert.Equal(t, "refresh-2", stored.RefreshToken)
+		assert.Equal(t, "secret", stored.ClientSecret)
+	})
+}
+
+func TestLoad_CredentialStoreError(t *testing.T) {
+	_, cleanup := setupTestConfig(t)
+	defer cleanup()
+
+	t.Setenv(auth.EnvCredentialPassphrase, "secret")
+	require.NoError(t, Save(&Config{URL: "https://test.atlassian.net", Email: "me@example.com", Auth: sharedconfig.Auth{APIToken: "token"}}))
+	t.Setenv(auth.EnvCredentialPassphrase, "")
+
+	_, err := Load()
+	assert.ErrorContains(t, err, auth.EnvCredentialPassphrase)
+	_, err = Authenticator()
+	assert.ErrorContains(t, err, auth.EnvCredentialPassphrase)
+
+	// A token in the environment is used without reading the store
+	t.Setenv("JIRA_API_TOKEN", "env-token")
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, "https://test.atlassian.net", cfg.GetURL())
+	assert.Equal(t, "env-token", cfg.GetAPIToken())
+	assert.Empty(t, cfg.TokenStore)
+}
+
+func TestLoad_ProfileNotFound(t *testing.T) {
+	_, cleanup := setupTestConfig(t)
+	defer cleanup()
+
+	// Without a config file the environment alone may configure jtk
+	cfg, err := Load()
+	require.NoError(t, err)
+	assert.Equal(t, &Config{}, cfg)
+
+	require.NoError(t, SaveProfile("default", &Config{URL: "https://test.atlassian.net", Email: "me@example.com"}))
+
+	SetProfile("typo")
+	_, err = Load()
+	assert.EqualError(t, err, `profile "typo" not found`)
+}
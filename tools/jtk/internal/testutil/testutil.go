@@ -0,0 +1,40 @@
+// Package testutil provides helpers for testing jtk commands.
+package testutil
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-cli-collective/jira-ticket-cli/api"
+	"github.com/open-cli-collective/jira-ticket-cli/internal/cmd/root"
+)
+
+// NewOptions returns table output options whose API client sends requests
+// to a test server running handler, along with the buffers capturing stdout
+// and stderr
+func NewOptions(t *testing.T, handler http.HandlerFunc) (*root.Options, *bytes.Buffer, *bytes.Buffer) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := api.New(api.ClientConfig{
+		URL:      server.URL,
+		Email:    "test@example.com",
+		APIToken: "token",
+	})
+	require.NoError(t, err)
+
+	var stdout, stderr bytes.Buffer
+	opts := &root.Options{
+		Output: "table",
+		Stdout: &stdout,
+		Stderr: &stderr,
+	}
+	opts.SetAPIClient(client)
+	return opts, &stdout, &stderr
+}
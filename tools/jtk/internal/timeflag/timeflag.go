@@ -0,0 +1,26 @@
+// Package timeflag parses the dates and times given to command flags.
+package timeflag
+
+import (
+	"fmt"
+	"time"
+)
+
+// layouts are the accepted formats, in local time unless they carry an
+// offset
+var layouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// Parse parses the value s of the named flag as a date, or a date and time
+func Parse(flag, s string) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid --%s %q (expected YYYY-MM-DD, YYYY-MM-DD HH:MM or RFC 3339)", flag, s)
+}
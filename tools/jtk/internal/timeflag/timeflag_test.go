@@ -0,0 +1,26 @@
+package timeflag
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	got, err := Parse("since", "2026-10-17")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 17, 0, 0, 0, 0, time.Local), got)
+
+	got, err = Parse("since", "2026-10-17 09:30")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 17, 9, 30, 0, 0, time.Local), got)
+
+	got, err = Parse("started", "2026-10-17T09:30:00+02:00")
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-17T09:30:00+02:00", got.Format(time.RFC3339))
+
+	_, err = Parse("until", "17/10/2026")
+	assert.EqualError(t, err, `invalid --until "17/10/2026" (expected YYYY-MM-DD, YYYY-MM-DD HH:MM or RFC 3339)`)
+}